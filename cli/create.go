@@ -27,6 +27,39 @@ var cmdCreate = &cobra.Command{
 	Long:  `create is the root of the generator commands.`,
 }
 
+// cmdCreateAnnouncement posts an announcement from the GM in the Galactic News
+var cmdCreateAnnouncement = &cobra.Command{
+	Use:   "announcement --article text --signature text",
+	Short: "post an announcement in the Galactic News",
+	Long:  `Post an announcement from the GM in the Galactic News for the current turn. Announcements are sent to every empire.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		started := time.Now()
+		defer func() {
+			log.Printf("create: announcement: elapsed time: %v\n", time.Now().Sub(started))
+		}()
+
+		repo, err := repos.Open(flags.Database.Path, context.Background())
+		if err != nil {
+			log.Fatalf("error: repos.open: %v\n", err)
+		}
+		defer repo.Close()
+		e, err := engine.Open(repo)
+		if err != nil {
+			log.Fatalf("error: engine.open: %v\n", err)
+		}
+
+		err = engine.CreateAnnouncementCommand(e, &engine.CreateAnnouncementParams_t{
+			Article:   cmd.Flag("article").Value.String(),
+			Signature: cmd.Flag("signature").Value.String(),
+		})
+		if err != nil {
+			log.Fatalf("error: engine.CreateAnnouncementCommand: %v\n", err)
+		}
+
+		log.Printf("create: announcement: posted\n")
+	},
+}
+
 // cmdCreateDatabase implements the create database command
 var cmdCreateDatabase = &cobra.Command{
 	Use:   "database",
@@ -210,6 +243,53 @@ var cmdCreateGame = &cobra.Command{
 	},
 }
 
+// cmdCreateOrders loads an empire's orders for the current turn
+var cmdCreateOrders = &cobra.Command{
+	Use:   "orders --empire --input",
+	Short: "load orders for an empire",
+	Long:  `Load an empire's orders file and store the orders for the current turn.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		started := time.Now()
+		defer func() {
+			log.Printf("create: orders: elapsed time: %v\n", time.Now().Sub(started))
+		}()
+
+		var empireID int64
+		if n, err := strconv.Atoi(cmd.Flag("empire").Value.String()); err != nil {
+			log.Fatalf("create: orders: %v\n", err)
+		} else if n < 1 || n > 250 {
+			log.Fatalf("create: orders: empire must be between 1 and 250\n")
+		} else {
+			empireID = int64(n)
+		}
+		input, err := os.ReadFile(cmd.Flag("input").Value.String())
+		if err != nil {
+			log.Fatalf("create: orders: %v\n", err)
+		}
+
+		repo, err := repos.Open(flags.Database.Path, context.Background())
+		if err != nil {
+			log.Fatalf("error: repos.open: %v\n", err)
+		}
+		defer repo.Close()
+		e, err := engine.Open(repo)
+		if err != nil {
+			log.Fatalf("error: engine.open: %v\n", err)
+		}
+		turnNo, err := repo.Queries.ReadCurrentTurn(repo.Context)
+		if err != nil {
+			log.Fatalf("error: current turn: %v\n", err)
+		}
+
+		err = e.LoadOrders(empireID, turnNo, input)
+		if err != nil {
+			log.Fatalf("error: engine.LoadOrders: %v\n", err)
+		}
+
+		log.Printf("create: orders: loaded orders for empire %d, turn %d\n", empireID, turnNo)
+	},
+}
+
 // cmdCreateStarList creates a new star list
 var cmdCreateStarList = &cobra.Command{
 	Use:   "star-list",
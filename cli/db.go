@@ -67,3 +67,22 @@ var cmdDBOpen = &cobra.Command{
 		log.Printf("db: open: finished\n")
 	},
 }
+
+// cmdDBMigrate runs the migrations that haven't been run on an existing database.
+var cmdDBMigrate = &cobra.Command{
+	Use:   "migrate --path database",
+	Short: "migrate an existing database",
+	Long:  `Run the migration scripts that haven't been run on an existing database.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		started := time.Now()
+		defer func() {
+			log.Printf("db: migrate: elapsed time: %v\n", time.Now().Sub(started))
+		}()
+		path := cmd.Flag("path").Value.String()
+		log.Printf("db: migrate: %s\n", path)
+		if err := repos.Migrate(path); err != nil {
+			log.Fatal(err)
+		}
+		log.Printf("db: migrate: finished\n")
+	},
+}
@@ -20,28 +20,57 @@ var cmdExecute = &cobra.Command{
 	Long:  `execute is the root of the execution commands.`,
 }
 
-var cmdExecuteProbes = &cobra.Command{
-	Use:   "probes",
-	Short: "execute probe orders",
-	Long:  `execute probe orders.`,
-	Run: func(cmd *cobra.Command, args []string) {
-		started := time.Now()
-		defer func() {
-			log.Printf("execute: probes: elapsed time: %v\n", time.Now().Sub(started))
-		}()
-		log.Printf("execute: probes: game %q\n", flags.Game.Code)
-		repo, err := repos.Open(flags.Database.Path, context.Background())
-		if err != nil {
-			log.Fatalf("error: store.open: %v\n", err)
-		}
-		defer repo.Close()
-		e, err := engine.Open(repo)
-		if err != nil {
-			log.Fatalf("error: engine.open: %v\n", err)
-		}
-		_ = e
-	},
-}
+var cmdExecuteAssemblies = newExecuteCommand("assemblies", "execute assembly orders",
+	`execute assemble and store orders for the current turn.`,
+	(*engine.Engine_t).ExecuteAssemblies)
+
+var cmdExecuteCombat = newExecuteCommand("combat", "execute combat orders",
+	`execute bombard, invade, raid, and support orders for the current turn.`,
+	(*engine.Engine_t).ExecuteCombat)
+
+var cmdExecuteDrafts = newExecuteCommand("drafts", "execute draft and discharge orders",
+	`graduate trainees and execute draft and discharge orders for the current turn.`,
+	(*engine.Engine_t).ExecuteDrafts)
+
+var cmdExecuteEspionage = newExecuteCommand("espionage", "execute espionage orders",
+	`execute check-rebels, convert-rebels, counter-agents, incite-rebels, steal-secrets, and suppress-agents orders for the current turn.`,
+	(*engine.Engine_t).ExecuteEspionage)
+
+var cmdExecuteJumps = newExecuteCommand("jumps", "execute jump orders",
+	`execute interstellar jump orders for the current turn.`,
+	(*engine.Engine_t).ExecuteJumps)
+
+var cmdExecuteMarket = newExecuteCommand("market", "execute buy and sell orders",
+	`execute buy and sell orders and publish market prices for the current turn.`,
+	(*engine.Engine_t).ExecuteMarket)
+
+var cmdExecuteMoves = newExecuteCommand("moves", "execute move orders",
+	`execute in-system move orders for the current turn.`,
+	(*engine.Engine_t).ExecuteMoves)
+
+var cmdExecuteNames = newExecuteCommand("names", "execute name orders",
+	`execute name orders for empires, systems, stars, ships, and colonies for the current turn.`,
+	(*engine.Engine_t).ExecuteNames)
+
+var cmdExecuteNews = newExecuteCommand("news", "execute news orders",
+	`execute news orders and publish the Galactic News for the current turn.`,
+	(*engine.Engine_t).ExecuteNews)
+
+var cmdExecutePermissions = newExecuteCommand("permissions", "execute grant and revoke orders",
+	`execute grant and revoke orders for colonize and trade rights for the current turn.`,
+	(*engine.Engine_t).ExecutePermissions)
+
+var cmdExecuteProbes = newExecuteCommand("probes", "execute probe orders",
+	`execute system, star, and orbit probe orders for the current turn.`,
+	(*engine.Engine_t).ExecuteProbes)
+
+var cmdExecuteRecycles = newExecuteCommand("recycles", "execute recycle orders",
+	`execute recycle and scrap orders for the current turn.`,
+	(*engine.Engine_t).ExecuteRecycles)
+
+var cmdExecuteResearch = newExecuteCommand("research", "execute research orders",
+	`execute lab groups and research orders for the current turn.`,
+	(*engine.Engine_t).ExecuteResearch)
 
 var cmdExecuteReset = &cobra.Command{
 	Use:   "reset",
@@ -67,25 +96,56 @@ var cmdExecuteReset = &cobra.Command{
 	},
 }
 
-var cmdExecuteSurveys = &cobra.Command{
-	Use:   "surveys",
-	Short: "execute survey orders",
-	Long:  `execute survey orders.`,
-	Run: func(cmd *cobra.Command, args []string) {
-		started := time.Now()
-		defer func() {
-			log.Printf("execute: surveys: elapsed time: %v\n", time.Now().Sub(started))
-		}()
-		log.Printf("execute: surveys: game %q\n", flags.Game.Code)
-		repo, err := repos.Open(flags.Database.Path, context.Background())
-		if err != nil {
-			log.Fatalf("error: store.open: %v\n", err)
-		}
-		defer repo.Close()
-		e, err := engine.Open(repo)
-		if err != nil {
-			log.Fatalf("error: engine.open: %v\n", err)
-		}
-		err = e.ExecuteSurveys(flags.Game.Code, 0)
-	},
+var cmdExecuteRetools = newExecuteCommand("retools", "execute retool orders",
+	`execute retool orders for the current turn.`,
+	(*engine.Engine_t).ExecuteRetools)
+
+var cmdExecuteSetups = newExecuteCommand("setups", "execute setup orders",
+	`execute setup orders to found new ships and colonies for the current turn.`,
+	(*engine.Engine_t).ExecuteSetups)
+
+var cmdExecuteSurveys = newExecuteCommand("surveys", "execute survey orders",
+	`execute orbit survey orders for the current turn.`,
+	(*engine.Engine_t).ExecuteSurveys)
+
+var cmdExecuteTransfers = newExecuteCommand("transfers", "execute transfer orders",
+	`execute transfer orders for the current turn, including orders queued on earlier turns.`,
+	(*engine.Engine_t).ExecuteTransfers)
+
+// newExecuteCommand returns a command that opens the store and the engine
+// and runs one phase of the turn for the current game and turn.
+func newExecuteCommand(use, short, long string, execute func(e *engine.Engine_t, gameCode string, turnNo int64) error) *cobra.Command {
+	return &cobra.Command{
+		Use:   use,
+		Short: short,
+		Long:  long,
+		Run: func(cmd *cobra.Command, args []string) {
+			started := time.Now()
+			defer func() {
+				log.Printf("execute: %s: elapsed time: %v\n", use, time.Now().Sub(started))
+			}()
+			log.Printf("execute: %s: game %q\n", use, flags.Game.Code)
+			repo, err := repos.Open(flags.Database.Path, context.Background())
+			if err != nil {
+				log.Fatalf("error: store.open: %v\n", err)
+			}
+			defer repo.Close()
+			turnNo, err := repo.Queries.ReadCurrentTurn(repo.Context)
+			if err != nil {
+				log.Fatalf("error: current turn: %v\n", err)
+			}
+			// turn 0 is the setup turn. orders are executed from turn 1.
+			if turnNo < 1 {
+				log.Fatalf("error: %s: %v\n", use, engine.ErrGameNotStarted)
+			}
+			e, err := engine.Open(repo)
+			if err != nil {
+				log.Fatalf("error: engine.open: %v\n", err)
+			}
+			err = execute(e, flags.Game.Code, turnNo)
+			if err != nil {
+				log.Fatalf("error: %s: %v\n", use, err)
+			}
+		},
+	}
 }
@@ -44,12 +44,16 @@ var cmdExportEmpires = &cobra.Command{
 
 		outputPath := cmd.Flags().Lookup("output").Value.String()
 
+		asOfTurnNo, err := e.Store.Queries.ReadCurrentTurn(e.Store.Context)
+		if err != nil {
+			log.Fatalf("error: store.queries.read_current_turn: %v\n", err)
+		}
 		activeEmpires, err := e.Store.Queries.ReadActiveEmpires(e.Store.Context)
 		if err != nil {
 			log.Fatalf("error: store.queries.read_active_empires: %v\n", err)
 		}
 		for _, empireID := range activeEmpires {
-			empireRow, err := e.Store.Queries.ReadEmpireByID(e.Store.Context, empireID)
+			empireRow, err := e.Store.Queries.ReadEmpireByID(e.Store.Context, sqlite.ReadEmpireByIDParams{EmpireID: empireID, AsOfDt: asOfTurnNo})
 			if err != nil {
 				log.Fatalf("error: readEmpireByID: %v\n", err)
 			}
@@ -65,11 +69,16 @@ var cmdExportEmpires = &cobra.Command{
 				}
 			}()
 
-			if _, err := exportCoverTab(empireID, f, e.Store.Context, e.Store.Queries); err != nil {
+			names, err := e.ReadPrivateNames(empireID, turnNo)
+			if err != nil {
+				log.Fatalf("export: empire %d: %v\n", empireID, err)
+			}
+
+			if _, err := exportCoverTab(empireID, turnNo, f, e.Store.Context, e.Store.Queries); err != nil {
 				log.Fatalf("export: empire %d: %v\n", empireID, err)
-			} else if _, err = exportSystemsTab(empireID, f, e.Store.Context, e.Store.Queries); err != nil {
+			} else if _, err = exportSystemsTab(empireID, turnNo, names, f, e.Store.Context, e.Store.Queries); err != nil {
 				log.Fatalf("export: empire %d: %v\n", empireID, err)
-			} else if _, err = exportStarProbesTab(empireID, turnNo, f, e.Store.Context, e.Store.Queries); err != nil {
+			} else if _, err = exportOrbitsTab(empireID, turnNo, names, f, e.Store.Context, e.Store.Queries); err != nil {
 				log.Fatalf("export: empire %d: %v\n", empireID, err)
 			}
 
@@ -83,7 +92,7 @@ var cmdExportEmpires = &cobra.Command{
 }
 
 // create the turn report cover sheet
-func exportCoverTab(empireID int64, f *excelize.File, ctx context.Context, q *sqlite.Queries) (index int, err error) {
+func exportCoverTab(empireID, turnNo int64, f *excelize.File, ctx context.Context, q *sqlite.Queries) (index int, err error) {
 	const sheet = "Cover"
 	index, err = f.NewSheet(sheet)
 	if err != nil {
@@ -92,7 +101,7 @@ func exportCoverTab(empireID int64, f *excelize.File, ctx context.Context, q *sq
 	}
 	f.SetActiveSheet(index)
 
-	row, err := q.ExportCoverTabByID(ctx, empireID)
+	row, err := q.ExportCoverTabByID(ctx, sqlite.ExportCoverTabByIDParams{EmpireID: empireID, AsOfDt: turnNo})
 	if err != nil {
 		log.Printf("export: sheet %q: %v\n", sheet, err)
 		return index, err
@@ -118,8 +127,9 @@ func exportCoverTab(empireID int64, f *excelize.File, ctx context.Context, q *sq
 	return index, nil
 }
 
-// create the turn report systems sheet
-func exportSystemsTab(empireID int64, f *excelize.File, ctx context.Context, q *sqlite.Queries) (index int, err error) {
+// create the turn report systems sheet from the stars that the empire knows about.
+// stars are shown with the names the empire gave them.
+func exportSystemsTab(empireID, turnNo int64, names *engine.PrivateNames_t, f *excelize.File, ctx context.Context, q *sqlite.Queries) (index int, err error) {
 	const sheet = "Systems"
 	index, err = f.NewSheet(sheet)
 	if err != nil {
@@ -129,12 +139,15 @@ func exportSystemsTab(empireID int64, f *excelize.File, ctx context.Context, q *
 	f.SetActiveSheet(index)
 
 	rowNo := 1 // heading row
-	_ = f.SetCellValue(sheet, "A1", "X")
-	_ = f.SetCellValue(sheet, "B1", "Y")
-	_ = f.SetCellValue(sheet, "C1", "Z")
-	_ = f.SetCellValue(sheet, "D1", "Nbr of Stars")
-
-	rows, err := q.ExportSystems(ctx)
+	_ = f.SetCellValue(sheet, "A1", "System")
+	_ = f.SetCellValue(sheet, "B1", "X")
+	_ = f.SetCellValue(sheet, "C1", "Y")
+	_ = f.SetCellValue(sheet, "D1", "Z")
+	_ = f.SetCellValue(sheet, "E1", "Star")
+	_ = f.SetCellValue(sheet, "F1", "Nbr of Orbits")
+	_ = f.SetCellValue(sheet, "G1", "Last Observed")
+
+	rows, err := q.ReadAllEmpireStarKnowledge(ctx, sqlite.ReadAllEmpireStarKnowledgeParams{EmpireID: empireID, AsOfDt: turnNo})
 	if err != nil {
 		log.Printf("export: sheet %q: %v\n", sheet, err)
 		return index, err
@@ -142,18 +155,22 @@ func exportSystemsTab(empireID int64, f *excelize.File, ctx context.Context, q *
 
 	for _, row := range rows {
 		rowNo++
-		_ = f.SetCellValue(sheet, fmt.Sprintf("A%d", rowNo), row.X)
-		_ = f.SetCellValue(sheet, fmt.Sprintf("B%d", rowNo), row.Y)
-		_ = f.SetCellValue(sheet, fmt.Sprintf("C%d", rowNo), row.Z)
-		_ = f.SetCellValue(sheet, fmt.Sprintf("D%d", rowNo), row.NbrOfStars)
+		_ = f.SetCellValue(sheet, fmt.Sprintf("A%d", rowNo), row.SystemName)
+		_ = f.SetCellValue(sheet, fmt.Sprintf("B%d", rowNo), row.X)
+		_ = f.SetCellValue(sheet, fmt.Sprintf("C%d", rowNo), row.Y)
+		_ = f.SetCellValue(sheet, fmt.Sprintf("D%d", rowNo), row.Z)
+		_ = f.SetCellValue(sheet, fmt.Sprintf("E%d", rowNo), names.Coordinates(row.SystemID, row.StarID, row.StarName))
+		_ = f.SetCellValue(sheet, fmt.Sprintf("F%d", rowNo), row.NbrOfOrbits)
+		_ = f.SetCellValue(sheet, fmt.Sprintf("G%d", rowNo), row.ObservedDt)
 	}
 
 	return index, nil
 }
 
-// export the results of all star probes by the empire
-func exportStarProbesTab(empireID, turnNo int64, f *excelize.File, ctx context.Context, q *sqlite.Queries) (index int, err error) {
-	const sheet = "Star Probes"
+// export the orbits that the empire knows about.
+// stars are shown with the names the empire gave them.
+func exportOrbitsTab(empireID, turnNo int64, names *engine.PrivateNames_t, f *excelize.File, ctx context.Context, q *sqlite.Queries) (index int, err error) {
+	const sheet = "Orbits"
 	index, err = f.NewSheet(sheet)
 	if err != nil {
 		log.Printf("export: sheet %q: %v\n", sheet, err)
@@ -163,16 +180,41 @@ func exportStarProbesTab(empireID, turnNo int64, f *excelize.File, ctx context.C
 
 	rowNo := 1 // heading row
 	_ = f.SetCellValue(sheet, "A1", "Star")
-
-	rows, err := q.ExportStarProbes(ctx, sqlite.ExportStarProbesParams{EmpireID: empireID, TurnNo: turnNo})
+	_ = f.SetCellValue(sheet, "B1", "Orbit")
+	_ = f.SetCellValue(sheet, "C1", "Kind")
+	_ = f.SetCellValue(sheet, "D1", "Fuel")
+	_ = f.SetCellValue(sheet, "E1", "Gold")
+	_ = f.SetCellValue(sheet, "F1", "Metals")
+	_ = f.SetCellValue(sheet, "G1", "Non-Metals")
+	_ = f.SetCellValue(sheet, "H1", "Last Observed")
+
+	rows, err := q.ReadAllEmpireOrbitKnowledge(ctx, sqlite.ReadAllEmpireOrbitKnowledgeParams{EmpireID: empireID, AsOfDt: turnNo})
 	if err != nil {
 		log.Printf("export: sheet %q: %v\n", sheet, err)
 		return index, err
 	}
 
+	stars := map[int64]string{}
 	for _, row := range rows {
+		star, ok := stars[row.StarID]
+		if !ok {
+			starRow, err := q.ReadStarSystem(ctx, row.StarID)
+			if err != nil {
+				log.Printf("export: sheet %q: %v\n", sheet, err)
+				return index, err
+			}
+			star = names.Coordinates(starRow.SystemID, row.StarID, starRow.StarName)
+			stars[row.StarID] = star
+		}
 		rowNo++
-		_ = f.SetCellValue(sheet, fmt.Sprintf("A%d", rowNo), row.StarName)
+		_ = f.SetCellValue(sheet, fmt.Sprintf("A%d", rowNo), star)
+		_ = f.SetCellValue(sheet, fmt.Sprintf("B%d", rowNo), row.OrbitNo)
+		_ = f.SetCellValue(sheet, fmt.Sprintf("C%d", rowNo), row.OrbitKind)
+		_ = f.SetCellValue(sheet, fmt.Sprintf("D%d", rowNo), row.FuelEst)
+		_ = f.SetCellValue(sheet, fmt.Sprintf("E%d", rowNo), row.GoldEst)
+		_ = f.SetCellValue(sheet, fmt.Sprintf("F%d", rowNo), row.MetsEst)
+		_ = f.SetCellValue(sheet, fmt.Sprintf("G%d", rowNo), row.NmtsEst)
+		_ = f.SetCellValue(sheet, fmt.Sprintf("H%d", rowNo), row.ObservedDt)
 	}
 
 	return index, nil
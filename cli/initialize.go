@@ -47,9 +47,16 @@ func Initialize(options ...Option) (*cobra.Command, error) {
 
 	cmdRoot.PersistentFlags().BoolVar(&flags.Debug.DumpEnv, "dump-env", flags.Debug.DumpEnv, "dump environment variables")
 
-	cmdRoot.AddCommand(cmdCreate, cmdDB, cmdDelete, cmdExecute, cmdExport, cmdShow, cmdStart, cmdVersion)
+	cmdRoot.AddCommand(cmdCreate, cmdDB, cmdDelete, cmdExecute, cmdExport, cmdPlan, cmdShow, cmdSim, cmdStart, cmdVersion)
 
-	cmdCreate.AddCommand(cmdCreateDatabase, cmdCreateEmpire, cmdCreateGame, cmdCreateStarList, cmdCreateSystemMap)
+	cmdCreate.AddCommand(cmdCreateAnnouncement, cmdCreateDatabase, cmdCreateEmpire, cmdCreateGame, cmdCreateOrders, cmdCreateStarList, cmdCreateSystemMap)
+
+	cmdCreateAnnouncement.Flags().String("article", "", "text of the announcement")
+	if err := cmdCreateAnnouncement.MarkFlagRequired("article"); err != nil {
+		log.Printf("error: initialize: flag %q: required: %v\n", "article", err)
+		return nil, err
+	}
+	cmdCreateAnnouncement.Flags().String("signature", "GM", "signature printed after the announcement")
 
 	cmdCreateDatabase.Flags().BoolVar(&flags.Database.ForceCreate, "force-create", flags.Database.ForceCreate, "force creation of the database")
 	cmdCreateDatabase.Flags().StringVar(&flags.Database.Path, "path", flags.Database.Path, "path to the database")
@@ -72,14 +79,23 @@ func Initialize(options ...Option) (*cobra.Command, error) {
 		return nil, err
 	}
 
+	cmdCreateOrders.Flags().Int64("empire", 0, "id of the empire issuing the orders")
+	cmdCreateOrders.Flags().String("input", "", "path to the orders file")
+	if err := cmdCreateOrders.MarkFlagRequired("input"); err != nil {
+		log.Printf("error: initialize: flag %q: required: %v\n", "input", err)
+		return nil, err
+	}
+
 	cmdDB.PersistentFlags().String("path", "", "path to the database")
 	if err := cmdDB.MarkPersistentFlagRequired("path"); err != nil {
 		log.Printf("error: initialize: flag %q: required: %v\n", "path", err)
 		return nil, err
 	}
-	cmdDB.AddCommand(cmdDBCreate, cmdDBOpen)
+	cmdDB.AddCommand(cmdDBCreate, cmdDBMigrate, cmdDBOpen)
+
+	cmdStart.AddCommand(cmdStartGame)
 
-	cmdExecute.AddCommand(cmdExecuteProbes, cmdExecuteReset, cmdExecuteSurveys)
+	cmdExecute.AddCommand(cmdExecuteAssemblies, cmdExecuteCombat, cmdExecuteDrafts, cmdExecuteEspionage, cmdExecuteJumps, cmdExecuteMarket, cmdExecuteMoves, cmdExecuteNames, cmdExecuteNews, cmdExecutePermissions, cmdExecuteProbes, cmdExecuteRecycles, cmdExecuteResearch, cmdExecuteReset, cmdExecuteRetools, cmdExecuteSetups, cmdExecuteSurveys, cmdExecuteTransfers)
 
 	cmdExport.AddCommand(cmdExportEmpires)
 	cmdExportEmpires.Flags().String("output", "", "path to create the exports in")
@@ -88,7 +104,35 @@ func Initialize(options ...Option) (*cobra.Command, error) {
 		return nil, err
 	}
 
+	cmdPlan.AddCommand(cmdPlanRoute)
+	cmdPlanRoute.Flags().Int64("ship", 0, "id of the ship that will jump")
+	if err := cmdPlanRoute.MarkFlagRequired("ship"); err != nil {
+		log.Printf("error: initialize: flag %q: required: %v\n", "ship", err)
+		return nil, err
+	}
+	cmdPlanRoute.Flags().Int64("to", 0, "id of the destination system")
+	if err := cmdPlanRoute.MarkFlagRequired("to"); err != nil {
+		log.Printf("error: initialize: flag %q: required: %v\n", "to", err)
+		return nil, err
+	}
+	cmdPlanRoute.Flags().Bool("cheapest", false, "plan the route that needs the least fuel")
+
 	cmdShow.AddCommand(cmdShowEnv)
 
+	cmdSim.AddCommand(cmdSimBattle)
+	cmdSimBattle.Flags().String("kind", "invade", "kind of battle: bombard, invade, or raid")
+	cmdSimBattle.Flags().String("attacker", "", "forces committed by the attacker")
+	if err := cmdSimBattle.MarkFlagRequired("attacker"); err != nil {
+		log.Printf("error: initialize: flag %q: required: %v\n", "attacker", err)
+		return nil, err
+	}
+	cmdSimBattle.Flags().String("defender", "", "forces of the defender")
+	if err := cmdSimBattle.MarkFlagRequired("defender"); err != nil {
+		log.Printf("error: initialize: flag %q: required: %v\n", "defender", err)
+		return nil, err
+	}
+	cmdSimBattle.Flags().Int("runs", 1000, "number of battles to fight")
+	cmdSimBattle.Flags().Uint64("seed", 1, "seed for the random number generator")
+
 	return cmdRoot, nil
 }
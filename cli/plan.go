@@ -0,0 +1,70 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/playbymail/empyr/engine"
+	"github.com/playbymail/empyr/pkg/empyr"
+	"github.com/playbymail/empyr/repos"
+	"github.com/spf13/cobra"
+	"log"
+	"strconv"
+)
+
+// this file implements the commands to help players plan orders
+
+var cmdPlan = &cobra.Command{
+	Use:   "plan",
+	Short: "plan orders",
+	Long:  `plan is the root of the planning commands.`,
+}
+
+var cmdPlanRoute = &cobra.Command{
+	Use:   "route",
+	Short: "plan a series of jumps",
+	Long:  `plan the series of jumps that takes a ship to another system, with the fuel needed for each jump.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		shipID, err := strconv.ParseInt(cmd.Flag("ship").Value.String(), 10, 64)
+		if err != nil || shipID < 1 {
+			log.Fatalf("error: ship: invalid id\n")
+		}
+		systemID, err := strconv.ParseInt(cmd.Flag("to").Value.String(), 10, 64)
+		if err != nil || systemID < 1 {
+			log.Fatalf("error: to: invalid system id\n")
+		}
+		cheapest := cmd.Flag("cheapest").Value.String() == "true"
+
+		repo, err := repos.Open(flags.Database.Path, context.Background())
+		if err != nil {
+			log.Fatalf("error: store.open: %v\n", err)
+		}
+		defer repo.Close()
+		e, err := engine.Open(repo)
+		if err != nil {
+			log.Fatalf("error: engine.open: %v\n", err)
+		}
+		route, err := engine.PlanRouteCommand(e, &engine.PlanRouteParams_t{
+			ShipID:   shipID,
+			SystemID: systemID,
+			Cheapest: cheapest,
+		})
+		if err != nil && !errors.Is(err, empyr.ErrInsufficientFuel) {
+			log.Fatalf("error: route: %v\n", err)
+		}
+		if len(route.Legs) == 0 {
+			fmt.Printf("ship %d is already in %s\n", route.ShipID, route.To)
+			return
+		}
+		fmt.Printf("ship %d: %s to %s: %d jumps\n", route.ShipID, route.From, route.To, len(route.Legs))
+		for n, leg := range route.Legs {
+			fmt.Printf("  %2d: %-24s %-24s %8.2f ly %12.0f fuel\n", n+1, leg.From, leg.To, leg.Distance, leg.Fuel)
+		}
+		fmt.Printf("  total: %.2f ly, %.0f fuel, %.0f fuel on hand\n", route.Distance, route.Fuel, route.FuelOnHand)
+		if err != nil {
+			fmt.Printf("  warning: %v\n", err)
+		}
+	},
+}
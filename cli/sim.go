@@ -0,0 +1,60 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package cli
+
+import (
+	"fmt"
+	"github.com/playbymail/empyr/engine"
+	"github.com/spf13/cobra"
+	"log"
+	"strconv"
+)
+
+// this file implements the commands to simulate parts of a turn without a database
+
+var cmdSim = &cobra.Command{
+	Use:   "sim",
+	Short: "simulate things",
+	Long:  `sim is the root of the simulation commands.`,
+}
+
+var cmdSimBattle = &cobra.Command{
+	Use:   "battle",
+	Short: "estimate the outcome of a battle",
+	Long: `fight the same battle many times and report the attacker's odds of winning and the expected losses on each side.
+
+Forces are comma separated lists of CODE-TL:QTY entries, eg "SLD:20000,MTSP:50000,ASW-2:1000,MTBT-1:500".
+SLD is the number of soldiers. FUEL and MTSP may be given without a tech level. MASS adds mass that doesn't fight.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		runs, err := strconv.Atoi(cmd.Flag("runs").Value.String())
+		if err != nil || runs < 1 {
+			log.Fatalf("error: runs: must be a positive number\n")
+		}
+		seed, err := strconv.ParseUint(cmd.Flag("seed").Value.String(), 10, 64)
+		if err != nil {
+			log.Fatalf("error: seed: %v\n", err)
+		}
+		odds, err := engine.SimulateBattleCommand(&engine.SimulateBattleParams_t{
+			Kind:     cmd.Flag("kind").Value.String(),
+			Attacker: cmd.Flag("attacker").Value.String(),
+			Defender: cmd.Flag("defender").Value.String(),
+			Runs:     runs,
+			Seed:     seed,
+		})
+		if err != nil {
+			log.Fatalf("error: battle: %v\n", err)
+		}
+		fmt.Printf("%s: %d battles: attacker won %d (%.1f%%), %.2f rounds on average\n", odds.Kind, odds.Runs, odds.AttackerWins, odds.WinPct*100, odds.Rounds)
+		for _, side := range []struct {
+			name   string
+			losses *engine.ExpectedLosses_t
+		}{{"attacker", odds.Attacker}, {"defender", odds.Defender}} {
+			fmt.Printf("  %s: %.0f combat factors, expected losses:\n", side.name, side.losses.Factors)
+			fmt.Printf("    %-8s %14.1f\n", "SLD", side.losses.Soldiers)
+			for _, code := range side.losses.Codes() {
+				fmt.Printf("    %-8s %14.1f\n", code, side.losses.Units[code])
+			}
+			fmt.Printf("    %-8s %13.1f%%\n", "mass", side.losses.MassPct*100)
+		}
+	},
+}
@@ -2,9 +2,43 @@
 
 package cli
 
-import "github.com/spf13/cobra"
+import (
+	"context"
+	"github.com/playbymail/empyr/engine"
+	"github.com/playbymail/empyr/repos"
+	"github.com/spf13/cobra"
+	"log"
+	"time"
+)
 
 var cmdStart = &cobra.Command{
 	Use:   "start",
 	Short: "start application components",
 }
+
+// cmdStartGame implements the start game command
+var cmdStartGame = &cobra.Command{
+	Use:   "game",
+	Short: "start the game",
+	Long:  `Start the game. Turn 0 is the setup turn; orders are executed from turn 1.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		started := time.Now()
+		defer func() {
+			log.Printf("start: game: elapsed time: %v\n", time.Now().Sub(started))
+		}()
+		repo, err := repos.Open(flags.Database.Path, context.Background())
+		if err != nil {
+			log.Fatalf("error: store.open: %v\n", err)
+		}
+		defer repo.Close()
+		e, err := engine.Open(repo)
+		if err != nil {
+			log.Fatalf("error: engine.open: %v\n", err)
+		}
+		err = engine.StartGameCommand(e, &engine.StartGameParams_t{Code: flags.Game.Code})
+		if err != nil {
+			log.Fatalf("error: engine.StartGameCommand: %v\n", err)
+		}
+		log.Printf("start: game: started game %s\n", flags.Game.Code)
+	},
+}
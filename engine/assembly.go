@@ -0,0 +1,62 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package engine
+
+import "math"
+
+// this file implements the rules for assembling and storing units.
+//
+// Operational units must be assembled before they can be used. Assembling
+// units takes them out of storage; storing units disassembles them and puts
+// them back in storage. Both need construction workers (CNW). Each worker
+// can assemble or disassemble assemblyMassPerWorker units of mass in a
+// turn, and a worker who has worked on one order can't work on another.
+// If there aren't enough workers for the whole order, as many units as the
+// workers can handle are assembled or stored.
+//
+// Factories, farms, labs, and mines work in groups. They are assembled into
+// a group and stored from a group. Assembling units into a group that does
+// not exist creates it; a new mine group must be given a deposit to work
+// and a new factory group must be given an item to manufacture. The item
+// may not have a higher tech level than the ship or colony. Farms and labs
+// go to group 1 if no group is given.
+//
+// Assembling or storing units changes the space and life support of the
+// ship or colony. The order fails if the ship or colony would not have
+// enough of either afterward. See capacity.go for the rules.
+
+const (
+	assemblyMassPerWorker = 100 // mass one construction worker can assemble or disassemble in a turn
+)
+
+// assemblyWorkers returns the number of construction workers needed to
+// assemble or disassemble units.
+func assemblyWorkers(unitCd string, techLevel, qty int64) int64 {
+	return int64(math.Ceil(Mass(unitCd, techLevel, qty) / assemblyMassPerWorker))
+}
+
+// assemblyQty returns the number of units, up to qty, that the workers can
+// assemble or disassemble.
+func assemblyQty(unitCd string, techLevel, qty, workers int64) int64 {
+	massPerUnit := Mass(unitCd, techLevel, 1)
+	if massPerUnit <= 0 {
+		return qty
+	}
+	return min(qty, int64(float64(workers)*assemblyMassPerWorker/massPerUnit))
+}
+
+// groupKind returns the kind of group that a unit works in, or an empty
+// string if the unit doesn't work in a group.
+func groupKind(unitCd string) string {
+	switch unitCd {
+	case "FCT":
+		return "factory"
+	case "FRM":
+		return "farm"
+	case "LAB":
+		return "lab"
+	case "MIN":
+		return "mine"
+	}
+	return ""
+}
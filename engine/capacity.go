@@ -0,0 +1,136 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package engine
+
+// this file implements the rules for the capacity of ships and colonies.
+//
+// Assembled structure (STU) and light structure (SLS) enclose the space
+// that a ship or colony uses to hold its units. Each unit of structure
+// encloses TL² units of volume divided by the type factor for the kind of
+// ship or colony (see sc_codes.type_factor). Open surface colonies use the
+// planet's surface, so they need the least structure; ships need the most.
+// Light structure may only be built in orbital colonies and doesn't
+// enclose anything in other ships or colonies.
+//
+// Assembled life supports (LFS) support TL² people each. Open surface
+// colonies live on the planet and don't need life support.
+//
+// Everything else in the inventory, including assembled life supports,
+// uses the enclosed space. Units may not be added to a ship or colony if
+// they would use more space than is enclosed, and people may not be added
+// if there isn't enough life support for them.
+//
+// There is no population growth phase yet. When one is added, it must cap
+// the people born with capGrowth.
+//
+// Units and resources with a tech level of 0 are treated as tech level 1.
+
+// capacity_t is the space and life support of a ship or colony.
+type capacity_t struct {
+	scCd       string
+	typeFactor int64   // divides the volume enclosed by structure
+	enclosed   float64 // volume enclosed by assembled structure
+	used       float64 // volume used by everything else
+	supported  int64   // people supported by assembled life support
+	population int64   // people in the ship or colony
+}
+
+// newCapacity returns an empty capacity for a kind of ship or colony.
+func newCapacity(scCd string, typeFactor int64) *capacity_t {
+	return &capacity_t{scCd: scCd, typeFactor: typeFactor}
+}
+
+// addUnits adds units to the capacity. volume is the volume of the units
+// in their current state.
+func (c *capacity_t) addUnits(unitCd string, techLevel, qty int64, volume float64, isAssembled bool) {
+	if isAssembled {
+		switch unitCd {
+		case "SLS", "STU":
+			c.enclosed += enclosedVolume(c.scCd, c.typeFactor, unitCd, techLevel, qty)
+			return
+		case "LFS":
+			c.supported += lifeSupportCapacity(techLevel, qty)
+		}
+	}
+	c.used += volume
+}
+
+// assemble updates the capacity for units that are taken out of storage
+// and assembled or, if isAssembled is false, disassembled and stored.
+func (c *capacity_t) assemble(unitCd string, techLevel, qty int64, isAssembled bool) {
+	c.addUnits(unitCd, techLevel, -qty, -inventoryVolume(unitCd, techLevel, qty, !isAssembled, isAssembled), !isAssembled)
+	c.addUnits(unitCd, techLevel, qty, inventoryVolume(unitCd, techLevel, qty, isAssembled, !isAssembled), isAssembled)
+}
+
+// availableVolume returns the enclosed space that isn't used.
+func (c *capacity_t) availableVolume() float64 {
+	return c.enclosed - c.used
+}
+
+// hasRoomFor returns true if there is enough enclosed space for units
+// with the given volume.
+func (c *capacity_t) hasRoomFor(volume float64) bool {
+	return volume <= c.availableVolume()
+}
+
+// canSupport returns true if there is enough life support for the given
+// number of people in addition to the current population.
+func (c *capacity_t) canSupport(people int64) bool {
+	return !needsLifeSupport(c.scCd) || c.population+people <= c.supported
+}
+
+// growthRoom returns the number of people that can be added before the
+// life support runs out, or -1 if the people don't need life support.
+func (c *capacity_t) growthRoom() int64 {
+	if !needsLifeSupport(c.scCd) {
+		return -1
+	}
+	return max(c.supported-c.population, 0)
+}
+
+// capGrowth returns the number of people, up to qty, that can be added to
+// the ship or colony by population growth.
+func (c *capacity_t) capGrowth(qty int64) int64 {
+	if room := c.growthRoom(); room >= 0 {
+		return min(qty, room)
+	}
+	return qty
+}
+
+// enclosedVolume returns the volume enclosed by assembled structure.
+// Units other than structure and light structure don't enclose anything,
+// and light structure only encloses space in an orbital colony.
+func enclosedVolume(scCd string, typeFactor int64, unitCd string, techLevel, qty int64) float64 {
+	if unitCd == "SLS" && scCd != "CORB" {
+		return 0
+	}
+	switch unitCd {
+	case "SLS", "STU":
+		techLevel = max(techLevel, 1)
+		return float64(qty*techLevel*techLevel) / float64(max(typeFactor, 1))
+	}
+	return 0
+}
+
+// lifeSupportCapacity returns the number of people supported by assembled
+// life supports.
+func lifeSupportCapacity(techLevel, qty int64) int64 {
+	techLevel = max(techLevel, 1)
+	return qty * techLevel * techLevel
+}
+
+// needsLifeSupport returns true if the people in a ship or colony need
+// life support.
+func needsLifeSupport(scCd string) bool {
+	return scCd != "COPN"
+}
+
+// isStructure returns true if the unit is structure, light structure, or
+// life support. These units are assembled when a ship or colony is set up.
+func isStructure(unitCd string) bool {
+	switch unitCd {
+	case "LFS", "SLS", "STU":
+		return true
+	}
+	return false
+}
@@ -9,6 +9,7 @@ import (
 )
 
 const (
+	ErrInvalidArticle     = Error("invalid article")
 	ErrInvalidCode        = Error("invalid code")
 	ErrInvalidDescription = Error("invalid description")
 	ErrInvalidHandle      = Error("invalid handle")
@@ -16,6 +17,18 @@ const (
 	ErrMissingHandle      = Error("missing handle")
 )
 
+// IsValidArticle validates whether a news article or signature meets specific requirements.
+// It checks that the text is between 1 and maxLength characters long and does not contain
+// any special escape sequences or unusual characters.
+func IsValidArticle(text string, maxLength int) (bool, error) {
+	if !(1 <= len(text) && len(text) <= maxLength) {
+		return false, errors.Join(fmt.Errorf("text must be between 1...%d characters", maxLength), ErrInvalidArticle)
+	} else if isWeird(text) {
+		return false, errors.Join(fmt.Errorf("text must not contain special characters"), ErrInvalidArticle)
+	}
+	return true, nil
+}
+
 // IsValidCode validates whether a game code meets specific formatting requirements.
 // It checks that the code is between 3 and 5 characters long and starts with uppercase letters,
 // optionally followed by numbers. Returns true if the code is valid, otherwise returns false
@@ -0,0 +1,236 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package engine
+
+import (
+	"fmt"
+	"github.com/playbymail/empyr/repos/sqlite"
+	"math"
+	"sort"
+	"strings"
+)
+
+// this file implements helpers for building the sections of a colony report.
+// they are used for the empire's own colonies and for the reports that
+// spies steal from foreign colonies.
+
+// readColonyCensus returns the census section of a colony report.
+func (e *Engine_t) readColonyCensus(scID, turnNo int64) (*ColonyCensusReport_t, error) {
+	popRows, err := e.Store.Queries.ReadSCPopulation(e.Store.Context, sqlite.ReadSCPopulationParams{ScID: scID, AsOfDt: turnNo})
+	if err != nil {
+		return nil, err
+	}
+	census := &ColonyCensusReport_t{}
+	totalPopulation, totalPay := int64(0), int64(0)
+	for _, popRow := range popRows {
+		totPay := int64(math.Ceil(float64(popRow.Qty) * popRow.PayRate))
+		census.Population = append(census.Population, &PopulationReport_t{
+			Group:      popRow.PopulationCd,
+			Population: commas(popRow.Qty),
+			PayRate:    fmt.Sprintf("%6.4f", popRow.PayRate),
+			TotalPay:   commas(totPay),
+			qty:        popRow.Qty,
+		})
+		totalPopulation += popRow.Qty
+		totalPay += totPay
+	}
+	census.TotalPopulation = commas(totalPopulation)
+	census.TotalPay = commas(totalPay)
+	for _, group := range census.Population {
+		group.PctTotalPop = fmt.Sprintf("%6.2f %%", 100*float64(group.qty)/float64(totalPopulation))
+	}
+	return census, nil
+}
+
+// readColonyInventory returns the inventory section of a colony report,
+// sorted by code and tech level.
+func (e *Engine_t) readColonyInventory(scID, turnNo int64) ([]*ColonyInventoryLine_t, error) {
+	inventoryRows, err := e.Store.Queries.ReadSCInventory(e.Store.Context, sqlite.ReadSCInventoryParams{ScID: scID, AsOfDt: turnNo})
+	if err != nil {
+		return nil, err
+	}
+	type inventoryLine_t struct {
+		id              string
+		code            string
+		techLevel       int64
+		nonAssemblyQty  int64
+		assembledQty    int64
+		disassembledQty int64
+	}
+	inventoryMap := map[string]*inventoryLine_t{}
+	for _, item := range inventoryRows {
+		code := codeTL(item.UnitCd, item.UnitTechLevel)
+		line, ok := inventoryMap[code]
+		if !ok {
+			line = &inventoryLine_t{id: code, code: item.UnitCd, techLevel: item.UnitTechLevel}
+			inventoryMap[code] = line
+		}
+		if IsOperational(line.code) {
+			if item.IsAssembled == 1 {
+				line.assembledQty += item.Qty
+			} else { // assumes disassembled are in storage
+				line.disassembledQty += item.Qty
+			}
+		} else { // assumes non-operational are always in storage
+			line.nonAssemblyQty += item.Qty
+		}
+	}
+	// sort the inventory lines by code and tech level
+	var inventoryLines []*inventoryLine_t
+	for _, line := range inventoryMap {
+		inventoryLines = append(inventoryLines, line)
+	}
+	sort.Slice(inventoryLines, func(i, j int) bool {
+		if inventoryLines[i].code == inventoryLines[j].code {
+			return inventoryLines[i].techLevel < inventoryLines[j].techLevel
+		}
+		return inventoryLines[i].code < inventoryLines[j].code
+	})
+	var inventory []*ColonyInventoryLine_t
+	for _, line := range inventoryLines {
+		inventory = append(inventory, &ColonyInventoryLine_t{
+			Code:            line.id,
+			NonAssemblyQty:  commas(line.nonAssemblyQty),
+			DisassembledQty: commas(line.disassembledQty),
+			AssembledQty:    commas(line.assembledQty),
+			IsOPU:           IsOperational(line.code),
+		})
+	}
+	return inventory, nil
+}
+
+// readColonyOther returns the other statistics section of a colony report,
+// with the space, life support, and power available to the colony.
+func (e *Engine_t) readColonyOther(scID, turnNo int64) (*ColonyOtherReport_t, error) {
+	inventoryRows, err := e.Store.Queries.ReadSCInventory(e.Store.Context, sqlite.ReadSCInventoryParams{ScID: scID, AsOfDt: turnNo})
+	if err != nil {
+		return nil, err
+	}
+	capacity, err := e.readCapacity(e.Store.Queries, scID, turnNo)
+	if err != nil {
+		return nil, err
+	}
+	power, powerAvailable, err := e.readPower(e.Store.Queries, scID, turnNo)
+	if err != nil {
+		return nil, err
+	}
+	other := &ColonyOtherReport_t{
+		TotalMass:            commas(int64(math.Ceil(inventoryMass(inventoryRows)))),
+		TotalVolume:          commas(int64(capacity.enclosed)),
+		AvailableVolume:      commas(int64(capacity.availableVolume())),
+		LifeSupport:          "not needed",
+		LifeSupportAvailable: "not needed",
+		Power:                commas(power),
+		PowerAvailable:       commas(powerAvailable),
+	}
+	if needsLifeSupport(capacity.scCd) {
+		other.LifeSupport = commas(capacity.supported)
+		other.LifeSupportAvailable = commas(capacity.growthRoom())
+	}
+	return other, nil
+}
+
+// readColonyTransports returns the transport section of a colony report,
+// with the mass carried by the colony's transports on the turn.
+func (e *Engine_t) readColonyTransports(scID, turnNo int64) (*ColonyTransportReport_t, error) {
+	transport, err := e.readTransport(e.Store.Queries, scID, turnNo)
+	if err != nil {
+		return nil, err
+	}
+	transport.used, err = e.Store.Queries.ReadSCTransportUsed(e.Store.Context, sqlite.ReadSCTransportUsedParams{ScID: scID, Effdt: turnNo})
+	if err != nil {
+		return nil, err
+	}
+	return &ColonyTransportReport_t{
+		Capacity:  commas(int64(transport.capacity)),
+		Used:      commas(int64(transport.used)),
+		Available: commas(int64(transport.available())),
+	}, nil
+}
+
+// readColonyLabor returns the labor section of a colony report, with the
+// labor assigned to each group and any shortages.
+func (e *Engine_t) readColonyLabor(scID, turnNo int64) (*ColonyLaborReport_t, error) {
+	staffing, err := e.readStaffing(e.Store.Queries, scID, turnNo)
+	if err != nil {
+		return nil, err
+	}
+	labor := &ColonyLaborReport_t{
+		ProAvailable: commas(staffing.available.pro),
+		ProUnused:    commas(staffing.left.pro),
+		UskAvailable: commas(staffing.available.usk),
+		UskUnused:    commas(staffing.left.usk),
+		AutAvailable: commas(staffing.available.aut),
+		AutUnused:    commas(staffing.left.aut),
+	}
+	for _, group := range staffing.groups {
+		rpt := &ColonyLaborGroupReport_t{
+			Group:     fmt.Sprintf("%s %02d", group.kind, group.groupNo),
+			ProWanted: commas(group.wanted.pro),
+			ProUsed:   commas(group.used.pro),
+			UskWanted: commas(group.wanted.usk),
+			UskUsed:   commas(group.used.usk),
+			AutUsed:   commas(group.used.aut),
+		}
+		var shortages []string
+		pro, usk := group.shortage()
+		if pro > 0 {
+			shortages = append(shortages, fmt.Sprintf("%s PRO", commas(pro)))
+		}
+		if usk > 0 {
+			shortages = append(shortages, fmt.Sprintf("%s USK", commas(usk)))
+		}
+		rpt.Shortage = strings.Join(shortages, ", ")
+		labor.Groups = append(labor.Groups, rpt)
+	}
+	return labor, nil
+}
+
+// readColonyFactoryGroups returns the factory groups section of a colony
+// report, with the work in progress at the end of the turn.
+func (e *Engine_t) readColonyFactoryGroups(scID, turnNo int64) ([]*ColonyFactoryGroupsReport_t, error) {
+	groupRows, err := e.Store.Queries.ReadSCGroupTooling(e.Store.Context, sqlite.ReadSCGroupToolingParams{ScID: scID, AsOfDt: turnNo})
+	if err != nil {
+		return nil, err
+	}
+	var groups []*ColonyFactoryGroupsReport_t
+	for _, groupRow := range groupRows {
+		code := codeTL(groupRow.ItemCd, groupRow.ItemTechLevel)
+		rpt := &ColonyFactoryGroupsReport_t{
+			GroupNo: fmt.Sprintf("%02d", groupRow.GroupNo),
+			Orders:  code,
+		}
+		if groupRow.Retooled == 1 {
+			rpt.Orders += " *"
+			rpt.RetoolTurn = fmt.Sprintf("%d", groupRow.Effdt)
+		}
+		unitRows, err := e.Store.Queries.ReadSCGroupUnitsByGroup(e.Store.Context, sqlite.ReadSCGroupUnitsByGroupParams{GroupID: groupRow.GroupID, AsOfDt: turnNo})
+		if err != nil {
+			return nil, err
+		}
+		wipRows, err := e.Store.Queries.ReadSCGroupUnitWIPByGroup(e.Store.Context, sqlite.ReadSCGroupUnitWIPByGroupParams{GroupID: groupRow.GroupID, ProductionDt: turnNo})
+		if err != nil {
+			return nil, err
+		}
+		wip := map[int64]sqlite.ReadSCGroupUnitWIPByGroupRow{}
+		for _, wipRow := range wipRows {
+			wip[wipRow.TechLevel] = wipRow
+		}
+		for _, unitRow := range unitRows {
+			rptLine := &ColonyFactoryGroupReport_t{
+				TechLevel:  unitRow.TechLevel,
+				NbrOfUnits: commas(unitRow.NbrOfUnits),
+			}
+			for i, qty := range []int64{wip[unitRow.TechLevel].Wip25pctQty, wip[unitRow.TechLevel].Wip50pctQty, wip[unitRow.TechLevel].Wip75pctQty} {
+				rptLine.Pipeline[i] = &ColonyFactoryPipelineReport_t{
+					Percentage: fmt.Sprintf("%d%%", 25*(i+1)),
+					Unit:       code,
+					Qty:        commas(qty),
+				}
+			}
+			rpt.Units = append(rpt.Units, rptLine)
+		}
+		groups = append(groups, rpt)
+	}
+	return groups, nil
+}
@@ -0,0 +1,351 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package engine
+
+import (
+	"math"
+	"math/rand/v2"
+	"sort"
+)
+
+// this file implements the rules for resolving a battle between two forces.
+//
+// A bombardment is fought in space. Each round, both sides fire at the same
+// time. Missile launchers (MSL) launch one missile (MSS) of the same tech
+// level per round; each missile that gets through destroys 100 x TL^2 mass.
+// Anti-missiles (ANM) need a launcher of the same tech level and intercept
+// three out of four missiles on average. Energy weapons (EWP) burn 4 x TL
+// fuel per shot and deliver 10 x TL^2 energy units; energy shields (ESH)
+// burn 10 x TL fuel per use and deflect 10 x TL^2 energy units. Energy that
+// isn't deflected destroys the same amount of mass. EWP, ESH, and MSL must
+// be assembled and need 1 soldier per 100 units. The soldiers are shared by
+// all the weapons, so a soldier crews at most 100 units in a round. Units
+// lost to weapons fire are reported as destroyed.
+//
+// An invasion or raid is fought on the ground. Each soldier (SLD) provides
+// 1 combat factor and uses 1 military supply (MTSP) per round; soldiers
+// without supplies don't fight. Military robots (MTBT) fight like 2 x TL
+// soldiers and use 2 x TL supplies. Assault weapons (ASW) provide 2 x TL^2
+// factors and assault craft (ASC) 10 x TL factors; each needs a soldier or
+// robot equivalent to operate it, and assault craft burn 0.1 fuel per round.
+// Each round, a side loses up to half of its forces, in proportion to the
+// share of the factors held by the enemy. A side is routed when the enemy
+// has three times its factors.
+
+const (
+	combatSpaceRounds  = 3   // maximum number of rounds in a bombardment
+	combatGroundRounds = 5   // maximum number of rounds in an invasion or raid
+	combatRoutRatio    = 3.0 // a side is routed when outnumbered by this ratio
+	combatInterceptPct = 0.75
+	combatLossRate     = 0.5
+)
+
+// CombatUnit_t is the key for a unit in a combat force.
+type CombatUnit_t struct {
+	Code      string
+	TechLevel int64
+}
+
+// CombatForce_t is the forces that one side commits to a battle.
+type CombatForce_t struct {
+	Units    map[CombatUnit_t]int64 // combat units, eg {"ASW", 2}: 1,000
+	Soldiers int64                  // soldiers (SLD) committed
+	Supplies int64                  // military supplies (MTSP) committed
+	Fuel     int64                  // fuel available to operate weapons and shields
+	Mass     float64                // total mass of the ships and colonies on this side
+}
+
+// CombatLosses_t is what one side lost in a battle.
+type CombatLosses_t struct {
+	Expended  map[CombatUnit_t]int64 // MSS and ANM fired, plus FUEL and MTSP used (tech level 0)
+	Destroyed map[CombatUnit_t]int64 // combat units destroyed by weapons fire or in ground combat
+	Soldiers  int64                  // soldiers killed by weapons fire or in ground combat
+	MassPct   float64                // fraction of the total mass destroyed by weapons fire
+	Factors   float64                // ground combat factors at the start of the battle
+}
+
+// CombatOutcome_t is the result of a battle.
+type CombatOutcome_t struct {
+	Kind         string // "bombard", "invade", or "raid"
+	Rounds       int
+	AttackerWins bool
+	Attacker     CombatLosses_t
+	Defender     CombatLosses_t
+}
+
+// combatSide_t tracks the state of one side while a battle is fought.
+type combatSide_t struct {
+	units     map[CombatUnit_t]int64
+	soldiers  int64
+	supplies  int64
+	fuel      float64
+	crew      int64   // units that the soldiers can still crew this round
+	mass      float64 // mass remaining
+	massLeft  float64 // fraction of the mass remaining
+	expended  map[CombatUnit_t]int64
+	destroyed map[CombatUnit_t]int64
+	killed    int64
+	fuelUsed  float64
+	supplied  int64 // supplies used
+}
+
+func newCombatSide(f *CombatForce_t) *combatSide_t {
+	s := &combatSide_t{
+		units:     map[CombatUnit_t]int64{},
+		soldiers:  f.Soldiers,
+		supplies:  f.Supplies,
+		fuel:      float64(f.Fuel),
+		mass:      f.Mass,
+		massLeft:  1,
+		expended:  map[CombatUnit_t]int64{},
+		destroyed: map[CombatUnit_t]int64{},
+	}
+	for k, v := range f.Units {
+		if v > 0 {
+			s.units[k] = v
+		}
+	}
+	return s
+}
+
+// ResolveCombat fights a battle between an attacker and a defender.
+// The forces are not modified. The random number generator adds noise
+// to the damage dealt, so the same battle can have different outcomes.
+func ResolveCombat(r *rand.Rand, kind string, attacker, defender *CombatForce_t) *CombatOutcome_t {
+	a, d := newCombatSide(attacker), newCombatSide(defender)
+	out := &CombatOutcome_t{Kind: kind}
+	out.Attacker.Factors, out.Defender.Factors = a.groundFactors(false), d.groundFactors(false)
+
+	switch kind {
+	case "bombard":
+		for out.Rounds < combatSpaceRounds && a.massLeft > 0 && d.massLeft > 0 {
+			out.Rounds++
+			a.muster()
+			d.muster()
+			toDefender, toAttacker := a.fire(r, d), d.fire(r, a)
+			d.takeHits(toDefender)
+			a.takeHits(toAttacker)
+		}
+		out.AttackerWins = d.massLeft < a.massLeft
+	case "invade", "raid":
+		// the attacker only wins if it routs the defender before time runs out.
+		// an attacker without ground factors can't win, and an invasion needs
+		// soldiers to occupy the colony.
+		switch fa, fd := a.groundFactors(false), d.groundFactors(false); {
+		case fa == 0, kind == "invade" && attacker.Soldiers == 0:
+			// the attacker has nothing to fight with
+		case fd == 0:
+			out.AttackerWins = true
+		default:
+			for out.Rounds < combatGroundRounds {
+				out.Rounds++
+				fa, fd = a.groundFactors(true), d.groundFactors(true)
+				a.casualties(min(1, combatLossRate*fd/(fa+fd)*combatNoise(r)))
+				d.casualties(min(1, combatLossRate*fa/(fa+fd)*combatNoise(r)))
+				fa, fd = a.groundFactors(false), d.groundFactors(false)
+				if fd == 0 || fa >= combatRoutRatio*fd {
+					out.AttackerWins = true
+					break
+				} else if fa == 0 || fd >= combatRoutRatio*fa {
+					break
+				}
+			}
+		}
+	}
+
+	out.Attacker, out.Defender = a.losses(out.Attacker.Factors), d.losses(out.Defender.Factors)
+	return out
+}
+
+// combatNoise returns a random factor between 0.75 and 1.25.
+func combatNoise(r *rand.Rand) float64 {
+	return 0.75 + 0.5*r.Float64()
+}
+
+// muster assigns the soldiers to crew the weapons for a round.
+// Energy weapons, shields, and missile launchers need 1 soldier per 100 units.
+func (s *combatSide_t) muster() {
+	s.crew = s.soldiers * 100
+}
+
+// man crews up to qty units from the soldiers that are left this round.
+// It returns the number of units crewed.
+func (s *combatSide_t) man(qty int64) int64 {
+	qty = max(min(qty, s.crew), 0)
+	s.crew -= qty
+	return qty
+}
+
+// expend removes consumable units from the force.
+func (s *combatSide_t) expend(key CombatUnit_t, qty int64) {
+	s.units[key] -= qty
+	s.expended[key] += qty
+}
+
+// burn uses fuel from the force.
+func (s *combatSide_t) burn(fuel float64) {
+	s.fuel -= fuel
+	s.fuelUsed += fuel
+}
+
+// fire launches missiles and fires energy weapons at the target.
+// It returns the mass destroyed on the target.
+func (s *combatSide_t) fire(r *rand.Rand, target *combatSide_t) float64 {
+	var mass, energy float64
+	for techLevel := int64(1); techLevel <= 10; techLevel++ {
+		tl := float64(techLevel)
+		missile := CombatUnit_t{Code: "MSS", TechLevel: techLevel}
+		if launched := s.man(min(s.units[CombatUnit_t{Code: "MSL", TechLevel: techLevel}], s.units[missile])); launched > 0 {
+			s.expend(missile, launched)
+			mass += float64(launched-target.intercept(r, techLevel, launched)) * 100 * tl * tl
+		}
+		if shots := s.man(min(s.units[CombatUnit_t{Code: "EWP", TechLevel: techLevel}], int64(s.fuel/(4*tl)))); shots > 0 {
+			s.burn(float64(shots) * 4 * tl)
+			energy += float64(shots) * 10 * tl * tl
+		}
+	}
+	mass += energy - target.deflect(energy)
+	return mass * combatNoise(r)
+}
+
+// intercept fires anti-missiles at incoming missiles of the same tech level.
+// It returns the number of missiles destroyed.
+func (s *combatSide_t) intercept(r *rand.Rand, techLevel, launched int64) int64 {
+	anm := CombatUnit_t{Code: "ANM", TechLevel: techLevel}
+	fired := s.man(min(s.units[anm], s.units[CombatUnit_t{Code: "MSL", TechLevel: techLevel}], launched))
+	if fired == 0 {
+		return 0
+	}
+	s.expend(anm, fired)
+	return min(launched, int64(math.Round(float64(fired)*combatInterceptPct*combatNoise(r))))
+}
+
+// deflect uses shields to deflect incoming energy, highest tech level first.
+// It returns the energy deflected.
+func (s *combatSide_t) deflect(energy float64) (deflected float64) {
+	for techLevel := int64(10); techLevel >= 1 && deflected < energy; techLevel-- {
+		tl := float64(techLevel)
+		shields := min(s.units[CombatUnit_t{Code: "ESH", TechLevel: techLevel}], int64(s.fuel/(10*tl)))
+		used := s.man(min(shields, int64(math.Ceil((energy-deflected)/(10*tl*tl)))))
+		if used <= 0 {
+			continue
+		}
+		s.burn(float64(used) * 10 * tl)
+		deflected += float64(used) * 10 * tl * tl
+	}
+	return min(deflected, energy)
+}
+
+// takeHits destroys mass. The units and soldiers on the side are lost in
+// proportion and recorded as destroyed and killed.
+func (s *combatSide_t) takeHits(mass float64) {
+	if mass <= 0 || s.mass <= 0 {
+		return
+	}
+	pct := min(1, mass/s.mass)
+	s.mass -= s.mass * pct
+	s.massLeft *= 1 - pct
+	for key, qty := range s.units {
+		lost := int64(math.Round(float64(qty) * pct))
+		s.units[key] = qty - lost
+		s.destroyed[key] += lost
+	}
+	killed := int64(math.Round(float64(s.soldiers) * pct))
+	s.soldiers -= killed
+	s.killed += killed
+	s.supplies -= int64(math.Round(float64(s.supplies) * pct))
+	s.fuel -= s.fuel * pct
+}
+
+// groundWeapon_t is an assault weapon and the factors it provides.
+type groundWeapon_t struct {
+	key     CombatUnit_t
+	factors float64
+}
+
+// groundFactors returns the ground combat factors of the side.
+// If consume is set, the supplies and fuel for a round are used.
+func (s *combatSide_t) groundFactors(consume bool) float64 {
+	supplies := s.supplies
+	soldiers := min(s.soldiers, supplies)
+	supplies -= soldiers
+	crews, factors := soldiers, float64(soldiers)
+	for techLevel := int64(10); techLevel >= 1; techLevel-- {
+		mtbt := min(s.units[CombatUnit_t{Code: "MTBT", TechLevel: techLevel}], supplies/(2*techLevel))
+		supplies -= mtbt * 2 * techLevel
+		crews += mtbt * 2 * techLevel
+		factors += float64(mtbt * 2 * techLevel)
+	}
+
+	// crews are assigned to the weapons that provide the most factors
+	var weapons []groundWeapon_t
+	for techLevel := int64(1); techLevel <= 10; techLevel++ {
+		tl := float64(techLevel)
+		weapons = append(weapons,
+			groundWeapon_t{key: CombatUnit_t{Code: "ASC", TechLevel: techLevel}, factors: 10 * tl},
+			groundWeapon_t{key: CombatUnit_t{Code: "ASW", TechLevel: techLevel}, factors: 2 * tl * tl})
+	}
+	sort.SliceStable(weapons, func(i, j int) bool {
+		return weapons[i].factors > weapons[j].factors
+	})
+	fuel := s.fuel
+	for _, w := range weapons {
+		used := min(s.units[w.key], crews)
+		if w.key.Code == "ASC" {
+			used = min(used, int64(fuel/0.1))
+			fuel -= float64(used) * 0.1
+		}
+		crews -= used
+		factors += float64(used) * w.factors
+	}
+
+	if consume {
+		s.supplied += s.supplies - supplies
+		s.supplies = supplies
+		s.burn(s.fuel - fuel)
+	}
+	return factors
+}
+
+// casualties removes a fraction of the ground forces of the side.
+func (s *combatSide_t) casualties(pct float64) {
+	killed := int64(math.Round(float64(s.soldiers) * pct))
+	s.soldiers -= killed
+	s.killed += killed
+	for key, qty := range s.units {
+		switch key.Code {
+		case "ASC", "ASW", "MTBT":
+			lost := int64(math.Round(float64(qty) * pct))
+			s.units[key] = qty - lost
+			s.destroyed[key] += lost
+		}
+	}
+}
+
+// losses returns the losses of the side at the end of the battle.
+func (s *combatSide_t) losses(factors float64) CombatLosses_t {
+	l := CombatLosses_t{
+		Expended:  map[CombatUnit_t]int64{},
+		Destroyed: map[CombatUnit_t]int64{},
+		Soldiers:  s.killed,
+		MassPct:   1 - s.massLeft,
+		Factors:   factors,
+	}
+	for key, qty := range s.expended {
+		if qty > 0 {
+			l.Expended[key] = qty
+		}
+	}
+	for key, qty := range s.destroyed {
+		if qty > 0 {
+			l.Destroyed[key] = qty
+		}
+	}
+	if fuel := int64(math.Ceil(s.fuelUsed)); fuel > 0 {
+		l.Expended[CombatUnit_t{Code: "FUEL"}] = fuel
+	}
+	if s.supplied > 0 {
+		l.Expended[CombatUnit_t{Code: "MTSP"}] = s.supplied
+	}
+	return l
+}
@@ -24,6 +24,7 @@ var (
 
 const (
 	ErrGameInProgress = Error("game in progress")
+	ErrGameNotStarted = Error("game not started")
 	ErrInvalidPath    = Error("invalid path")
 	ErrWritingReport  = Error("error writing report")
 )
@@ -183,6 +184,24 @@ func CreateGameCommand(e *Engine_t, cfg *CreateGameParams_t) error {
 	return err
 }
 
+type StartGameParams_t struct {
+	Code string // code of the game to start
+}
+
+// StartGameCommand starts a game. Turn 0 is the setup turn, when empires
+// are created. Once the game is started, no more empires can be created
+// and orders are executed from turn 1 on.
+func StartGameCommand(e *Engine_t, cfg *StartGameParams_t) error {
+	turnNo, err := e.Store.Queries.ReadCurrentTurn(e.Store.Context)
+	if err != nil {
+		return err
+	} else if turnNo != 0 {
+		return ErrGameInProgress
+	}
+	log.Printf("start: game: code %q\n", cfg.Code)
+	return e.Store.Queries.UpdateCurrentTurn(e.Store.Context, 1)
+}
+
 func codeTL(code string, tl int64) string {
 	if tl == 0 {
 		return code
@@ -96,15 +96,20 @@ func createHomeColony(g *Game_t, e *Empire_t) (*Entity_t, error) {
 	if err != nil {
 		return nil, err
 	}
+	// the power from the colony's power plants is shared by the farm and
+	// mine groups and is allocated before fuel.
+	power := entityPower(sc)
 	// allocate resources to the farm group.
 	for _, grp := range sc.FarmGroups {
 		// fake the constraints since this is the setup
-		_ = grp.Allocate(FarmGroupConstraints_t{
-			Fuel: 99_999_999_999,
-			Pro:  99_999_999_999,
-			Usk:  99_999_999_999,
-			Aut:  99_999_999_999,
+		remaining := grp.Allocate(FarmGroupConstraints_t{
+			Fuel:  99_999_999_999,
+			Power: power,
+			Pro:   99_999_999_999,
+			Usk:   99_999_999_999,
+			Aut:   99_999_999_999,
 		})
+		power = remaining.Power
 	}
 
 	// load the default values for factories
@@ -217,12 +222,14 @@ func createHomeColony(g *Game_t, e *Empire_t) (*Entity_t, error) {
 	// allocate resources to the mine group.
 	for _, grp := range sc.MiningGroups {
 		// fake the constraints since this is the setup
-		_ = grp.Allocate(MineGroupConstraints_t{
-			Fuel: 99_999_999_999,
-			Pro:  99_999_999_999,
-			Usk:  99_999_999_999,
-			Aut:  99_999_999_999,
+		remaining := grp.Allocate(MineGroupConstraints_t{
+			Fuel:  99_999_999_999,
+			Power: power,
+			Pro:   99_999_999_999,
+			Usk:   99_999_999_999,
+			Aut:   99_999_999_999,
 		})
+		power = remaining.Power
 	}
 
 	// run some of the steps to simulate a turn?
@@ -566,6 +573,15 @@ func createEmpireCommand(e *Engine_t, cfg *CreateEmpireParams_t) (int64, error)
 		return 0, err
 	}
 	log.Printf("create: empire: id %d: colony %d\n", empireID, scId)
+	err = q.CreateSCOwner(e.Store.Context, sqlite.CreateSCOwnerParams{
+		ScID:     scId,
+		Effdt:    0,
+		Enddt:    domains.MaxGameTurnNo,
+		EmpireID: empireID,
+	})
+	if err != nil {
+		return 0, err
+	}
 
 	for _, pop := range []struct {
 		code string
@@ -1052,7 +1068,7 @@ func createEmpireCommand(e *Engine_t, cfg *CreateEmpireParams_t) (int64, error)
 	}
 
 	// insert survey and probe orders to get reports for the empire started
-	err = q.CreateSCSurveyOrder(e.Store.Context, sqlite.CreateSCSurveyOrderParams{ScID: scId, TargetID: gameRow.HomeOrbitID})
+	err = q.CreateSCSurveyOrder(e.Store.Context, sqlite.CreateSCSurveyOrderParams{ScID: scId, TargetID: gameRow.HomeOrbitID, Kind: "orbit"})
 	if err != nil {
 		log.Printf("create: empire: survey %v\n", err)
 		return 0, err
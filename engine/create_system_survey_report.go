@@ -7,10 +7,12 @@ import (
 	_ "embed"
 	"fmt"
 	"github.com/playbymail/empyr/pkg/stdlib"
+	"github.com/playbymail/empyr/repos/sqlite"
 	"html/template"
 	"log"
 	"os"
 	"path/filepath"
+	"sort"
 	"time"
 )
 
@@ -102,7 +104,7 @@ func CreateSystemSurveyReportCommand(e *Engine_t, cfg *CreateSystemSurveyReportP
 		log.Printf("error: %v\n", err)
 		return nil, err
 	}
-	empireRow, err := e.Store.Queries.ReadEmpireByID(e.Store.Context, cfg.EmpireNo)
+	empireRow, err := e.Store.Queries.ReadEmpireByID(e.Store.Context, sqlite.ReadEmpireByIDParams{EmpireID: cfg.EmpireNo, AsOfDt: cfg.TurnNo})
 	if err != nil {
 		log.Printf("error: %v\n", err)
 		return nil, err
@@ -126,57 +128,11 @@ func CreateSystemSurveyReportCommand(e *Engine_t, cfg *CreateSystemSurveyReportP
 		CreatedDateTime: time.Now().UTC().Format(time.RFC3339),
 	}
 
-	//// get a list of all the reports for this empire for this turn.
-	//// these reports are keyed by the SC that owns the report.
-	//scReportRows, err := e.Store.Queries.ReadEmpireReports(e.Store.Context, sqlc.ReadEmpireReportsParams{EmpireID: empireRow.EmpireID, TurnNo: cfg.TurnNo})
-	//if err != nil {
-	//	log.Printf("error: %v\n", err)
-	//	return nil, err
-	//}
-	//for _, scReportRow := range scReportRows {
-	//	//log.Printf("sc %d: report %d\n", scReportRow.ScID, scReportRow.ReportID)
-	//
-	//	// get a list of the surveys that the sc created this turn
-	//	surveyReportRows, err := e.Store.Queries.ReadSystemSurveyReports(e.Store.Context, scReportRow.ReportID)
-	//	if err != nil {
-	//		log.Printf("error: %v\n", err)
-	//		return nil, err
-	//	}
-	//
-	//	// for each survey, get the survey data and add it to the report
-	//	for _, surveyReportRow := range surveyReportRows {
-	//		starRow, err := e.Store.Queries.ReadOrbitStar(e.Store.Context, surveyReportRow.OrbitID)
-	//		if err != nil {
-	//			log.Printf("error: %v\n", err)
-	//			return nil, err
-	//		}
-	//		surveyReport := &SurveyReport_t{
-	//			ID:      scReportRow.ReportID,
-	//			SorCID:  scReportRow.ScID,
-	//			Name:    fmt.Sprintf("%02d/%02d/%02d%s", starRow.X, starRow.Y, starRow.Z, starRow.StarSequence),
-	//			StarID:  starRow.StarID,
-	//			OrbitID: surveyReportRow.OrbitID,
-	//			OrbitNo: starRow.OrbitNo,
-	//		}
-	//
-	//		// add the deposits to the report
-	//		depositRows, err := e.Store.Queries.ReadSystemSurveyDeposits(e.Store.Context, surveyReportRow.SystemSurveyID)
-	//		if err != nil {
-	//			log.Printf("error: %v\n", err)
-	//			return nil, err
-	//		}
-	//		for _, depositRow := range depositRows {
-	//			surveyReport.Deposits = append(surveyReport.Deposits, &SurveyReportLine_t{
-	//				DepositNo: fmt.Sprintf("%02d", depositRow.DepositNo),
-	//				Quantity:  commas(depositRow.DepositQty),
-	//				Resource:  depositRow.DepositKind,
-	//				YieldPct:  fmt.Sprintf("%d %%", depositRow.DepositYieldPct),
-	//			})
-	//		}
-	//		payload.Surveys = append(payload.Surveys, surveyReport)
-	//	}
-	//}
-	////log.Printf("game %d: empire %d: turn %d: surveys %d\n", empireRow.GameID, empireRow.EmpireID, cfg.TurnNo, len(payload.Surveys))
+	payload.Surveys, err = e.readSurveyReports(empireRow.EmpireID, cfg.TurnNo)
+	if err != nil {
+		log.Printf("error: %v\n", err)
+		return nil, err
+	}
 
 	// buffer will hold the rendered turn report
 	buffer := &bytes.Buffer{}
@@ -188,3 +144,75 @@ func CreateSystemSurveyReportCommand(e *Engine_t, cfg *CreateSystemSurveyReportP
 
 	return buffer.Bytes(), nil
 }
+
+// readSurveyReports returns the results of all the surveys ordered by
+// an empire during the turn, with the deposits found by each survey and
+// the reason for each survey that failed.
+func (e *Engine_t) readSurveyReports(empireID, turnNo int64) ([]*SurveyReport_t, error) {
+	orbitRows, err := e.Store.Queries.ReadAllSurveyOrbitResultsByEmpire(e.Store.Context, sqlite.ReadAllSurveyOrbitResultsByEmpireParams{
+		EmpireID: empireID,
+		AsOfDt:   turnNo,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var surveys []*SurveyReport_t
+	surveyReports := map[int64]*SurveyReport_t{}
+	for _, orbitRow := range orbitRows {
+		surveyReport := &SurveyReport_t{
+			ID:            orbitRow.SurveyID,
+			SorCID:        orbitRow.ScID,
+			Name:          orbitRow.Location,
+			StarID:        orbitRow.StarID,
+			OrbitID:       orbitRow.OrbitID,
+			OrbitNo:       orbitRow.OrbitNo,
+			Habitability:  orbitRow.HabitabilityNo,
+			FarmlandInUse: commas(orbitRow.FarmlandInUse),
+			Population:    commas(orbitRow.Population),
+		}
+		surveyReports[orbitRow.SurveyID] = surveyReport
+		surveys = append(surveys, surveyReport)
+	}
+
+	// add the deposits to the report
+	depositRows, err := e.Store.Queries.ReadAllSurveyDepositResultsByEmpire(e.Store.Context, sqlite.ReadAllSurveyDepositResultsByEmpireParams{
+		EmpireID: empireID,
+		AsOfDt:   turnNo,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, depositRow := range depositRows {
+		surveyReport, ok := surveyReports[depositRow.SurveyID]
+		if !ok {
+			continue
+		}
+		surveyReport.Deposits = append(surveyReport.Deposits, &SurveyReportLine_t{
+			DepositNo: fmt.Sprintf("%02d", depositRow.DepositNo),
+			Quantity:  commas(depositRow.DepositQty),
+			Resource:  depositRow.DepositKind,
+			YieldPct:  fmt.Sprintf("%d %%", depositRow.YieldPct),
+		})
+	}
+
+	// add the surveys that failed to the report
+	failedRows, err := e.Store.Queries.ReadAllFailedSurveysByEmpire(e.Store.Context, sqlite.ReadAllFailedSurveysByEmpireParams{
+		EmpireID: empireID,
+		AsOfDt:   turnNo,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, failedRow := range failedRows {
+		surveys = append(surveys, &SurveyReport_t{
+			ID:     failedRow.SurveyID,
+			SorCID: failedRow.ScID,
+			Reason: failedRow.Reason,
+		})
+	}
+	sort.Slice(surveys, func(i, j int) bool {
+		return surveys[i].ID < surveys[j].ID
+	})
+
+	return surveys, nil
+}
@@ -13,7 +13,6 @@ import (
 	"math"
 	"os"
 	"path/filepath"
-	"sort"
 	"time"
 )
 
@@ -126,6 +125,20 @@ func CreateTurnReportCommand(e *Engine_t, cfg *CreateTurnReportParams_t) ([]byte
 		CreatedDateTime: time.Now().UTC().Format(time.RFC3339),
 	}
 
+	nameRow, err := e.Store.Queries.ReadEmpireName(e.Store.Context, sqlite.ReadEmpireNameParams{EmpireID: empireRow.EmpireID, AsOfDt: turnNo})
+	if err != nil {
+		log.Printf("error: %v\n", err)
+		return nil, err
+	}
+	payload.Heading.EmpireName = nameRow.Name
+
+	// the empire's private names for systems and stars are shown with the coordinates
+	names, err := e.ReadPrivateNames(empireRow.EmpireID, turnNo)
+	if err != nil {
+		log.Printf("error: %v\n", err)
+		return nil, err
+	}
+
 	colonyRows, err := e.Store.Queries.ReadAllColoniesByEmpire(e.Store.Context, empireRow.EmpireID)
 	if err != nil {
 		log.Printf("error: %v\n", err)
@@ -135,7 +148,7 @@ func CreateTurnReportCommand(e *Engine_t, cfg *CreateTurnReportParams_t) ([]byte
 		log.Printf("colony: %d\n", colonyRow.ScID)
 		colonyReport := &ColonyReport_t{
 			Id:          colonyRow.ScID,
-			Coordinates: colonyRow.StarName,
+			Coordinates: names.Coordinates(colonyRow.SystemID, colonyRow.StarID, colonyRow.StarName),
 			OrbitNo:     colonyRow.OrbitNo,
 			Kind:        colonyRow.ScKind,
 			Name:        colonyRow.Name,
@@ -150,97 +163,25 @@ func CreateTurnReportCommand(e *Engine_t, cfg *CreateTurnReportParams_t) ([]byte
 		if colonyReport.Name == "" {
 			colonyReport.Name = "Not Named"
 		}
-		if popRows, err := e.Store.Queries.ReadSCPopulation(e.Store.Context, colonyRow.ScID); err != nil {
+		if colonyReport.Census, err = e.readColonyCensus(colonyRow.ScID, turnNo); err != nil {
 			log.Printf("error: %v\n", err)
 			return nil, err
-		} else {
-			colonyReport.Census = &ColonyCensusReport_t{}
-			totalPopulation, totalPay := int64(0), int64(0)
-			for _, popRow := range popRows {
-				totPay := int64(math.Ceil(float64(popRow.Qty) * popRow.PayRate))
-				colonyReport.Census.Population = append(colonyReport.Census.Population, &PopulationReport_t{
-					Group:      popRow.PopulationCd,
-					Population: commas(popRow.Qty),
-					PayRate:    fmt.Sprintf("%6.4f", popRow.PayRate),
-					TotalPay:   commas(totPay),
-					qty:        popRow.Qty,
-				})
-				totalPopulation += popRow.Qty
-				totalPay += totPay
-			}
-			colonyReport.Census.TotalPopulation = commas(totalPopulation)
-			colonyReport.Census.TotalPay = commas(totalPay)
-			for _, group := range colonyReport.Census.Population {
-				group.PctTotalPop = fmt.Sprintf("%6.2f %%", 100*float64(group.qty)/float64(totalPopulation))
-			}
 		}
-		// lie about the other statistics for now
-		colonyReport.Other = &ColonyOtherReport_t{
-			TotalMass:       "89,494,472",
-			TotalVolume:     "60,000,000",
-			AvailableVolume: "4,952,743",
+		if colonyReport.Other, err = e.readColonyOther(colonyRow.ScID, turnNo); err != nil {
+			log.Printf("error: %v\n", err)
+			return nil, err
 		}
-		// lie about transports, too
-		colonyReport.Transports = &ColonyTransportReport_t{
-			Capacity:  "400,000",
-			Used:      "0",
-			Available: "400,000",
+		if colonyReport.Transports, err = e.readColonyTransports(colonyRow.ScID, turnNo); err != nil {
+			log.Printf("error: %v\n", err)
+			return nil, err
 		}
-		if inventoryRows, err := e.Store.Queries.ReadSCInventory(e.Store.Context, colonyRow.ScID); err != nil {
+		if colonyReport.Labor, err = e.readColonyLabor(colonyRow.ScID, turnNo); err != nil {
+			log.Printf("error: %v\n", err)
+			return nil, err
+		}
+		if colonyReport.Inventory, err = e.readColonyInventory(colonyRow.ScID, turnNo); err != nil {
 			log.Printf("error: %v\n", err)
 			return nil, err
-		} else {
-			type inventoryLine_t struct {
-				id              string
-				code            string
-				techLevel       int64
-				nonAssemblyQty  int64
-				assembledQty    int64
-				disassembledQty int64
-			}
-			inventoryMap := map[string]*inventoryLine_t{}
-			for _, item := range inventoryRows {
-				var code string
-				if item.TechLevel == 0 {
-					code = item.UnitCd
-				} else {
-					code = fmt.Sprintf("%s-%d", item.UnitCd, item.TechLevel)
-				}
-				line, ok := inventoryMap[code]
-				if !ok {
-					line = &inventoryLine_t{id: code, code: item.UnitCd, techLevel: item.TechLevel}
-					inventoryMap[code] = line
-				}
-				if IsOperational(line.code) {
-					if item.IsAssembled == 1 {
-						line.assembledQty += item.Qty
-					} else { // assumes disassembled are in storage
-						line.disassembledQty += item.Qty
-					}
-				} else { // assumes non-operational are always in storage
-					line.nonAssemblyQty += item.Qty
-				}
-			}
-			// sort the inventory lines by code and tech level
-			var inventoryLines []*inventoryLine_t
-			for _, line := range inventoryMap {
-				inventoryLines = append(inventoryLines, line)
-			}
-			sort.Slice(inventoryLines, func(i, j int) bool {
-				if inventoryLines[i].code == inventoryLines[j].code {
-					return inventoryLines[i].techLevel < inventoryLines[j].techLevel
-				}
-				return inventoryLines[i].code < inventoryLines[j].code
-			})
-			for _, line := range inventoryLines {
-				colonyReport.Inventory = append(colonyReport.Inventory, &ColonyInventoryLine_t{
-					Code:            line.id,
-					NonAssemblyQty:  commas(line.nonAssemblyQty),
-					DisassembledQty: commas(line.disassembledQty),
-					AssembledQty:    commas(line.assembledQty),
-					IsOPU:           IsOperational(line.code),
-				})
-			}
 		}
 		//if reportRows, err := e.Store.Queries.ReadReportProductionInputs(e.Store.Context, sqlc.ReadReportProductionInputsParams{
 		//	SorcID: colonyRow.ScID,
@@ -289,63 +230,8 @@ func CreateTurnReportCommand(e *Engine_t, cfg *CreateTurnReportParams_t) ([]byte
 		//		})
 		//	}
 		//}
-		if fgRows, err := e.Store.Queries.ReadSCFactoryGroups(e.Store.Context, colonyRow.ScID); err != nil {
+		if colonyReport.FactoryGroups, err = e.readColonyFactoryGroups(colonyRow.ScID, turnNo); err != nil {
 			log.Printf("error: %v\n", err)
-		} else {
-			for _, fgRow := range fgRows {
-				var code string
-				if fgRow.OrdersTechLevel == 0 {
-					code = fgRow.OrdersCd
-				} else {
-					code = fmt.Sprintf("%s-%d", fgRow.OrdersCd, fgRow.OrdersTechLevel)
-				}
-				rpt := &ColonyFactoryGroupsReport_t{
-					GroupNo: fmt.Sprintf("%02d", fgRow.GroupNo),
-					Orders:  code,
-				}
-				if fgRetool, err := e.Store.Queries.ReadSCFactoryGroupRetoolOrder(e.Store.Context, sqlc.ReadSCFactoryGroupRetoolOrderParams{
-					ScID:    colonyRow.ScID,
-					GroupNo: fgRow.GroupNo,
-				}); err == nil {
-					rpt.Orders += " *"
-					rpt.RetoolTurn = fmt.Sprintf("%d", fgRetool.TurnNo)
-				}
-				if grpRows, err := e.Store.Queries.ReadSCFactoryGroup(e.Store.Context, sqlc.ReadSCFactoryGroupParams{
-					ScID:    colonyRow.ScID,
-					GroupNo: fgRow.GroupNo,
-				}); err != nil {
-					log.Printf("error: %v\n", err)
-				} else {
-					for _, grpRow := range grpRows {
-						if grpRow.OrdersTechLevel == 0 {
-							code = grpRow.OrdersCd
-						} else {
-							code = fmt.Sprintf("%s-%d", grpRow.OrdersCd, grpRow.OrdersTechLevel)
-						}
-						rptLine := &ColonyFactoryGroupReport_t{
-							TechLevel:  grpRow.GroupTechLevel,
-							NbrOfUnits: commas(grpRow.NbrOfUnits),
-						}
-						rptLine.Pipeline[0] = &ColonyFactoryPipelineReport_t{
-							Percentage: "25%",
-							Unit:       code,
-							Qty:        commas(grpRow.Wip25pctQty),
-						}
-						rptLine.Pipeline[1] = &ColonyFactoryPipelineReport_t{
-							Percentage: "50%",
-							Unit:       code,
-							Qty:        commas(grpRow.Wip50pctQty),
-						}
-						rptLine.Pipeline[2] = &ColonyFactoryPipelineReport_t{
-							Percentage: "75%",
-							Unit:       code,
-							Qty:        commas(grpRow.Wip75pctQty),
-						}
-						rpt.Units = append(rpt.Units, rptLine)
-					}
-				}
-				colonyReport.FactoryGroups = append(colonyReport.FactoryGroups, rpt)
-			}
 		}
 		if fgRows, err := e.Store.Queries.ReadSCFarmGroups(e.Store.Context, colonyRow.ScID); err != nil {
 			log.Printf("error: %v\n", err)
@@ -392,6 +278,210 @@ func CreateTurnReportCommand(e *Engine_t, cfg *CreateTurnReportParams_t) ([]byte
 		payload.Colonies = append(payload.Colonies, colonyReport)
 	}
 
+	shipRows, err := e.Store.Queries.ReadAllShipsByEmpire(e.Store.Context, sqlite.ReadAllShipsByEmpireParams{
+		EmpireID: empireRow.EmpireID,
+		AsOfDt:   turnNo,
+	})
+	if err != nil {
+		log.Printf("error: %v\n", err)
+		return nil, err
+	}
+	shipReports := map[int64]*ShipReport_t{}
+	for _, shipRow := range shipRows {
+		shipReport := &ShipReport_t{
+			Id:          shipRow.ScID,
+			IdCode:      fmt.Sprintf("SS-%d", shipRow.ScID),
+			Name:        shipRow.Name,
+			Coordinates: names.Coordinates(shipRow.SystemID, shipRow.StarID, shipRow.StarName),
+			OrbitNo:     shipRow.OrbitNo,
+			IsOnSurface: shipRow.IsOnSurface == 1,
+		}
+		if shipReport.Name == "" {
+			shipReport.Name = "Not Named"
+		}
+		shipReports[shipRow.ScID] = shipReport
+		payload.Ships = append(payload.Ships, shipReport)
+	}
+	if moveRows, err := e.Store.Queries.ReadAllMoveResultsByEmpire(e.Store.Context, sqlite.ReadAllMoveResultsByEmpireParams{
+		EmpireID: empireRow.EmpireID,
+		AsOfDt:   turnNo,
+	}); err != nil {
+		log.Printf("error: %v\n", err)
+		return nil, err
+	} else {
+		for _, moveRow := range moveRows {
+			shipReport, ok := shipReports[moveRow.ScID]
+			if !ok {
+				continue
+			}
+			shipReport.Movement = append(shipReport.Movement, &ShipMovementReport_t{
+				From:   movementLocation(moveRow.FromStarName, moveRow.FromOrbitNo, moveRow.FromIsOnSurface == 1),
+				To:     movementLocation(moveRow.ToStarName, moveRow.ToOrbitNo, moveRow.ToIsOnSurface == 1),
+				Mass:   commas(int64(math.Ceil(moveRow.Mass))),
+				Thrust: commas(int64(math.Floor(moveRow.Thrust))),
+				Fuel:   commas(moveRow.FuelUsed),
+				Status: moveRow.Status,
+				Reason: moveRow.Reason,
+			})
+		}
+	}
+	if jumpRows, err := e.Store.Queries.ReadAllJumpResultsByEmpire(e.Store.Context, sqlite.ReadAllJumpResultsByEmpireParams{
+		EmpireID: empireRow.EmpireID,
+		AsOfDt:   turnNo,
+	}); err != nil {
+		log.Printf("error: %v\n", err)
+		return nil, err
+	} else {
+		for _, jumpRow := range jumpRows {
+			shipReport, ok := shipReports[jumpRow.ScID]
+			if !ok {
+				continue
+			}
+			shipReport.Jumps = append(shipReport.Jumps, &ShipJumpReport_t{
+				From:     movementLocation(jumpRow.FromStarName, jumpRow.FromOrbitNo, false),
+				To:       movementLocation(jumpRow.ToStarName, jumpRow.ToOrbitNo, false),
+				Distance: fmt.Sprintf("%.2f", jumpRow.Distance),
+				Mass:     commas(int64(math.Ceil(jumpRow.Mass))),
+				Engines:  commas(jumpRow.EnginesUsed),
+				Fuel:     commas(jumpRow.FuelUsed),
+				Status:   jumpRow.Status,
+				Reason:   jumpRow.Reason,
+			})
+		}
+	}
+
+	payload.Surveys, err = e.readSurveyReports(empireRow.EmpireID, turnNo)
+	if err != nil {
+		log.Printf("error: %v\n", err)
+		return nil, err
+	}
+
+	if combatRows, err := e.Store.Queries.ReadAllCombatResultsByEmpire(e.Store.Context, sqlite.ReadAllCombatResultsByEmpireParams{
+		EmpireID: empireRow.EmpireID,
+		AsOfDt:   turnNo,
+	}); err != nil {
+		log.Printf("error: %v\n", err)
+		return nil, err
+	} else {
+		for _, combatRow := range combatRows {
+			isDefender := combatRow.TargetEmpireID == empireRow.EmpireID
+			if isDefender && combatRow.ScEmpireID != empireRow.EmpireID && combatRow.Status == "failed" {
+				// the defender doesn't see attacks that never happened
+				continue
+			}
+			payload.Battles = append(payload.Battles, &CombatReport_t{
+				ScID:       combatRow.ScID,
+				Kind:       combatRow.Kind,
+				TargetID:   combatRow.TargetID,
+				IsDefender: isDefender,
+				Committed:  fmt.Sprintf("%d%%", combatRow.PctCommitted),
+				Rounds:     combatRow.Rounds,
+				Destroyed:  fmt.Sprintf("%.1f%%", combatRow.MassPct*100),
+				Status:     combatRow.Status,
+				Reason:     combatRow.Reason,
+			})
+		}
+	}
+	if lossRows, err := e.Store.Queries.ReadAllCombatLossesByEmpire(e.Store.Context, sqlite.ReadAllCombatLossesByEmpireParams{
+		EmpireID: empireRow.EmpireID,
+		AsOfDt:   turnNo,
+	}); err != nil {
+		log.Printf("error: %v\n", err)
+		return nil, err
+	} else {
+		for _, lossRow := range lossRows {
+			payload.CombatLosses = append(payload.CombatLosses, &CombatLossReport_t{
+				ScID: lossRow.ScID,
+				Code: codeTL(lossRow.Code, lossRow.TechLevel),
+				Qty:  commas(lossRow.Qty),
+			})
+		}
+	}
+
+	payload.Espionage, err = e.readEspionageReports(empireRow.EmpireID, turnNo)
+	if err != nil {
+		log.Printf("error: %v\n", err)
+		return nil, err
+	}
+
+	payload.StolenReports, err = e.readStolenReports(gameCode, empireRow.EmpireID, turnNo)
+	if err != nil {
+		log.Printf("error: %v\n", err)
+		return nil, err
+	}
+
+	payload.MarketOrders, payload.MarketPrices, err = e.readMarketReports(empireRow.EmpireID, turnNo)
+	if err != nil {
+		log.Printf("error: %v\n", err)
+		return nil, err
+	}
+
+	payload.Rights, payload.RightOrders, err = e.readPermissionReports(empireRow.EmpireID, turnNo)
+	if err != nil {
+		log.Printf("error: %v\n", err)
+		return nil, err
+	}
+
+	payload.News, payload.NewsOrders, err = e.readNewsReports(empireRow.EmpireID, turnNo)
+	if err != nil {
+		log.Printf("error: %v\n", err)
+		return nil, err
+	}
+
+	payload.NameOrders, err = e.readNameReports(empireRow.EmpireID, turnNo)
+	if err != nil {
+		log.Printf("error: %v\n", err)
+		return nil, err
+	}
+
+	payload.Setups, err = e.readSetupReports(empireRow.EmpireID, turnNo)
+	if err != nil {
+		log.Printf("error: %v\n", err)
+		return nil, err
+	}
+
+	payload.Assemblies, err = e.readAssemblyReports(empireRow.EmpireID, turnNo)
+	if err != nil {
+		log.Printf("error: %v\n", err)
+		return nil, err
+	}
+
+	payload.Labs, payload.Research, err = e.readResearchReports(empireRow.EmpireID, turnNo)
+	if err != nil {
+		log.Printf("error: %v\n", err)
+		return nil, err
+	}
+
+	payload.Retools, err = e.readRetoolReports(empireRow.EmpireID, turnNo)
+	if err != nil {
+		log.Printf("error: %v\n", err)
+		return nil, err
+	}
+
+	payload.Recycles, err = e.readRecycleReports(empireRow.EmpireID, turnNo)
+	if err != nil {
+		log.Printf("error: %v\n", err)
+		return nil, err
+	}
+
+	payload.Transfers, err = e.readTransferReports(empireRow.EmpireID, turnNo)
+	if err != nil {
+		log.Printf("error: %v\n", err)
+		return nil, err
+	}
+
+	payload.Drafts, err = e.readDraftReports(empireRow.EmpireID, turnNo)
+	if err != nil {
+		log.Printf("error: %v\n", err)
+		return nil, err
+	}
+
+	payload.KnownStars, err = e.readKnownStarReports(empireRow.EmpireID, turnNo, names)
+	if err != nil {
+		log.Printf("error: %v\n", err)
+		return nil, err
+	}
+
 	// buffer will hold the rendered turn report
 	buffer := &bytes.Buffer{}
 
@@ -402,3 +492,11 @@ func CreateTurnReportCommand(e *Engine_t, cfg *CreateTurnReportParams_t) ([]byte
 
 	return buffer.Bytes(), nil
 }
+
+// movementLocation returns the display for a location in a movement report.
+func movementLocation(starName string, orbitNo int64, isOnSurface bool) string {
+	if isOnSurface {
+		return fmt.Sprintf("%s #%d (surface)", starName, orbitNo)
+	}
+	return fmt.Sprintf("%s #%d", starName, orbitNo)
+}
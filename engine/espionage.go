@@ -0,0 +1,136 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package engine
+
+import (
+	"math"
+	"math/rand/v2"
+)
+
+// this file implements the rules for resolving espionage missions.
+//
+// Spies (SPY) and special agents (SAG) are sent on missions. Missions at
+// home use special agents: check-rebels estimates the number of rebels in
+// the colony, with each agent checking up to 1,000 people, and
+// convert-rebels wins back up to 50 rebels per agent. A few agents are
+// lost on every mission at home.
+//
+// Spies ordered to counter-agents guard their colony for the turn. Every
+// mission against the colony must get past them. The chance that a spy is
+// caught is the share of the counter-agents in the total number of agents,
+// and counter-agents are killed catching them. suppress-agents sends spies
+// to kill the target's counter-agents; each spy that gets through kills one.
+// incite-rebels turns up to 100 loyal people per spy into rebels.
+// steal-secrets succeeds if any spy gets through. Spies that are caught are
+// lost.
+//
+// A successful steal-secrets mission returns a copy of the target colony's
+// report. The census is revealed if one spy gets through, the inventory if
+// 10 do, and the factory groups if 25 do. Fewer than 100 spies see only
+// their share of the lines in each section, picked at random but always at
+// least one, and the totals in a partial census are reported as unknown.
+
+const (
+	espionageCheckPerAgent   = 1_000 // people one special agent can check for rebels
+	espionageConvertPerAgent = 50    // rebels one special agent can win back
+	espionageIncitePerAgent  = 100   // loyal people one spy can turn into rebels
+	espionageHomeLossRate    = 0.02  // share of agents lost on missions at home
+	espionageCounterLossRate = 0.25  // counter-agents killed for each spy caught
+	espionageMaxCatchPct     = 0.9   // the best counter-agents still miss some spies
+	espionageStealCensus     = 1     // spies needed to steal the census
+	espionageStealInventory  = 10    // spies needed to steal the inventory
+	espionageStealFactories  = 25    // spies needed to steal the factory groups
+	espionageStealAll        = 100   // spies needed to steal every line of a section
+)
+
+// interceptAgents returns the number of spies caught by the counter-agents
+// guarding a colony and the number of counter-agents killed catching them.
+func interceptAgents(r *rand.Rand, agents, counterAgents int64) (caught, counterLost int64) {
+	if agents <= 0 || counterAgents <= 0 {
+		return 0, 0
+	}
+	pct := float64(counterAgents) / float64(counterAgents+agents)
+	pct = min(max(bellCurve(r, pct, pct/10), 0), espionageMaxCatchPct)
+	caught = min(int64(math.Round(float64(agents)*pct)), agents)
+	counterLost = min(int64(math.Round(float64(caught)*espionageCounterLossRate)), counterAgents)
+	return caught, counterLost
+}
+
+// homeLosses returns the number of agents lost on a mission at home.
+func homeLosses(r *rand.Rand, agents int64) int64 {
+	pct := min(max(bellCurve(r, espionageHomeLossRate, espionageHomeLossRate/2), 0), 1)
+	return min(int64(math.Round(float64(agents)*pct)), agents)
+}
+
+// estimateRebels returns the number of rebels that special agents report
+// after checking a colony. The estimate gets worse as the share of the
+// population that the agents can check gets smaller.
+func estimateRebels(r *rand.Rand, agents, population, rebels int64) int64 {
+	if population <= 0 {
+		return 0
+	}
+	checked := min(agents*espionageCheckPerAgent, population)
+	coverage := float64(checked) / float64(population)
+	if coverage >= 1 {
+		return rebels
+	}
+	estimate := bellCurve(r, float64(rebels), float64(rebels)*(1-coverage)/2)
+	return min(max(int64(math.Round(estimate)), 0), population)
+}
+
+// convertRebels returns the number of rebels won back by special agents.
+func convertRebels(r *rand.Rand, agents, rebels int64) int64 {
+	converted := bellCurve(r, float64(agents*espionageConvertPerAgent), float64(agents*espionageConvertPerAgent)/10)
+	return min(max(int64(math.Round(converted)), 0), rebels)
+}
+
+// inciteRebels returns the number of loyal people turned into rebels by spies.
+func inciteRebels(r *rand.Rand, agents, loyal int64) int64 {
+	incited := bellCurve(r, float64(agents*espionageIncitePerAgent), float64(agents*espionageIncitePerAgent)/10)
+	return min(max(int64(math.Round(incited)), 0), loyal)
+}
+
+// redactColonyReport removes the sections and lines of a stolen colony
+// report that the spies who got through didn't see.
+func redactColonyReport(r *rand.Rand, agents int64, report *ColonyReport_t) {
+	if agents < espionageStealCensus || report.Census == nil {
+		report.Census = nil
+	} else if agents < espionageStealAll {
+		report.Census.Population = redactLines(r, agents, report.Census.Population)
+		report.Census.TotalEmployed, report.Census.TotalPopulation, report.Census.TotalPay = "unknown", "unknown", "unknown"
+		for _, group := range report.Census.Population {
+			group.PctTotalPop = "unknown"
+		}
+	}
+	if agents < espionageStealInventory {
+		report.Inventory = nil
+	} else {
+		report.Inventory = redactLines(r, agents, report.Inventory)
+	}
+	if agents < espionageStealFactories {
+		report.FactoryGroups = nil
+	} else {
+		report.FactoryGroups = redactLines(r, agents, report.FactoryGroups)
+	}
+}
+
+// redactLines returns the lines of a section that the spies saw, in their
+// original order.
+func redactLines[T any](r *rand.Rand, agents int64, lines []T) []T {
+	n := len(lines)
+	if agents >= espionageStealAll || n == 0 {
+		return lines
+	}
+	keep := max(n*int(agents)/espionageStealAll, 1)
+	seen := make([]bool, n)
+	for _, i := range r.Perm(n)[:keep] {
+		seen[i] = true
+	}
+	var redacted []T
+	for i, line := range lines {
+		if seen[i] {
+			redacted = append(redacted, line)
+		}
+	}
+	return redacted
+}
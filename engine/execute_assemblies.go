@@ -0,0 +1,275 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package engine
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/playbymail/empyr/internal/domains"
+	"github.com/playbymail/empyr/repos/sqlite"
+	"log"
+)
+
+// ExecuteAssemblies executes all the assemble and store orders for the
+// current turn.
+//
+// An assemble order takes operational units out of storage and assembles
+// them. A store order disassembles assembled units and puts them back in
+// storage. Factories, farms, labs, and mines are added to or taken from a
+// group. Orders are executed in the order they were given, and construction
+// workers used by one order can't be used by another. See assembly.go for
+// the rules.
+func (e *Engine_t) ExecuteAssemblies(gameCode string, turnNo int64) error {
+	// start a transaction
+	q, tx, err := e.Store.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// get a list of all the assembly orders. these are the orders that need to be executed.
+	assemblyOrderRows, err := q.ReadAllAssemblyOrdersByTurn(e.Store.Context, turnNo)
+	if err != nil {
+		return err
+	}
+
+	// construction workers and capacity are loaded once per ship or colony
+	// and updated as the orders are executed.
+	workers := map[int64]int64{}
+	capacities := map[int64]*capacity_t{}
+
+	for _, order := range assemblyOrderRows {
+		owner, err := q.ReadSCOwner(e.Store.Context, sqlite.ReadSCOwnerParams{ScID: order.ScID, AsOfDt: turnNo})
+		if err != nil {
+			return err
+		}
+		capacity, ok := capacities[order.ScID]
+		if !ok {
+			if capacity, err = e.readCapacity(q, order.ScID, turnNo); err != nil {
+				return err
+			}
+			capacities[order.ScID] = capacity
+			row, err := q.ReadSCPopulationCode(e.Store.Context, sqlite.ReadSCPopulationCodeParams{
+				ScID:         order.ScID,
+				PopulationCd: "CNW",
+				AsOfDt:       turnNo,
+			})
+			if errors.Is(err, sql.ErrNoRows) {
+				row.Qty = 0
+			} else if err != nil {
+				return err
+			}
+			workers[order.ScID] = row.Qty
+		}
+		result := sqlite.CreateSCAssemblyResultParams{
+			AssemblyID: order.AssemblyID,
+			Effdt:      turnNo,
+			EmpireID:   owner.EmpireID,
+			Status:     "succeeded",
+		}
+		result.Qty, result.Reason, err = e.executeAssemblyOrder(q, order, capacity, workers[order.ScID], turnNo)
+		if err != nil {
+			return err
+		} else if result.Qty == 0 {
+			result.Status = "failed"
+		}
+		result.CnwUsed = assemblyWorkers(order.UnitCd, order.TechLevel, result.Qty)
+		workers[order.ScID] -= result.CnwUsed
+		log.Printf("game %q: turn %d: sc %d: assembly %d: %s %d %s: %d: %s %q\n", gameCode, turnNo, order.ScID, order.AssemblyID, order.Kind, order.Qty, codeTL(order.UnitCd, order.TechLevel), result.Qty, result.Status, result.Reason)
+		err = q.CreateSCAssemblyResult(e.Store.Context, result)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// executeAssemblyOrder validates an assemble or store order and, if it is
+// valid, moves the units between storage and assembly. It returns the
+// number of units that were moved and the reason that the order failed or
+// was only partly executed.
+func (e *Engine_t) executeAssemblyOrder(q *sqlite.Queries, order sqlite.ReadAllAssemblyOrdersByTurnRow, capacity *capacity_t, workers, turnNo int64) (int64, string, error) {
+	code := codeTL(order.UnitCd, order.TechLevel)
+	if !IsOperational(order.UnitCd) {
+		return 0, fmt.Sprintf("%s can't be assembled", code), nil
+	}
+	isAssembled := order.Kind == "assemble"
+
+	// find the group that the units are added to or taken from
+	kind, groupNo := groupKind(order.UnitCd), order.GroupNo
+	if kind == "" && groupNo != 0 {
+		return 0, fmt.Sprintf("%s doesn't work in groups", code), nil
+	} else if groupNo == 0 && (kind == "farm" || kind == "lab") {
+		groupNo = 1
+	} else if groupNo == 0 && kind != "" {
+		return 0, fmt.Sprintf("%s group is required", kind), nil
+	}
+	var groupID int64
+	var hasGroup bool
+	if kind != "" {
+		var err error
+		groupID, hasGroup, err = e.findGroup(q, order.ScID, kind, groupNo, turnNo)
+		if err != nil {
+			return 0, "", err
+		}
+	}
+	if kind != "" && !isAssembled {
+		if !hasGroup {
+			return 0, fmt.Sprintf("no %s group %d", kind, groupNo), nil
+		}
+		row, err := q.ReadSCGroupUnit(e.Store.Context, sqlite.ReadSCGroupUnitParams{GroupID: groupID, TechLevel: order.TechLevel, AsOfDt: turnNo})
+		if errors.Is(err, sql.ErrNoRows) {
+			row.NbrOfUnits = 0
+		} else if err != nil {
+			return 0, "", err
+		}
+		if row.NbrOfUnits < order.Qty {
+			return 0, fmt.Sprintf("not enough %s in %s group %d", code, kind, groupNo), nil
+		}
+	}
+
+	// a new mine group needs a deposit and a new factory group needs an item
+	var depositID int64
+	if kind == "mine" && isAssembled && !hasGroup {
+		location, err := q.ReadSCLocation(e.Store.Context, sqlite.ReadSCLocationParams{ScID: order.ScID, AsOfDt: turnNo})
+		if err != nil {
+			return 0, "", err
+		}
+		deposit, err := q.ReadDepositByOrbitDepositNo(e.Store.Context, sqlite.ReadDepositByOrbitDepositNoParams{
+			OrbitID:   location.OrbitID,
+			DepositNo: order.DepositNo,
+			TurnNo:    turnNo,
+		})
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, "no such deposit", nil
+		} else if err != nil {
+			return 0, "", err
+		}
+		depositID = deposit.DepositID
+	} else if kind == "factory" && isAssembled && !hasGroup {
+		sc, err := q.ReadSC(e.Store.Context, order.ScID)
+		if err != nil {
+			return 0, "", err
+		} else if reason := manufactureReason(order.ItemCd, order.ItemTechLevel, sc.ScCd, sc.ScTechLevel); reason != "" {
+			return 0, reason, nil
+		}
+	}
+
+	// the units must be in storage (to assemble) or assembled (to store)
+	parms := sqlite.ReadSCInventoryUnitParams{
+		ScID:          order.ScID,
+		UnitCd:        order.UnitCd,
+		UnitTechLevel: order.TechLevel,
+		AsOfDt:        turnNo,
+	}
+	if !isAssembled {
+		parms.IsAssembled = 1
+	}
+	row, err := q.ReadSCInventoryUnit(e.Store.Context, parms)
+	if errors.Is(err, sql.ErrNoRows) {
+		row.Qty = 0
+	} else if err != nil {
+		return 0, "", err
+	}
+	if row.Qty < order.Qty {
+		if isAssembled {
+			return 0, fmt.Sprintf("not enough %s in storage", code), nil
+		}
+		return 0, fmt.Sprintf("not enough %s assembled", code), nil
+	}
+
+	// the workers may not be able to handle the whole order
+	var reason string
+	qty := assemblyQty(order.UnitCd, order.TechLevel, order.Qty, workers)
+	if qty == 0 {
+		return 0, "not enough construction workers", nil
+	} else if qty < order.Qty {
+		reason = "not enough construction workers"
+	}
+
+	// the ship or colony must still have enough space and life support
+	capacity.assemble(order.UnitCd, order.TechLevel, qty, isAssembled)
+	if !capacity.hasRoomFor(0) {
+		capacity.assemble(order.UnitCd, order.TechLevel, qty, !isAssembled)
+		return 0, "not enough space", nil
+	} else if !capacity.canSupport(0) {
+		capacity.assemble(order.UnitCd, order.TechLevel, qty, !isAssembled)
+		return 0, "not enough life support", nil
+	}
+
+	// move the units
+	if err = e.adjustInventoryEntry(q, order.ScID, order.UnitCd, order.TechLevel, !isAssembled, turnNo, -qty); err != nil {
+		return 0, "", err
+	} else if err = e.adjustInventoryEntry(q, order.ScID, order.UnitCd, order.TechLevel, isAssembled, turnNo, qty); err != nil {
+		return 0, "", err
+	}
+	if kind == "" {
+		return qty, reason, nil
+	}
+	if !hasGroup {
+		if groupID, err = e.createGroup(q, order.ScID, kind, groupNo, turnNo); err != nil {
+			return 0, "", err
+		}
+		switch kind {
+		case "factory":
+			err = q.CreateSCGroupTooling(e.Store.Context, sqlite.CreateSCGroupToolingParams{
+				GroupID:       groupID,
+				Effdt:         turnNo,
+				Enddt:         domains.MaxGameTurnNo,
+				ItemCd:        order.ItemCd,
+				ItemTechLevel: order.ItemTechLevel,
+				Retooled:      1,
+			})
+		case "mine":
+			err = q.CreateSCGroupDeposit(e.Store.Context, sqlite.CreateSCGroupDepositParams{
+				GroupID:   groupID,
+				Effdt:     turnNo,
+				Enddt:     domains.MaxGameTurnNo,
+				DepositID: depositID,
+			})
+		}
+		if err != nil {
+			return 0, "", err
+		}
+	}
+	delta := qty
+	if !isAssembled {
+		delta = -qty
+	}
+	if err = e.adjustGroupUnits(q, groupID, order.TechLevel, turnNo, delta); err != nil {
+		return 0, "", err
+	}
+	return qty, reason, nil
+}
+
+// readAssemblyReports returns the results of the assemble and store orders
+// given by an empire's ships and colonies.
+func (e *Engine_t) readAssemblyReports(empireID, turnNo int64) ([]*AssemblyReport_t, error) {
+	rows, err := e.Store.Queries.ReadAllAssemblyResultsByEmpire(e.Store.Context, sqlite.ReadAllAssemblyResultsByEmpireParams{
+		EmpireID: empireID,
+		Effdt:    turnNo,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var assemblies []*AssemblyReport_t
+	for _, row := range rows {
+		report := &AssemblyReport_t{
+			ScID:       row.ScID,
+			Kind:       row.Kind,
+			Unit:       codeTL(row.UnitCd, row.TechLevel),
+			OrderedQty: commas(row.OrderedQty),
+			Qty:        commas(row.Qty),
+			CnwUsed:    commas(row.CnwUsed),
+			Status:     row.Status,
+			Reason:     row.Reason,
+		}
+		if row.GroupNo != 0 {
+			report.GroupNo = fmt.Sprintf("%02d", row.GroupNo)
+		}
+		assemblies = append(assemblies, report)
+	}
+	return assemblies, nil
+}
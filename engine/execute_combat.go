@@ -0,0 +1,486 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package engine
+
+import (
+	"database/sql"
+	"errors"
+	"github.com/playbymail/empyr/repos/sqlite"
+	"log"
+	"maps"
+	"math"
+	"math/rand/v2"
+)
+
+// combatant_t is a ship or colony taking part in a battle.
+type combatant_t struct {
+	scID      int64
+	empireID  int64                                // owner when the battle is fought
+	order     *sqlite.ReadAllCombatOrdersByTurnRow // nil for the ship or colony being attacked
+	force     *CombatForce_t
+	inventory []sqlite.ReadSCInventoryRow
+}
+
+// engagement_t is a battle between the ships and colonies attacking a
+// target and the target and its defenders.
+type engagement_t struct {
+	kind      string // "bombard", "invade", or "raid"
+	targetID  int64
+	attacks   []*sqlite.ReadAllCombatOrdersByTurnRow
+	supports  []*sqlite.ReadAllCombatOrdersByTurnRow // support-attack orders
+	defenders []*sqlite.ReadAllCombatOrdersByTurnRow // support-defend orders
+}
+
+// ExecuteCombat executes all the attack and support orders for the current turn.
+//
+// Orders are gathered into engagements, one for each target and kind of
+// attack. Bombardments are fought first, then invasions, then raids, so
+// a bombardment can soften up a target before it is invaded. Ships and
+// colonies that support the attack or the defense of the target join every
+// engagement for that target. Everyone taking part must be in the same
+// orbit as the target.
+//
+// The rules for fighting the battle are in combat.go. Units and fuel used
+// or destroyed are removed from the inventory of each ship or colony in
+// proportion to what it committed. Weapons fire destroys a share of the
+// mass of everything on the losing side, including the population. When
+// a raid succeeds, the raiders carry off the target's stock of the raided
+// unit. When an invasion of a colony succeeds, the colony is captured by
+// the empire of the invader that committed the most soldiers.
+//
+// Owners are resolved as of the turn and are recorded with the results,
+// so the empire that lost a colony still sees the battle on its report.
+func (e *Engine_t) ExecuteCombat(gameCode string, turnNo int64) error {
+	// start a transaction
+	q, tx, err := e.Store.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// get a list of all the combat orders. these are the orders that need to be executed.
+	combatOrderRows, err := q.ReadAllCombatOrdersByTurn(e.Store.Context, turnNo)
+	if err != nil {
+		return err
+	}
+	r := turnRand(gameCode+"/combat", turnNo)
+
+	// gather the orders into engagements
+	var engagements []*engagement_t
+	for _, kind := range []string{"bombard", "invade", "raid"} {
+		byTarget := map[int64]*engagement_t{}
+		for i := range combatOrderRows {
+			order := &combatOrderRows[i]
+			if order.Kind != kind {
+				continue
+			}
+			battle, ok := byTarget[order.TargetID]
+			if !ok {
+				battle = &engagement_t{kind: kind, targetID: order.TargetID}
+				byTarget[order.TargetID] = battle
+				engagements = append(engagements, battle)
+			}
+			battle.attacks = append(battle.attacks, order)
+		}
+	}
+	supported := map[int64]bool{}
+	for i := range combatOrderRows {
+		order := &combatOrderRows[i]
+		for _, battle := range engagements {
+			if battle.targetID != order.TargetID {
+				continue
+			}
+			switch order.Kind {
+			case "support-attack":
+				battle.supports = append(battle.supports, order)
+				supported[order.CombatID] = true
+			case "support-defend":
+				battle.defenders = append(battle.defenders, order)
+				supported[order.CombatID] = true
+			}
+		}
+	}
+
+	results := map[int64]*sqlite.CreateSCCombatResultParams{}
+	for _, battle := range engagements {
+		err = e.executeEngagement(q, r, turnNo, battle, results)
+		if err != nil {
+			log.Printf("game %q: turn %d: %s: target %d: %v\n", gameCode, turnNo, battle.kind, battle.targetID, err)
+			return err
+		}
+	}
+
+	for _, order := range combatOrderRows {
+		result, ok := results[order.CombatID]
+		if !ok {
+			result = &sqlite.CreateSCCombatResultParams{CombatID: order.CombatID, Effdt: turnNo, EmpireID: order.EmpireID, Status: "failed"}
+			if owner, err := q.ReadSCOwner(e.Store.Context, sqlite.ReadSCOwnerParams{ScID: order.TargetID, AsOfDt: turnNo}); err == nil {
+				result.TargetEmpireID = owner.EmpireID
+			} else if !errors.Is(err, sql.ErrNoRows) {
+				return err
+			}
+			if (order.Kind == "support-attack" || order.Kind == "support-defend") && !supported[order.CombatID] {
+				result.Reason = "no battle to support"
+			} else {
+				result.Reason = "did not take part in the battle"
+			}
+		}
+		log.Printf("game %q: turn %d: sc %d: combat %d: %s %d: %s %q\n", gameCode, turnNo, order.ScID, order.CombatID, order.Kind, order.TargetID, result.Status, result.Reason)
+		err = q.CreateSCCombatResult(e.Store.Context, *result)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// executeEngagement fights a single battle and updates the results for
+// every order that took part in it.
+// Errors that are the player's fault are returned in the results;
+// the error is reserved for problems with the database.
+func (e *Engine_t) executeEngagement(q *sqlite.Queries, r *rand.Rand, turnNo int64, battle *engagement_t, results map[int64]*sqlite.CreateSCCombatResultParams) error {
+	targetEmpireID := int64(0)
+	failed := func(order *sqlite.ReadAllCombatOrdersByTurnRow, reason string) {
+		results[order.CombatID] = &sqlite.CreateSCCombatResultParams{CombatID: order.CombatID, Effdt: turnNo, EmpireID: order.EmpireID, TargetEmpireID: targetEmpireID, Status: "failed", Reason: reason}
+	}
+
+	target, err := q.ReadSCLocation(e.Store.Context, sqlite.ReadSCLocationParams{ScID: battle.targetID, AsOfDt: turnNo})
+	if errors.Is(err, sql.ErrNoRows) {
+		for _, order := range battle.attacks {
+			failed(order, "target has no location")
+		}
+		return nil
+	} else if err != nil {
+		return err
+	}
+	defender, err := e.readCombatant(q, battle.targetID, nil, turnNo)
+	if err != nil {
+		return err
+	}
+	targetCd := ""
+	scRows, err := q.ReadAllSCsByOrbit(e.Store.Context, sqlite.ReadAllSCsByOrbitParams{OrbitID: target.OrbitID, AsOfDt: turnNo})
+	if err != nil {
+		return err
+	}
+	inOrbit := map[int64]bool{}
+	for _, scRow := range scRows {
+		inOrbit[scRow.ScID] = true
+		if scRow.ScID == battle.targetID {
+			targetEmpireID, targetCd = scRow.EmpireID, scRow.ScCd
+		}
+	}
+	defender.empireID = targetEmpireID
+
+	// everyone taking part must be in the same orbit as the target
+	var attackers, defenders []*combatant_t
+	defenders = append(defenders, defender)
+	for _, order := range append(append([]*sqlite.ReadAllCombatOrdersByTurnRow{}, battle.attacks...), battle.supports...) {
+		if !inOrbit[order.ScID] {
+			failed(order, "not in the same orbit as the target")
+			continue
+		} else if order.EmpireID == targetEmpireID {
+			failed(order, "may not attack your own ship or colony")
+			continue
+		} else if order.Kind == "raid" && order.RaidUnitCd == "" {
+			failed(order, "raid must name the unit to carry off")
+			continue
+		}
+		attacker, err := e.readCombatant(q, order.ScID, order, turnNo)
+		if err != nil {
+			return err
+		}
+		attackers = append(attackers, attacker)
+	}
+	for _, order := range battle.defenders {
+		if !inOrbit[order.ScID] {
+			failed(order, "not in the same orbit as the target")
+			continue
+		}
+		supporter, err := e.readCombatant(q, order.ScID, order, turnNo)
+		if err != nil {
+			return err
+		}
+		defenders = append(defenders, supporter)
+	}
+	if len(attackers) == 0 {
+		for _, order := range battle.defenders {
+			if _, ok := results[order.CombatID]; !ok {
+				failed(order, "no attackers")
+			}
+		}
+		return nil
+	}
+
+	outcome := ResolveCombat(r, battle.kind, combinedForce(attackers), combinedForce(defenders))
+	err = e.applyCombatLosses(q, turnNo, attackers, outcome.Attacker)
+	if err != nil {
+		return err
+	}
+	err = e.applyCombatLosses(q, turnNo, defenders, outcome.Defender)
+	if err != nil {
+		return err
+	}
+
+	// successful raiders carry off the target's stock of the raided unit
+	if battle.kind == "raid" && outcome.AttackerWins {
+		for _, attacker := range attackers {
+			if attacker.order.Kind != "raid" {
+				continue
+			}
+			inventory, err := q.ReadSCInventory(e.Store.Context, sqlite.ReadSCInventoryParams{ScID: battle.targetID, AsOfDt: turnNo})
+			if err != nil {
+				return err
+			}
+			for _, row := range inventory {
+				if row.UnitCd != attacker.order.RaidUnitCd || row.Qty == 0 {
+					continue
+				}
+				if err = e.adjustInventoryEntry(q, battle.targetID, row.UnitCd, row.UnitTechLevel, row.IsAssembled == 1, turnNo, -row.Qty); err != nil {
+					return err
+				} else if err = e.adjustInventory(q, attacker.scID, row.UnitCd, row.UnitTechLevel, turnNo, row.Qty); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	// a successful invasion captures a colony for the invader that
+	// committed the most soldiers. invaders without soldiers can't capture it.
+	var captor *combatant_t
+	if battle.kind == "invade" && outcome.AttackerWins && isColony(targetCd) {
+		for _, attacker := range attackers {
+			if attacker.order.Kind != "invade" || attacker.force.Soldiers == 0 {
+				continue
+			} else if captor == nil || attacker.force.Soldiers > captor.force.Soldiers {
+				captor = attacker
+			}
+		}
+	}
+	if captor != nil {
+		err = e.transferSC(q, battle.targetID, captor.order.EmpireID, turnNo)
+		if errors.Is(err, ErrAlreadyTransferred) {
+			captor = nil
+		} else if err != nil {
+			return err
+		}
+	}
+
+	for _, attacker := range attackers {
+		result := &sqlite.CreateSCCombatResultParams{
+			CombatID:       attacker.order.CombatID,
+			Effdt:          turnNo,
+			EmpireID:       attacker.order.EmpireID,
+			TargetEmpireID: targetEmpireID,
+			Rounds:         int64(outcome.Rounds),
+			MassPct:        outcome.Defender.MassPct,
+			Status:         "lost",
+		}
+		if outcome.AttackerWins {
+			result.Status = "won"
+		}
+		if attacker == captor {
+			result.Reason = "captured the colony"
+		}
+		results[attacker.order.CombatID] = result
+	}
+	for _, supporter := range defenders[1:] {
+		result := &sqlite.CreateSCCombatResultParams{
+			CombatID:       supporter.order.CombatID,
+			Effdt:          turnNo,
+			EmpireID:       supporter.order.EmpireID,
+			TargetEmpireID: targetEmpireID,
+			Rounds:         int64(outcome.Rounds),
+			MassPct:        outcome.Attacker.MassPct,
+			Status:         "won",
+		}
+		if outcome.AttackerWins {
+			result.Status = "lost"
+		}
+		results[supporter.order.CombatID] = result
+	}
+
+	return nil
+}
+
+// readCombatant returns the forces that a ship or colony commits to a battle.
+// The target of an attack (order is nil) commits everything it has.
+// Fuel is not committed; all of it is available to operate weapons.
+func (e *Engine_t) readCombatant(q *sqlite.Queries, scID int64, order *sqlite.ReadAllCombatOrdersByTurnRow, turnNo int64) (*combatant_t, error) {
+	pct := int64(100)
+	if order != nil {
+		pct = order.PctCommitted
+	}
+	c := &combatant_t{scID: scID, order: order, force: &CombatForce_t{Units: map[CombatUnit_t]int64{}}}
+	if order != nil {
+		c.empireID = order.EmpireID
+	}
+
+	inventory, err := q.ReadSCInventory(e.Store.Context, sqlite.ReadSCInventoryParams{ScID: scID, AsOfDt: turnNo})
+	if err != nil {
+		return nil, err
+	}
+	c.inventory = inventory
+	for _, row := range inventory {
+		c.force.Mass += row.Mass
+		key := CombatUnit_t{Code: row.UnitCd, TechLevel: row.UnitTechLevel}
+		switch row.UnitCd {
+		case "ESH", "EWP", "MSL":
+			if row.IsAssembled == 1 {
+				c.force.Units[key] += row.Qty * pct / 100
+			}
+		case "ANM", "ASC", "ASW", "MSS", "MTBT":
+			c.force.Units[key] += row.Qty * pct / 100
+		case "FUEL":
+			c.force.Fuel += row.Qty
+		case "MTSP":
+			c.force.Supplies += row.Qty * pct / 100
+		}
+	}
+
+	population, err := q.ReadSCPopulation(e.Store.Context, sqlite.ReadSCPopulationParams{ScID: scID, AsOfDt: turnNo})
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range population {
+		if row.PopulationCd == "SLD" {
+			c.force.Soldiers += row.Qty * pct / 100
+		}
+	}
+
+	return c, nil
+}
+
+// combinedForce returns the sum of the forces of all the ships and colonies on a side.
+func combinedForce(side []*combatant_t) *CombatForce_t {
+	f := &CombatForce_t{Units: map[CombatUnit_t]int64{}}
+	for _, c := range side {
+		for key, qty := range c.force.Units {
+			f.Units[key] += qty
+		}
+		f.Soldiers += c.force.Soldiers
+		f.Supplies += c.force.Supplies
+		f.Fuel += c.force.Fuel
+		f.Mass += c.force.Mass
+	}
+	return f
+}
+
+// applyCombatLosses removes the losses of one side from the ships and
+// colonies on that side. Units used or destroyed are shared out in
+// proportion to what each committed. Mass destroyed by weapons fire is
+// taken from everything else in the inventory and from the population.
+func (e *Engine_t) applyCombatLosses(q *sqlite.Queries, turnNo int64, side []*combatant_t, losses CombatLosses_t) error {
+	lost := make([]map[CombatUnit_t]int64, len(side))
+	for i := range side {
+		lost[i] = map[CombatUnit_t]int64{}
+	}
+	weights := make([]int64, len(side))
+
+	// units fired or destroyed are keyed by unit and tech level
+	for _, byKey := range []map[CombatUnit_t]int64{losses.Expended, losses.Destroyed} {
+		for key, qty := range byKey {
+			for i, c := range side {
+				switch key.Code {
+				case "FUEL":
+					weights[i] = c.force.Fuel
+				case "MTSP":
+					weights[i] = c.force.Supplies
+				default:
+					weights[i] = c.force.Units[key]
+				}
+			}
+			for i, share := range shareOut(qty, weights) {
+				lost[i][key] += share
+			}
+		}
+	}
+
+	for i, c := range side {
+		// the committed units are already counted in the losses, so the
+		// mass destroyed is only taken from the units that weren't committed.
+		committed := maps.Clone(c.force.Units)
+		for _, row := range c.inventory {
+			key := CombatUnit_t{Code: row.UnitCd, TechLevel: row.UnitTechLevel}
+			// fuel and supplies are used from the lowest tech level first.
+			// other units are lost from their assembled and stored entries in turn.
+			pool := key
+			if row.UnitCd == "FUEL" || row.UnitCd == "MTSP" {
+				pool = CombatUnit_t{Code: row.UnitCd}
+			}
+			qty := min(row.Qty, lost[i][pool])
+			lost[i][pool] -= qty
+			inBattle := min(row.Qty, committed[key])
+			committed[key] -= inBattle
+			qty += int64(math.Round(float64(row.Qty-max(qty, inBattle)) * losses.MassPct))
+			if qty = min(qty, row.Qty); qty <= 0 {
+				continue
+			}
+			if err := e.adjustInventoryEntry(q, c.scID, row.UnitCd, row.UnitTechLevel, row.IsAssembled == 1, turnNo, -qty); err != nil {
+				return err
+			}
+			if err := q.CreateSCCombatLoss(e.Store.Context, sqlite.CreateSCCombatLossParams{ScID: c.scID, Effdt: turnNo, EmpireID: c.empireID, Code: row.UnitCd, TechLevel: row.UnitTechLevel, Qty: qty}); err != nil {
+				return err
+			}
+		}
+	}
+
+	// casualties
+	for i, c := range side {
+		weights[i] = c.force.Soldiers
+	}
+	killed := shareOut(losses.Soldiers, weights)
+	for i, c := range side {
+		population, err := q.ReadSCPopulation(e.Store.Context, sqlite.ReadSCPopulationParams{ScID: c.scID, AsOfDt: turnNo})
+		if err != nil {
+			return err
+		}
+		for _, row := range population {
+			var qty, inBattle int64
+			if row.PopulationCd == "SLD" {
+				qty, inBattle = killed[i], c.force.Soldiers
+			}
+			qty += int64(math.Round(float64(row.Qty-max(qty, inBattle)) * losses.MassPct))
+			if qty = min(qty, row.Qty); qty <= 0 {
+				continue
+			}
+			if err := e.adjustPopulation(q, c.scID, row.PopulationCd, turnNo, -qty); err != nil {
+				return err
+			}
+			if err := q.CreateSCCombatLoss(e.Store.Context, sqlite.CreateSCCombatLossParams{ScID: c.scID, Effdt: turnNo, EmpireID: c.empireID, Code: row.PopulationCd, Qty: qty}); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// shareOut divides a quantity in proportion to the weights. Any remainder
+// is handed out one at a time, in order, to the entries with room for it.
+// No entry gets more than its weight.
+func shareOut(qty int64, weights []int64) []int64 {
+	shares := make([]int64, len(weights))
+	var total int64
+	for _, w := range weights {
+		total += w
+	}
+	if total <= 0 || qty <= 0 {
+		return shares
+	}
+	qty = min(qty, total)
+	remaining := qty
+	for i, w := range weights {
+		shares[i] = int64(float64(qty) * float64(w) / float64(total))
+		remaining -= shares[i]
+	}
+	for remaining > 0 {
+		for i := 0; remaining > 0 && i < len(weights); i++ {
+			if shares[i] < weights[i] {
+				shares[i]++
+				remaining--
+			}
+		}
+	}
+	return shares
+}
@@ -0,0 +1,173 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package engine
+
+import (
+	"fmt"
+	"github.com/playbymail/empyr/repos/sqlite"
+	"log"
+)
+
+// ExecuteDrafts executes all the draft and discharge orders for the current
+// turn.
+//
+// Trainees who finish their training on this turn graduate first, so that
+// they can be discharged on the same turn. Then the orders are executed in
+// the order they were given. A draft order moves unskilled workers into
+// training and uses consumer goods; a discharge order moves people back to
+// the unskilled workers. See training.go for the rules.
+func (e *Engine_t) ExecuteDrafts(gameCode string, turnNo int64) error {
+	// start a transaction
+	q, tx, err := e.Store.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// graduate the trainees who have finished their training
+	traineeRows, err := q.ReadAllTraineesByTurn(e.Store.Context, turnNo)
+	if err != nil {
+		return err
+	}
+	for _, trainee := range traineeRows {
+		if !isTrained(trainee.PopulationCd, trainee.DraftDt, turnNo) {
+			continue
+		}
+		owner, err := q.ReadSCOwner(e.Store.Context, sqlite.ReadSCOwnerParams{ScID: trainee.ScID, AsOfDt: turnNo})
+		if err != nil {
+			return err
+		}
+		available, err := e.readPopulationQty(q, trainee.ScID, "TRN", turnNo)
+		if err != nil {
+			return err
+		}
+		result := sqlite.CreateSCDraftResultParams{
+			DraftID:  trainee.DraftID,
+			Effdt:    turnNo,
+			EmpireID: owner.EmpireID,
+			Qty:      min(trainee.Qty, available),
+			Status:   "graduated",
+		}
+		if result.Qty < trainee.Qty {
+			result.Reason = fmt.Sprintf("%s trainees lost", commas(trainee.Qty-result.Qty))
+		}
+		if err = e.adjustPopulation(q, trainee.ScID, "TRN", turnNo, -result.Qty); err != nil {
+			return err
+		} else if err = e.adjustPopulation(q, trainee.ScID, trainee.PopulationCd, turnNo, result.Qty); err != nil {
+			return err
+		}
+		log.Printf("game %q: turn %d: sc %d: draft %d: %d %s: %s %q\n", gameCode, turnNo, trainee.ScID, trainee.DraftID, result.Qty, trainee.PopulationCd, result.Status, result.Reason)
+		err = q.CreateSCDraftResult(e.Store.Context, result)
+		if err != nil {
+			return err
+		}
+	}
+
+	// get a list of all the draft orders. these are the orders that need to be executed.
+	draftOrderRows, err := q.ReadAllDraftOrdersByTurn(e.Store.Context, turnNo)
+	if err != nil {
+		return err
+	}
+	for _, order := range draftOrderRows {
+		owner, err := q.ReadSCOwner(e.Store.Context, sqlite.ReadSCOwnerParams{ScID: order.ScID, AsOfDt: turnNo})
+		if err != nil {
+			return err
+		}
+		result := sqlite.CreateSCDraftResultParams{
+			DraftID:  order.DraftID,
+			Effdt:    turnNo,
+			EmpireID: owner.EmpireID,
+			Status:   "succeeded",
+		}
+		err = e.executeDraftOrder(q, order, turnNo, &result)
+		if err != nil {
+			return err
+		}
+		log.Printf("game %q: turn %d: sc %d: %s %d: %d %s: %s %q\n", gameCode, turnNo, order.ScID, order.Kind, order.DraftID, order.Qty, order.PopulationCd, result.Status, result.Reason)
+		err = q.CreateSCDraftResult(e.Store.Context, result)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// executeDraftOrder validates a draft or discharge order and, if it is
+// valid, moves the people. It updates the result with the quantity moved,
+// the consumer goods used, and the status of the order.
+func (e *Engine_t) executeDraftOrder(q *sqlite.Queries, order sqlite.ReadAllDraftOrdersByTurnRow, turnNo int64, result *sqlite.CreateSCDraftResultParams) error {
+	fail := func(reason string) error {
+		result.Status, result.Reason = "failed", reason
+		return nil
+	}
+	if !isProfession(order.PopulationCd) {
+		return fail(fmt.Sprintf("can't %s %s", order.Kind, order.PopulationCd))
+	}
+
+	if order.Kind == "discharge" {
+		available, err := e.readLoyalPopulation(q, order.ScID, order.PopulationCd, turnNo)
+		if err != nil {
+			return err
+		} else if available < order.Qty {
+			return fail(fmt.Sprintf("not enough %s", order.PopulationCd))
+		}
+		if err = e.adjustPopulation(q, order.ScID, order.PopulationCd, turnNo, -order.Qty); err != nil {
+			return err
+		} else if err = e.adjustPopulation(q, order.ScID, "USK", turnNo, order.Qty); err != nil {
+			return err
+		}
+		result.Qty = order.Qty
+		return nil
+	}
+
+	// drafts need the unskilled workers and the consumer goods to train them
+	available, err := e.readLoyalPopulation(q, order.ScID, "USK", turnNo)
+	if err != nil {
+		return err
+	} else if available < order.Qty {
+		return fail("not enough USK")
+	}
+	cngd := trainingCost(order.PopulationCd, order.Qty)
+	stored, err := e.readStoredQty(q, order.ScID, "CNGD", 0, turnNo)
+	if err != nil {
+		return err
+	} else if stored < cngd {
+		return fail("not enough CNGD")
+	}
+	if err = e.adjustPopulation(q, order.ScID, "USK", turnNo, -order.Qty); err != nil {
+		return err
+	} else if err = e.adjustPopulation(q, order.ScID, "TRN", turnNo, order.Qty); err != nil {
+		return err
+	} else if err = e.adjustInventory(q, order.ScID, "CNGD", 0, turnNo, -cngd); err != nil {
+		return err
+	}
+	result.Qty, result.CngdUsed = order.Qty, cngd
+	return nil
+}
+
+// readDraftReports returns the results of the draft and discharge orders
+// given by an empire's ships and colonies, and the trainees who graduated.
+func (e *Engine_t) readDraftReports(empireID, turnNo int64) ([]*DraftReport_t, error) {
+	rows, err := e.Store.Queries.ReadAllDraftResultsByEmpire(e.Store.Context, sqlite.ReadAllDraftResultsByEmpireParams{
+		EmpireID: empireID,
+		Effdt:    turnNo,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var drafts []*DraftReport_t
+	for _, row := range rows {
+		drafts = append(drafts, &DraftReport_t{
+			ScID:         row.ScID,
+			Kind:         row.Kind,
+			PopulationCd: row.PopulationCd,
+			OrderedQty:   commas(row.OrderedQty),
+			Qty:          commas(row.Qty),
+			CngdUsed:     commas(row.CngdUsed),
+			Status:       row.Status,
+			Reason:       row.Reason,
+		})
+	}
+	return drafts, nil
+}
@@ -0,0 +1,349 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package engine
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/playbymail/empyr/repos/sqlite"
+	"log"
+	"math/rand/v2"
+)
+
+// ExecuteEspionage executes all the espionage missions for the current turn.
+//
+// Agents are committed to missions in the order that the missions were
+// given; a mission fails if its ship or colony doesn't have enough loyal
+// agents left. Spies sent against a target must be in the same orbit as
+// the target. Counter-agents are posted first, then spies suppress the
+// counter-agents, then incite-rebels and steal-secrets missions are run
+// against what's left. Missions at home are run last, so special agents
+// can win back rebels incited on the same turn. The rules for each mission
+// are in espionage.go. Agents that are caught or killed are removed from
+// the population.
+func (e *Engine_t) ExecuteEspionage(gameCode string, turnNo int64) error {
+	// start a transaction
+	q, tx, err := e.Store.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// get a list of all the espionage orders. these are the orders that need to be executed.
+	espionageOrderRows, err := q.ReadAllEspionageOrdersByTurn(e.Store.Context, turnNo)
+	if err != nil {
+		return err
+	}
+	r := turnRand(gameCode+"/espionage", turnNo)
+
+	results := map[int64]*sqlite.CreateSCEspionageResultParams{}
+	for _, order := range espionageOrderRows {
+		results[order.EspionageID] = &sqlite.CreateSCEspionageResultParams{
+			EspionageID: order.EspionageID,
+			Effdt:       turnNo,
+			EmpireID:    order.EmpireID,
+			Status:      "succeeded",
+		}
+	}
+	failed := func(order *sqlite.ReadAllEspionageOrdersByTurnRow, reason string) {
+		results[order.EspionageID].Status, results[order.EspionageID].Reason = "failed", reason
+	}
+
+	// commit agents to missions
+	var missions []*sqlite.ReadAllEspionageOrdersByTurnRow
+	committed := map[int64]map[string]int64{}
+	for i := range espionageOrderRows {
+		order := &espionageOrderRows[i]
+		reason, err := e.validateEspionageOrder(q, order, turnNo, results[order.EspionageID])
+		if err != nil {
+			return err
+		} else if reason != "" {
+			failed(order, reason)
+			continue
+		}
+		agentCd := espionageAgentCd(order.Kind)
+		if committed[order.ScID] == nil {
+			committed[order.ScID] = map[string]int64{}
+		}
+		available, err := e.readLoyalPopulation(q, order.ScID, agentCd, turnNo)
+		if err != nil {
+			return err
+		} else if order.Qty > available-committed[order.ScID][agentCd] {
+			failed(order, "not enough agents")
+			continue
+		}
+		committed[order.ScID][agentCd] += order.Qty
+		missions = append(missions, order)
+	}
+
+	// post the counter-agents. there is at most one order per colony.
+	guards := map[int64]*sqlite.ReadAllEspionageOrdersByTurnRow{}
+	counterAgents := map[int64]int64{}
+	for _, order := range missions {
+		if order.Kind == "counter-agents" {
+			guards[order.TargetID] = order
+			counterAgents[order.TargetID] = order.Qty
+		}
+	}
+
+	// run the missions
+	for _, phase := range []map[string]bool{
+		{"suppress-agents": true},
+		{"incite-rebels": true, "steal-secrets": true},
+		{"check-rebels": true, "convert-rebels": true},
+	} {
+		for _, order := range missions {
+			if !phase[order.Kind] {
+				continue
+			}
+			result := results[order.EspionageID]
+			err = e.executeMission(q, r, turnNo, order, result, counterAgents)
+			if err != nil {
+				log.Printf("game %q: turn %d: sc %d: espionage %d: %s %d: %v\n", gameCode, turnNo, order.ScID, order.EspionageID, order.Kind, order.TargetID, err)
+				return err
+			}
+			if guard, ok := guards[order.TargetID]; ok && order.TargetID != order.ScID {
+				results[guard.EspionageID].EffectQty += result.AgentsLost
+			}
+		}
+	}
+
+	// counter-agents that were killed are removed from the population
+	for targetID, guard := range guards {
+		result := results[guard.EspionageID]
+		result.AgentsLost = guard.Qty - counterAgents[targetID]
+		if err = e.adjustPopulation(q, guard.ScID, "SPY", turnNo, -result.AgentsLost); err != nil {
+			return err
+		}
+	}
+
+	for _, order := range espionageOrderRows {
+		result := results[order.EspionageID]
+		log.Printf("game %q: turn %d: sc %d: espionage %d: %s %d: %s %q\n", gameCode, turnNo, order.ScID, order.EspionageID, order.Kind, order.TargetID, result.Status, result.Reason)
+		err = q.CreateSCEspionageResult(e.Store.Context, *result)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// espionageAgentCd returns the population code of the agents used on a mission.
+func espionageAgentCd(kind string) string {
+	switch kind {
+	case "check-rebels", "convert-rebels":
+		return "SAG"
+	}
+	return "SPY"
+}
+
+// validateEspionageOrder returns the reason that a mission can't be run,
+// or an empty string if it can. It sets the owner of the target in the
+// result.
+func (e *Engine_t) validateEspionageOrder(q *sqlite.Queries, order *sqlite.ReadAllEspionageOrdersByTurnRow, turnNo int64, result *sqlite.CreateSCEspionageResultParams) (string, error) {
+	owner, err := q.ReadSCOwner(e.Store.Context, sqlite.ReadSCOwnerParams{ScID: order.TargetID, AsOfDt: turnNo})
+	if errors.Is(err, sql.ErrNoRows) {
+		return "no such target", nil
+	} else if err != nil {
+		return "", err
+	}
+	result.TargetEmpireID = owner.EmpireID
+
+	switch order.Kind {
+	case "check-rebels", "convert-rebels", "counter-agents":
+		if order.TargetID != order.ScID {
+			return "mission must be run at home", nil
+		}
+		return "", nil
+	}
+	if owner.EmpireID == order.EmpireID {
+		return "may not spy on your own ship or colony", nil
+	}
+	from, err := q.ReadSCLocation(e.Store.Context, sqlite.ReadSCLocationParams{ScID: order.ScID, AsOfDt: turnNo})
+	if errors.Is(err, sql.ErrNoRows) {
+		return "ship or colony has no location", nil
+	} else if err != nil {
+		return "", err
+	}
+	to, err := q.ReadSCLocation(e.Store.Context, sqlite.ReadSCLocationParams{ScID: order.TargetID, AsOfDt: turnNo})
+	if errors.Is(err, sql.ErrNoRows) {
+		return "target has no location", nil
+	} else if err != nil {
+		return "", err
+	} else if from.OrbitID != to.OrbitID {
+		return "not in the same orbit as the target", nil
+	}
+	return "", nil
+}
+
+// executeMission runs a single mission and updates its result. Agents
+// sent against a target must get past the counter-agents guarding it.
+func (e *Engine_t) executeMission(q *sqlite.Queries, r *rand.Rand, turnNo int64, order *sqlite.ReadAllEspionageOrdersByTurnRow, result *sqlite.CreateSCEspionageResultParams, counterAgents map[int64]int64) error {
+	population, err := q.ReadSCPopulation(e.Store.Context, sqlite.ReadSCPopulationParams{ScID: order.TargetID, AsOfDt: turnNo})
+	if err != nil {
+		return err
+	}
+	var total, rebels int64
+	for _, row := range population {
+		total, rebels = total+row.Qty, rebels+row.RebelQty
+	}
+
+	switch order.Kind {
+	case "check-rebels":
+		result.AgentsLost = homeLosses(r, order.Qty)
+		result.EffectQty = estimateRebels(r, order.Qty, total, rebels)
+	case "convert-rebels":
+		result.AgentsLost = homeLosses(r, order.Qty)
+		result.EffectQty, err = e.adjustRebels(q, order.TargetID, turnNo, -convertRebels(r, order.Qty, rebels))
+		if err != nil {
+			return err
+		}
+	default:
+		caught, counterLost := interceptAgents(r, order.Qty, counterAgents[order.TargetID])
+		counterAgents[order.TargetID] -= counterLost
+		result.AgentsLost, result.AgentsThrough, result.CounterLost = caught, order.Qty-caught, counterLost
+		if result.AgentsThrough == 0 {
+			result.Status, result.Reason = "failed", "all agents were caught"
+			break
+		}
+		switch order.Kind {
+		case "suppress-agents":
+			killed := min(result.AgentsThrough, counterAgents[order.TargetID])
+			counterAgents[order.TargetID] -= killed
+			result.CounterLost += killed
+			result.EffectQty = killed
+		case "incite-rebels":
+			result.EffectQty, err = e.adjustRebels(q, order.TargetID, turnNo, inciteRebels(r, result.AgentsThrough, total-rebels))
+			if err != nil {
+				return err
+			}
+		case "steal-secrets":
+			result.EffectQty = result.AgentsThrough
+		}
+	}
+
+	return e.adjustPopulation(q, order.ScID, espionageAgentCd(order.Kind), turnNo, -result.AgentsLost)
+}
+
+// readLoyalPopulation returns the number of people in a population group
+// who are not rebels.
+func (e *Engine_t) readLoyalPopulation(q *sqlite.Queries, scID int64, populationCd string, turnNo int64) (int64, error) {
+	row, err := q.ReadSCPopulationCode(e.Store.Context, sqlite.ReadSCPopulationCodeParams{
+		ScID:         scID,
+		PopulationCd: populationCd,
+		AsOfDt:       turnNo,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	} else if err != nil {
+		return 0, err
+	}
+	return row.Qty - row.RebelQty, nil
+}
+
+// readEspionageReports returns the missions run by an empire and the
+// missions against it that were detected during the turn.
+func (e *Engine_t) readEspionageReports(empireID, turnNo int64) ([]*EspionageReport_t, error) {
+	rows, err := e.Store.Queries.ReadAllEspionageResultsByEmpire(e.Store.Context, sqlite.ReadAllEspionageResultsByEmpireParams{
+		EmpireID: empireID,
+		AsOfDt:   turnNo,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var reports []*EspionageReport_t
+	for _, row := range rows {
+		if row.ScEmpireID != empireID {
+			// the defender only sees the agents that were caught
+			if row.AgentsLost == 0 && row.CounterLost == 0 {
+				continue
+			}
+			reports = append(reports, &EspionageReport_t{
+				ScID:        "unknown",
+				Kind:        row.Kind,
+				TargetID:    row.TargetID,
+				IsDefender:  true,
+				Lost:        commas(row.AgentsLost),
+				CounterLost: commas(row.CounterLost),
+			})
+			continue
+		}
+		report := &EspionageReport_t{
+			ScID:        fmt.Sprintf("%d", row.ScID),
+			Kind:        row.Kind,
+			TargetID:    row.TargetID,
+			Agents:      commas(row.Qty),
+			Lost:        commas(row.AgentsLost),
+			CounterLost: commas(row.CounterLost),
+			Status:      row.Status,
+			Reason:      row.Reason,
+		}
+		if row.Status == "succeeded" {
+			switch row.Kind {
+			case "check-rebels":
+				report.Effect = fmt.Sprintf("about %s rebels", commas(row.EffectQty))
+			case "convert-rebels":
+				report.Effect = fmt.Sprintf("%s rebels converted", commas(row.EffectQty))
+			case "counter-agents":
+				report.Effect = fmt.Sprintf("%s foreign agents caught", commas(row.EffectQty))
+			case "incite-rebels":
+				report.Effect = fmt.Sprintf("%s rebels incited", commas(row.EffectQty))
+			case "steal-secrets":
+				report.Effect = fmt.Sprintf("%s agents got through", commas(row.EffectQty))
+			case "suppress-agents":
+				report.Effect = fmt.Sprintf("%s counter-agents killed", commas(row.EffectQty))
+			}
+		}
+		reports = append(reports, report)
+	}
+	return reports, nil
+}
+
+// readStolenReports returns the colony reports stolen by an empire's spies
+// during the turn. The reports are built from the target as it stands at
+// the end of the turn and redacted by the number of spies that got through.
+// Targets that were destroyed after the mission are left out.
+func (e *Engine_t) readStolenReports(gameCode string, empireID, turnNo int64) ([]*StolenReport_t, error) {
+	rows, err := e.Store.Queries.ReadAllEspionageResultsByEmpire(e.Store.Context, sqlite.ReadAllEspionageResultsByEmpireParams{
+		EmpireID: empireID,
+		AsOfDt:   turnNo,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var reports []*StolenReport_t
+	for _, row := range rows {
+		if row.ScEmpireID != empireID || row.Kind != "steal-secrets" || row.Status != "succeeded" {
+			continue
+		}
+		report := &StolenReport_t{
+			ScID:     row.ScID,
+			TargetID: row.TargetID,
+			Agents:   commas(row.EffectQty),
+			Colony:   &ColonyReport_t{Id: row.TargetID},
+		}
+		if location, err := e.Store.Queries.ReadSCLocation(e.Store.Context, sqlite.ReadSCLocationParams{ScID: row.TargetID, AsOfDt: turnNo}); errors.Is(err, sql.ErrNoRows) {
+			// the target was destroyed, so there is nothing to report
+			continue
+		} else if err != nil {
+			return nil, err
+		} else if star, err := e.Store.Queries.ReadStarSystem(e.Store.Context, location.StarID); err != nil {
+			return nil, err
+		} else {
+			report.Coordinates, report.OrbitNo = star.StarName, location.OrbitNo
+		}
+		if report.Colony.Census, err = e.readColonyCensus(row.TargetID, turnNo); err != nil {
+			return nil, err
+		} else if report.Colony.Inventory, err = e.readColonyInventory(row.TargetID, turnNo); err != nil {
+			return nil, err
+		} else if report.Colony.FactoryGroups, err = e.readColonyFactoryGroups(row.TargetID, turnNo); err != nil {
+			return nil, err
+		}
+		// seed by mission so the same lines are revealed every time the report is run
+		redactColonyReport(turnRand(fmt.Sprintf("%s/steal-secrets/%d", gameCode, row.EspionageID), turnNo), row.EffectQty, report.Colony)
+		reports = append(reports, report)
+	}
+	return reports, nil
+}
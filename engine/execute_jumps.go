@@ -0,0 +1,155 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package engine
+
+import (
+	"database/sql"
+	"errors"
+	"github.com/playbymail/empyr/pkg/empyr"
+	"github.com/playbymail/empyr/repos/sqlite"
+	"log"
+	"math"
+	"sort"
+)
+
+// ExecuteJumps executes all the jump orders for the current turn.
+//
+// A jump moves a ship to an orbit in another system. The drive setup is
+// built from the assembled hyper engines in the ship's inventory and the
+// rules for lift, range, and fuel are applied by empyr.Ship. Each engine
+// needs 1 professional for every 100 engines; engines without a crew can't
+// be used. The ship always arrives in orbit.
+func (e *Engine_t) ExecuteJumps(gameCode string, turnNo int64) error {
+	// start a transaction
+	q, tx, err := e.Store.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// get a list of all the jump orders. these are the orders that need to be executed.
+	jumpOrderRows, err := q.ReadAllJumpOrdersByTurn(e.Store.Context, turnNo)
+	if err != nil {
+		return err
+	}
+
+	for _, jumpOrder := range jumpOrderRows {
+		result, err := e.executeJump(q, turnNo, jumpOrder)
+		if err != nil {
+			log.Printf("game %q: turn %d: ship %d: jump %d: %v\n", gameCode, turnNo, jumpOrder.ScID, jumpOrder.JumpID, err)
+			return err
+		}
+		log.Printf("game %q: turn %d: ship %d: jump %d: %s %q\n", gameCode, turnNo, jumpOrder.ScID, jumpOrder.JumpID, result.Status, result.Reason)
+		err = q.CreateSCJumpResult(e.Store.Context, *result)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// executeJump executes a single jump order and returns the result.
+// Errors that are the player's fault are returned in the result;
+// the error is reserved for problems with the database.
+func (e *Engine_t) executeJump(q *sqlite.Queries, turnNo int64, order sqlite.ReadAllJumpOrdersByTurnRow) (*sqlite.CreateSCJumpResultParams, error) {
+	result := &sqlite.CreateSCJumpResultParams{
+		JumpID:    order.JumpID,
+		Effdt:     turnNo,
+		ToOrbitID: order.OrbitID,
+		Status:    "failed",
+	}
+
+	loc, err := q.ReadSCLocation(e.Store.Context, sqlite.ReadSCLocationParams{ScID: order.ScID, AsOfDt: turnNo})
+	if errors.Is(err, sql.ErrNoRows) {
+		// the result must reference an orbit, so use the destination
+		result.FromOrbitID = order.OrbitID
+		result.Reason = "ship has no location"
+		return result, nil
+	} else if err != nil {
+		return nil, err
+	}
+	result.FromOrbitID = loc.OrbitID
+
+	if order.ScCd != "SHIP" {
+		result.Reason = "only ships may jump"
+		return result, nil
+	} else if loc.Effdt == turnNo {
+		result.Reason = ErrAlreadyMoved.Error()
+		return result, nil
+	} else if order.SystemID == loc.SystemID {
+		result.Reason = "orbit is in the same system; use move"
+		return result, nil
+	} else if loc.IsOnSurface == 1 {
+		result.Reason = "ship must be in orbit to jump"
+		return result, nil
+	}
+
+	inventory, err := q.ReadSCInventory(e.Store.Context, sqlite.ReadSCInventoryParams{ScID: order.ScID, AsOfDt: turnNo})
+	if err != nil {
+		return nil, err
+	}
+	pro, err := e.readPopulationQty(q, order.ScID, "PRO", turnNo)
+	if err != nil {
+		return nil, err
+	}
+	ship := shipForJump(inventory, pro)
+	ship.Location.Current = empyr.Location{X: int(loc.X), Y: int(loc.Y), Z: int(loc.Z)}
+	to := empyr.Location{X: int(order.X), Y: int(order.Y), Z: int(order.Z)}
+	result.Distance = ship.Location.Current.DistanceFrom(to)
+	result.Mass = inventoryMass(inventory)
+	result.EnginesUsed = int64(ship.JumpDrivesNeeded())
+
+	_, fuelConsumed, err := ship.Jump(to)
+	if err != nil {
+		result.Reason = err.Error()
+		return result, nil
+	}
+	result.FuelUsed = int64(math.Ceil(fuelConsumed))
+
+	err = e.adjustInventory(q, order.ScID, "FUEL", 0, turnNo, -result.FuelUsed)
+	if err != nil {
+		return nil, err
+	}
+	err = e.relocateSC(q, order.ScID, turnNo, loc.Effdt, order.OrbitID, 0)
+	if err != nil {
+		return nil, err
+	}
+	result.Status, result.Reason = "jumped", ""
+
+	return result, nil
+}
+
+// shipForJump builds the drive setup for a jump from the ship's inventory.
+// Only assembled hyper engines that the professionals can crew are used,
+// starting with the highest tech level.
+func shipForJump(inventory []sqlite.ReadSCInventoryRow, pro int64) empyr.Ship {
+	ship := empyr.Ship{Mass: int(math.Ceil(inventoryMass(inventory)))}
+	sort.Slice(inventory, func(i, j int) bool {
+		return inventory[i].UnitTechLevel > inventory[j].UnitTechLevel
+	})
+	for _, row := range inventory {
+		if row.UnitCd == "HEN" && row.IsAssembled == 1 {
+			qty := min(row.Qty, pro*100)
+			pro -= hyperEngineCrew(qty)
+			if qty == 0 {
+				continue
+			}
+			ship.JumpDrives = append(ship.JumpDrives, empyr.HyperEngine{
+				TechLevel: int(row.UnitTechLevel),
+				Quantity:  int(qty),
+				Mass:      row.Mass,
+				Volume:    row.Volume,
+			})
+		} else if row.UnitCd == "FUEL" {
+			ship.Fuel += float64(row.Qty)
+		}
+	}
+	return ship
+}
+
+// hyperEngineCrew returns the number of professionals needed to crew
+// hyper engines.
+func hyperEngineCrew(qty int64) int64 {
+	return (qty + 99) / 100
+}
@@ -0,0 +1,294 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package engine
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/playbymail/empyr/repos/sqlite"
+	"log"
+	"sort"
+)
+
+// ExecuteMarket executes all the buy and sell orders for the current turn.
+//
+// The orders are checked in the order they were given, then collected into
+// an order book for every product in every orbit. The books are cleared
+// using the rules in market.go, and the units and GOLD that were traded are
+// moved between the inventories of the buyers and sellers.
+//
+// The prices for every product that had an order are published for the
+// turn, for each orbit, since the books in one orbit don't set the prices
+// in another.
+func (e *Engine_t) ExecuteMarket(gameCode string, turnNo int64) error {
+	// start a transaction
+	q, tx, err := e.Store.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// get a list of all the buy and sell orders. these are the orders that need to be executed.
+	marketOrderRows, err := q.ReadAllMarketOrdersByTurn(e.Store.Context, turnNo)
+	if err != nil {
+		return err
+	}
+
+	type product_t struct {
+		unitCd    string
+		techLevel int64
+	}
+	type book_t struct {
+		orbitID     int64
+		product     product_t
+		buys, sells []*marketOrder_t
+	}
+	books := map[string]*book_t{}
+	results := map[int64]*sqlite.CreateSCMarketResultParams{}
+	orders := map[int64]*marketOrder_t{}
+
+	// commit units, GOLD, and space to the orders
+	committed := map[int64]map[string]int64{}
+	capacities := map[int64]*capacity_t{}
+	for _, row := range marketOrderRows {
+		result := &sqlite.CreateSCMarketResultParams{
+			MarketID: row.MarketID,
+			Effdt:    turnNo,
+			EmpireID: row.EmpireID,
+			Status:   "succeeded",
+		}
+		results[row.MarketID] = result
+		if committed[row.ScID] == nil {
+			committed[row.ScID] = map[string]int64{}
+		}
+		code, qty := codeTL(row.UnitCd, row.TechLevel), row.Qty
+		if row.Kind == "buy" {
+			code, qty = "GOLD", marketBid(row.Qty, row.Price)
+		}
+		reason, err := e.validateMarketOrder(q, row, turnNo, qty+committed[row.ScID][code])
+		if err != nil {
+			return err
+		} else if reason != "" {
+			result.Status, result.Reason = "failed", reason
+			continue
+		}
+		if row.Kind == "buy" {
+			capacity, ok := capacities[row.ScID]
+			if !ok {
+				if capacity, err = e.readCapacity(q, row.ScID, turnNo); err != nil {
+					return err
+				}
+				capacities[row.ScID] = capacity
+			}
+			volume := VolumeStored(row.UnitCd, row.TechLevel, row.Qty)
+			if !capacity.hasRoomFor(volume) {
+				result.Status, result.Reason = "failed", "not enough space"
+				continue
+			}
+			capacity.used += volume
+		}
+		committed[row.ScID][code] += qty
+
+		product := product_t{unitCd: row.UnitCd, techLevel: row.TechLevel}
+		key := fmt.Sprintf("%d/%s", row.OrbitID, codeTL(row.UnitCd, row.TechLevel))
+		book, ok := books[key]
+		if !ok {
+			book = &book_t{orbitID: row.OrbitID, product: product}
+			books[key] = book
+		}
+		order := &marketOrder_t{id: row.MarketID, scID: row.ScID, empireID: row.EmpireID, qty: row.Qty, price: row.Price}
+		orders[row.MarketID] = order
+		if row.Kind == "buy" {
+			book.buys = append(book.buys, order)
+		} else {
+			book.sells = append(book.sells, order)
+		}
+	}
+
+	// clear the books in a fixed order so that the results can be reproduced
+	var keys []string
+	for key := range books {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	type price_t struct {
+		tradedQty, tradedGold int64
+		low, high             float64
+		bestBid, bestAsk      float64
+	}
+	type priceKey_t struct {
+		orbitID int64
+		product product_t
+	}
+	prices := map[priceKey_t]*price_t{}
+	for _, key := range keys {
+		book := books[key]
+		mayTrade := func(buy, sell *marketOrder_t) (bool, error) {
+			if ok, err := e.hasPermission(q, sell.empireID, book.orbitID, "trade", buy.empireID, turnNo); err != nil || ok {
+				return ok, err
+			}
+			return e.hasPermission(q, buy.empireID, book.orbitID, "trade", sell.empireID, turnNo)
+		}
+		trades, err := clearMarket(book.buys, book.sells, mayTrade)
+		if err != nil {
+			return err
+		}
+		priceKey := priceKey_t{orbitID: book.orbitID, product: book.product}
+		price, ok := prices[priceKey]
+		if !ok {
+			price = &price_t{}
+			prices[priceKey] = price
+		}
+		for _, trade := range trades {
+			log.Printf("game %q: turn %d: market: orbit %d: %s: sc %d sold %d to sc %d for %d GOLD\n", gameCode, turnNo, book.orbitID, codeTL(book.product.unitCd, book.product.techLevel), trade.sell.scID, trade.qty, trade.buy.scID, trade.gold)
+			if err = e.adjustInventory(q, trade.sell.scID, book.product.unitCd, book.product.techLevel, turnNo, -trade.qty); err != nil {
+				return err
+			} else if err = e.adjustInventory(q, trade.buy.scID, book.product.unitCd, book.product.techLevel, turnNo, trade.qty); err != nil {
+				return err
+			} else if err = e.adjustInventory(q, trade.buy.scID, "GOLD", 0, turnNo, -trade.gold); err != nil {
+				return err
+			} else if err = e.adjustInventory(q, trade.sell.scID, "GOLD", 0, turnNo, trade.gold); err != nil {
+				return err
+			}
+			if price.tradedQty == 0 || trade.price < price.low {
+				price.low = trade.price
+			}
+			price.high = max(price.high, trade.price)
+			price.tradedQty, price.tradedGold = price.tradedQty+trade.qty, price.tradedGold+trade.gold
+		}
+		for _, order := range book.buys {
+			if order.filled < order.qty {
+				price.bestBid = max(price.bestBid, order.price)
+			}
+		}
+		for _, order := range book.sells {
+			if order.filled < order.qty && (price.bestAsk == 0 || order.price < price.bestAsk) {
+				price.bestAsk = order.price
+			}
+		}
+	}
+
+	for _, row := range marketOrderRows {
+		result := results[row.MarketID]
+		if order, ok := orders[row.MarketID]; ok {
+			result.FilledQty, result.GoldQty = order.filled, order.gold
+			if order.filled == 0 {
+				result.Status, result.Reason = "failed", "no matching orders"
+			} else if order.filled < order.qty {
+				result.Reason = "partly filled"
+			}
+		}
+		log.Printf("game %q: turn %d: sc %d: market %d: %s %d %s: %s %q\n", gameCode, turnNo, row.ScID, row.MarketID, row.Kind, row.Qty, codeTL(row.UnitCd, row.TechLevel), result.Status, result.Reason)
+		err = q.CreateSCMarketResult(e.Store.Context, *result)
+		if err != nil {
+			return err
+		}
+	}
+
+	for key, price := range prices {
+		parms := sqlite.CreateMarketPriceParams{
+			Effdt:     turnNo,
+			OrbitID:   key.orbitID,
+			UnitCd:    key.product.unitCd,
+			TechLevel: key.product.techLevel,
+			TradedQty: price.tradedQty,
+			LowPrice:  price.low,
+			HighPrice: price.high,
+			BestBid:   price.bestBid,
+			BestAsk:   price.bestAsk,
+		}
+		if price.tradedQty != 0 {
+			parms.AvgPrice = float64(price.tradedGold) / float64(price.tradedQty)
+		}
+		err = q.CreateMarketPrice(e.Store.Context, parms)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// validateMarketOrder returns the reason that an order can't be placed,
+// or an empty string if it can. needed is the number of units (for a sell
+// order) or GOLD (for a buy order) that the ship or colony must have,
+// including what it has committed to earlier orders.
+func (e *Engine_t) validateMarketOrder(q *sqlite.Queries, order sqlite.ReadAllMarketOrdersByTurnRow, turnNo, needed int64) (string, error) {
+	if order.UnitCd == "GOLD" {
+		return "may not trade GOLD", nil
+	}
+	unitCd, techLevel := order.UnitCd, order.TechLevel
+	if order.Kind == "buy" {
+		unitCd, techLevel = "GOLD", 0
+	}
+	row, err := q.ReadSCInventoryUnit(e.Store.Context, sqlite.ReadSCInventoryUnitParams{
+		ScID:          order.ScID,
+		UnitCd:        unitCd,
+		UnitTechLevel: techLevel,
+		IsAssembled:   0,
+		AsOfDt:        turnNo,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		row.Qty = 0
+	} else if err != nil {
+		return "", err
+	}
+	if row.Qty < needed {
+		if order.Kind == "buy" {
+			return "not enough GOLD", nil
+		}
+		return "not enough units", nil
+	}
+	return "", nil
+}
+
+// readMarketReports returns the results of the buy and sell orders given by
+// an empire's ships and colonies, and the prices published for the turn.
+func (e *Engine_t) readMarketReports(empireID, turnNo int64) ([]*MarketOrderReport_t, []*MarketPriceReport_t, error) {
+	rows, err := e.Store.Queries.ReadAllMarketResultsByEmpire(e.Store.Context, sqlite.ReadAllMarketResultsByEmpireParams{
+		EmpireID: empireID,
+		Effdt:    turnNo,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	var orders []*MarketOrderReport_t
+	for _, row := range rows {
+		orders = append(orders, &MarketOrderReport_t{
+			ScID:      row.ScID,
+			Kind:      row.Kind,
+			Code:      codeTL(row.UnitCd, row.TechLevel),
+			Qty:       commas(row.Qty),
+			Price:     fmt.Sprintf("%.2f", row.Price),
+			FilledQty: commas(row.FilledQty),
+			GoldQty:   commas(row.GoldQty),
+			Status:    row.Status,
+			Reason:    row.Reason,
+		})
+	}
+	priceRows, err := e.Store.Queries.ReadMarketPrices(e.Store.Context, turnNo)
+	if err != nil {
+		return nil, nil, err
+	}
+	price := func(p float64) string {
+		if p == 0 {
+			return "-"
+		}
+		return fmt.Sprintf("%.2f", p)
+	}
+	var prices []*MarketPriceReport_t
+	for _, row := range priceRows {
+		prices = append(prices, &MarketPriceReport_t{
+			Location:  fmt.Sprintf("%s Orbit # %d", row.StarName, row.OrbitNo),
+			Code:      codeTL(row.UnitCd, row.TechLevel),
+			TradedQty: commas(row.TradedQty),
+			LowPrice:  price(row.LowPrice),
+			HighPrice: price(row.HighPrice),
+			AvgPrice:  price(row.AvgPrice),
+			BestBid:   price(row.BestBid),
+			BestAsk:   price(row.BestAsk),
+		})
+	}
+	return orders, prices, nil
+}
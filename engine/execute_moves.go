@@ -0,0 +1,187 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package engine
+
+import (
+	"database/sql"
+	"errors"
+	"github.com/playbymail/empyr/internal/domains"
+	"github.com/playbymail/empyr/repos/sqlite"
+	"log"
+	"math"
+	"sort"
+)
+
+const (
+	ErrAlreadyMoved = Error("ship has already moved this turn")
+)
+
+// ExecuteMoves executes all the move orders for the current turn.
+//
+// A move changes the orbit of a ship within the system it is in, or lands
+// it on (or lifts it off of) the planet in the orbit. The ship's assembled
+// space drives must produce enough thrust (3000 x TL^2 per unit) to move the
+// total mass of the ship. Each drive needs 1 professional for every 100
+// drives; drives without a crew don't produce thrust. Every crewed drive
+// burns 1 x TL fuel per move.
+func (e *Engine_t) ExecuteMoves(gameCode string, turnNo int64) error {
+	// start a transaction
+	q, tx, err := e.Store.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// get a list of all the move orders. these are the orders that need to be executed.
+	moveOrderRows, err := q.ReadAllMoveOrdersByTurn(e.Store.Context, turnNo)
+	if err != nil {
+		return err
+	}
+
+	for _, moveOrder := range moveOrderRows {
+		result, err := e.executeMove(q, turnNo, moveOrder)
+		if err != nil {
+			log.Printf("game %q: turn %d: ship %d: move %d: %v\n", gameCode, turnNo, moveOrder.ScID, moveOrder.MoveID, err)
+			return err
+		}
+		log.Printf("game %q: turn %d: ship %d: move %d: %s %q\n", gameCode, turnNo, moveOrder.ScID, moveOrder.MoveID, result.Status, result.Reason)
+		err = q.CreateSCMoveResult(e.Store.Context, *result)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// executeMove executes a single move order and returns the result.
+// Errors that are the player's fault are returned in the result;
+// the error is reserved for problems with the database.
+func (e *Engine_t) executeMove(q *sqlite.Queries, turnNo int64, order sqlite.ReadAllMoveOrdersByTurnRow) (*sqlite.CreateSCMoveResultParams, error) {
+	result := &sqlite.CreateSCMoveResultParams{
+		MoveID:        order.MoveID,
+		Effdt:         turnNo,
+		ToOrbitID:     order.OrbitID,
+		ToIsOnSurface: order.IsOnSurface,
+		Status:        "failed",
+	}
+
+	loc, err := q.ReadSCLocation(e.Store.Context, sqlite.ReadSCLocationParams{ScID: order.ScID, AsOfDt: turnNo})
+	if errors.Is(err, sql.ErrNoRows) {
+		// the result must reference an orbit, so use the destination
+		result.FromOrbitID = order.OrbitID
+		result.Reason = "ship has no location"
+		return result, nil
+	} else if err != nil {
+		return nil, err
+	}
+	result.FromOrbitID, result.FromIsOnSurface = loc.OrbitID, loc.IsOnSurface
+
+	if order.ScCd != "SHIP" {
+		result.Reason = "only ships may move"
+		return result, nil
+	} else if loc.Effdt == turnNo {
+		result.Reason = ErrAlreadyMoved.Error()
+		return result, nil
+	} else if order.SystemID != loc.SystemID {
+		result.Reason = "orbit is not in the same system; use jump"
+		return result, nil
+	} else if order.OrbitID == loc.OrbitID && order.IsOnSurface == loc.IsOnSurface {
+		result.Reason = "ship is already at that location"
+		return result, nil
+	} else if order.IsOnSurface == 1 && !(order.OrbitKind == "TERR" || order.OrbitKind == "ASTR") {
+		result.Reason = "ship can not land in that orbit"
+		return result, nil
+	}
+
+	inventory, err := q.ReadSCInventory(e.Store.Context, sqlite.ReadSCInventoryParams{ScID: order.ScID, AsOfDt: turnNo})
+	if err != nil {
+		return nil, err
+	}
+	pro, err := e.readPopulationQty(q, order.ScID, "PRO", turnNo)
+	if err != nil {
+		return nil, err
+	}
+	result.Mass = inventoryMass(inventory)
+	// crew the drives with the highest tech level first
+	sort.Slice(inventory, func(i, j int) bool {
+		return inventory[i].UnitTechLevel > inventory[j].UnitTechLevel
+	})
+	var fuelOnHand float64
+	var hasDrives bool
+	for _, row := range inventory {
+		if row.UnitCd == "SPD" && row.IsAssembled == 1 {
+			hasDrives = true
+			qty := min(row.Qty, pro*100)
+			pro -= spaceDriveCrew(qty)
+			result.Thrust += spaceDriveThrust(row.UnitTechLevel, qty)
+			result.FuelUsed += int64(math.Ceil(unitFuel(row.UnitCd, row.UnitTechLevel, qty)))
+		} else if row.UnitCd == "FUEL" {
+			fuelOnHand += float64(row.Qty)
+		}
+	}
+	if !hasDrives {
+		result.Reason = "ship has no assembled space drives"
+		result.FuelUsed = 0
+		return result, nil
+	} else if result.Thrust == 0 {
+		result.Reason = "space drives have no crew"
+		result.FuelUsed = 0
+		return result, nil
+	} else if result.Thrust < result.Mass {
+		result.Reason = "space drives can not move the mass of the ship"
+		result.FuelUsed = 0
+		return result, nil
+	} else if fuelOnHand < float64(result.FuelUsed) {
+		result.Reason = "insufficient fuel"
+		result.FuelUsed = 0
+		return result, nil
+	}
+
+	err = e.adjustInventory(q, order.ScID, "FUEL", 0, turnNo, -result.FuelUsed)
+	if err != nil {
+		return nil, err
+	}
+	err = e.relocateSC(q, order.ScID, turnNo, loc.Effdt, order.OrbitID, order.IsOnSurface)
+	if err != nil {
+		return nil, err
+	}
+	result.Status, result.Reason = "moved", ""
+
+	return result, nil
+}
+
+// relocateSC end-dates the current location of a ship or colony and
+// creates a new location effective on the turn.
+func (e *Engine_t) relocateSC(q *sqlite.Queries, scID, turnNo, currentEffdt, orbitID, isOnSurface int64) error {
+	if currentEffdt == turnNo {
+		return ErrAlreadyMoved
+	}
+	err := q.UpdateSCLocationEndDt(e.Store.Context, sqlite.UpdateSCLocationEndDtParams{
+		Enddt: turnNo,
+		ScID:  scID,
+		Effdt: currentEffdt,
+	})
+	if err != nil {
+		return err
+	}
+	return q.CreateSCLocation(e.Store.Context, sqlite.CreateSCLocationParams{
+		ScID:        scID,
+		Effdt:       turnNo,
+		Enddt:       domains.MaxGameTurnNo,
+		OrbitID:     orbitID,
+		IsOnSurface: isOnSurface,
+	})
+}
+
+// spaceDriveCrew returns the number of professionals needed to crew
+// space drives.
+func spaceDriveCrew(qty int64) int64 {
+	return (qty + 99) / 100
+}
+
+// spaceDriveThrust returns the thrust produced by a group of space drives.
+func spaceDriveThrust(techLevel, quantity int64) float64 {
+	tl, qty := float64(techLevel), float64(quantity)
+	return 3_000 * tl * tl * qty
+}
@@ -0,0 +1,325 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package engine
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/playbymail/empyr/internal/domains"
+	"github.com/playbymail/empyr/repos/sqlite"
+	"log"
+)
+
+const (
+	namesMaxLength = 32 // longest name that can be given to an empire, place, ship or colony
+)
+
+// ExecuteNames executes all the name orders for the current turn.
+//
+// An empire may name itself, any system or star that it knows about, and
+// its own ships and colonies. The names given to systems and stars are
+// private to the empire and are shown only on its own reports.
+//
+// Names are effective-dated. The new name replaces the current one starting
+// with the current turn. If a name is given more than once on a turn, the
+// last order wins.
+func (e *Engine_t) ExecuteNames(gameCode string, turnNo int64) error {
+	// start a transaction
+	q, tx, err := e.Store.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// get a list of all the name orders. these are the orders that need to be executed.
+	nameOrderRows, err := q.ReadAllNameOrdersByTurn(e.Store.Context, turnNo)
+	if err != nil {
+		return err
+	}
+
+	for _, order := range nameOrderRows {
+		result := sqlite.CreateEmpireNameResultParams{
+			NameID: order.NameID,
+			Effdt:  turnNo,
+			Status: "succeeded",
+		}
+		reason, err := e.executeNameOrder(q, order, turnNo)
+		if err != nil {
+			return err
+		} else if reason != "" {
+			result.Status, result.Reason = "failed", reason
+		}
+		log.Printf("game %q: turn %d: empire %d: name %d: %s %d %q: %s %q\n", gameCode, turnNo, order.EmpireID, order.NameID, order.Kind, order.TargetID, order.Name, result.Status, result.Reason)
+		err = q.CreateEmpireNameResult(e.Store.Context, result)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// executeNameOrder validates a name order and, if it is valid, updates the
+// name. It returns the reason that the order failed, or an empty string if
+// it succeeded.
+func (e *Engine_t) executeNameOrder(q *sqlite.Queries, order sqlite.ReadAllNameOrdersByTurnRow, turnNo int64) (string, error) {
+	if order.Name == "" || len(order.Name) > namesMaxLength {
+		return "name is empty or too long", nil
+	} else if _, err := IsValidName(order.Name); err != nil {
+		return "name has special characters", nil
+	}
+	switch order.Kind {
+	case "empire":
+		if order.TargetID != order.EmpireID {
+			return "may only name own empire", nil
+		}
+		return "", e.nameEmpire(q, order.EmpireID, order.Name, turnNo)
+	case "system":
+		count, err := q.ReadEmpireKnowsSystem(e.Store.Context, sqlite.ReadEmpireKnowsSystemParams{
+			SystemID: order.TargetID,
+			EmpireID: order.EmpireID,
+			AsOfDt:   turnNo,
+		})
+		if err != nil {
+			return "", err
+		} else if count == 0 {
+			return "system is not known", nil
+		}
+		return "", e.nameSystem(q, order.EmpireID, order.TargetID, order.Name, turnNo)
+	case "star":
+		count, err := q.ReadEmpireKnowsStar(e.Store.Context, sqlite.ReadEmpireKnowsStarParams{
+			StarID:   order.TargetID,
+			EmpireID: order.EmpireID,
+			AsOfDt:   turnNo,
+		})
+		if err != nil {
+			return "", err
+		} else if count == 0 {
+			return "star is not known", nil
+		}
+		return "", e.nameStar(q, order.EmpireID, order.TargetID, order.Name, turnNo)
+	case "sc":
+		row, err := q.ReadSCOwner(e.Store.Context, sqlite.ReadSCOwnerParams{ScID: order.TargetID, AsOfDt: turnNo})
+		if errors.Is(err, sql.ErrNoRows) {
+			return "no such ship or colony", nil
+		} else if err != nil {
+			return "", err
+		} else if row.EmpireID != order.EmpireID {
+			return "not owner of ship or colony", nil
+		}
+		return "", e.nameSC(q, order.TargetID, order.Name, turnNo)
+	}
+	return fmt.Sprintf("can't name %q", order.Kind), nil
+}
+
+// nameEmpire updates the name of an empire, effective on the turn.
+// A name that was already given on the turn is corrected in place.
+func (e *Engine_t) nameEmpire(q *sqlite.Queries, empireID int64, name string, turnNo int64) error {
+	row, err := q.ReadEmpireName(e.Store.Context, sqlite.ReadEmpireNameParams{EmpireID: empireID, AsOfDt: turnNo})
+	if errors.Is(err, sql.ErrNoRows) {
+		row.Effdt, row.Enddt = -1, domains.MaxGameTurnNo
+	} else if err != nil {
+		return err
+	} else if row.Name == name {
+		return nil
+	} else if row.Effdt == turnNo {
+		return q.CorrectEmpireName(e.Store.Context, sqlite.CorrectEmpireNameParams{
+			Name:     name,
+			EmpireID: empireID,
+			Effdt:    row.Effdt,
+			Enddt:    row.Enddt,
+		})
+	} else {
+		err = q.ExpireEmpireName(e.Store.Context, sqlite.ExpireEmpireNameParams{
+			Enddt:    turnNo,
+			EmpireID: empireID,
+			Effdt:    row.Effdt,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return q.CreateEmpireName(e.Store.Context, sqlite.CreateEmpireNameParams{
+		EmpireID: empireID,
+		Effdt:    turnNo,
+		Enddt:    row.Enddt,
+		Name:     name,
+	})
+}
+
+// nameSystem updates the name an empire gave a system, effective on the turn.
+// A name that was already given on the turn is corrected in place.
+func (e *Engine_t) nameSystem(q *sqlite.Queries, empireID, systemID int64, name string, turnNo int64) error {
+	row, err := q.ReadEmpireSystemName(e.Store.Context, sqlite.ReadEmpireSystemNameParams{EmpireID: empireID, SystemID: systemID, AsOfDt: turnNo})
+	if errors.Is(err, sql.ErrNoRows) {
+		row.Effdt, row.Enddt = -1, domains.MaxGameTurnNo
+	} else if err != nil {
+		return err
+	} else if row.Name == name {
+		return nil
+	} else if row.Effdt == turnNo {
+		return q.CorrectEmpireSystemName(e.Store.Context, sqlite.CorrectEmpireSystemNameParams{
+			Name:     name,
+			EmpireID: empireID,
+			SystemID: systemID,
+			Effdt:    row.Effdt,
+		})
+	} else {
+		err = q.UpdateEmpireSystemNameEndDt(e.Store.Context, sqlite.UpdateEmpireSystemNameEndDtParams{
+			Enddt:    turnNo,
+			EmpireID: empireID,
+			SystemID: systemID,
+			Effdt:    row.Effdt,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return q.CreateEmpireSystemName(e.Store.Context, sqlite.CreateEmpireSystemNameParams{
+		EmpireID: empireID,
+		SystemID: systemID,
+		Effdt:    turnNo,
+		Enddt:    row.Enddt,
+		Name:     name,
+	})
+}
+
+// nameStar updates the name an empire gave a star, effective on the turn.
+// A name that was already given on the turn is corrected in place.
+func (e *Engine_t) nameStar(q *sqlite.Queries, empireID, starID int64, name string, turnNo int64) error {
+	row, err := q.ReadEmpireStarName(e.Store.Context, sqlite.ReadEmpireStarNameParams{EmpireID: empireID, StarID: starID, AsOfDt: turnNo})
+	if errors.Is(err, sql.ErrNoRows) {
+		row.Effdt, row.Enddt = -1, domains.MaxGameTurnNo
+	} else if err != nil {
+		return err
+	} else if row.Name == name {
+		return nil
+	} else if row.Effdt == turnNo {
+		return q.CorrectEmpireStarName(e.Store.Context, sqlite.CorrectEmpireStarNameParams{
+			Name:     name,
+			EmpireID: empireID,
+			StarID:   starID,
+			Effdt:    row.Effdt,
+		})
+	} else {
+		err = q.UpdateEmpireStarNameEndDt(e.Store.Context, sqlite.UpdateEmpireStarNameEndDtParams{
+			Enddt:    turnNo,
+			EmpireID: empireID,
+			StarID:   starID,
+			Effdt:    row.Effdt,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return q.CreateEmpireStarName(e.Store.Context, sqlite.CreateEmpireStarNameParams{
+		EmpireID: empireID,
+		StarID:   starID,
+		Effdt:    turnNo,
+		Enddt:    row.Enddt,
+		Name:     name,
+	})
+}
+
+// nameSC updates the name of a ship or colony, effective on the turn.
+// A name that was already given on the turn is corrected in place.
+func (e *Engine_t) nameSC(q *sqlite.Queries, scID int64, name string, turnNo int64) error {
+	row, err := q.ReadSCName(e.Store.Context, sqlite.ReadSCNameParams{ScID: scID, AsOfDt: turnNo})
+	if errors.Is(err, sql.ErrNoRows) {
+		row.Effdt, row.Enddt = -1, domains.MaxGameTurnNo
+	} else if err != nil {
+		return err
+	} else if row.Name == name {
+		return nil
+	} else if row.Effdt == turnNo {
+		return q.CorrectSCName(e.Store.Context, sqlite.CorrectSCNameParams{
+			Name:  name,
+			ScID:  scID,
+			Effdt: row.Effdt,
+		})
+	} else {
+		err = q.UpdateSCNameEndDt(e.Store.Context, sqlite.UpdateSCNameEndDtParams{
+			Enddt: turnNo,
+			ScID:  scID,
+			Effdt: row.Effdt,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return q.CreateSCName(e.Store.Context, sqlite.CreateSCNameParams{
+		ScID:  scID,
+		Name:  name,
+		Effdt: turnNo,
+		Enddt: row.Enddt,
+	})
+}
+
+// PrivateNames_t holds the names that an empire gave to systems and stars.
+type PrivateNames_t struct {
+	systems map[int64]string
+	stars   map[int64]string
+}
+
+// ReadPrivateNames returns the names that an empire gave to systems and
+// stars as of the turn.
+func (e *Engine_t) ReadPrivateNames(empireID, turnNo int64) (*PrivateNames_t, error) {
+	names := &PrivateNames_t{systems: map[int64]string{}, stars: map[int64]string{}}
+	systemRows, err := e.Store.Queries.ReadAllEmpireSystemNames(e.Store.Context, sqlite.ReadAllEmpireSystemNamesParams{EmpireID: empireID, AsOfDt: turnNo})
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range systemRows {
+		names.systems[row.SystemID] = row.Name
+	}
+	starRows, err := e.Store.Queries.ReadAllEmpireStarNames(e.Store.Context, sqlite.ReadAllEmpireStarNamesParams{EmpireID: empireID, AsOfDt: turnNo})
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range starRows {
+		names.stars[row.StarID] = row.Name
+	}
+	return names, nil
+}
+
+// Coordinates returns the display for a star, followed by the name the
+// empire gave it or its system, eg "02/13/28A (Home)".
+func (n *PrivateNames_t) Coordinates(systemID, starID int64, starName string) string {
+	if name, ok := n.stars[starID]; ok {
+		return fmt.Sprintf("%s (%s)", starName, name)
+	} else if name, ok = n.systems[systemID]; ok {
+		return fmt.Sprintf("%s (%s)", starName, name)
+	}
+	return starName
+}
+
+// readNameReports returns the results of the name orders given by an
+// empire on the turn.
+func (e *Engine_t) readNameReports(empireID, turnNo int64) ([]*NameOrderReport_t, error) {
+	rows, err := e.Store.Queries.ReadAllNameResultsByEmpire(e.Store.Context, sqlite.ReadAllNameResultsByEmpireParams{
+		EmpireID: empireID,
+		Effdt:    turnNo,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var orders []*NameOrderReport_t
+	for _, row := range rows {
+		report := &NameOrderReport_t{
+			Kind:   row.Kind,
+			Target: row.Coordinates,
+			Name:   row.Name,
+			Status: row.Status,
+			Reason: row.Reason,
+		}
+		switch row.Kind {
+		case "empire":
+			report.Target = fmt.Sprintf("E%03d", row.TargetID)
+		case "sc":
+			report.Target = fmt.Sprintf("S/C # %d", row.TargetID)
+		}
+		orders = append(orders, report)
+	}
+	return orders, nil
+}
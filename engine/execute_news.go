@@ -0,0 +1,151 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package engine
+
+import (
+	"database/sql"
+	"fmt"
+	"github.com/playbymail/empyr/repos/sqlite"
+	"log"
+)
+
+const (
+	newsMaxArticleLength   = 1_000 // longest article the Galactic News will print
+	newsMaxSignatureLength = 64    // longest signature the Galactic News will print
+	newsRange              = 5     // distance from a ship or colony that news can be observed
+)
+
+// ExecuteNews executes all the news orders for the current turn.
+//
+// An empire may post an article only in a system where it has a ship or
+// colony. Published articles are printed in the Galactic News section of
+// the turn report of every empire that has a ship or colony within range
+// of the system.
+func (e *Engine_t) ExecuteNews(gameCode string, turnNo int64) error {
+	// start a transaction
+	q, tx, err := e.Store.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// get a list of all the news orders. these are the orders that need to be executed.
+	newsOrderRows, err := q.ReadAllNewsOrdersByTurn(e.Store.Context, turnNo)
+	if err != nil {
+		return err
+	}
+
+	for _, order := range newsOrderRows {
+		result := sqlite.CreateEmpireNewsResultParams{
+			NewsID: order.NewsID,
+			Effdt:  turnNo,
+			Status: "succeeded",
+		}
+		if _, err := IsValidArticle(order.Article, newsMaxArticleLength); err != nil {
+			result.Status, result.Reason = "failed", "article is empty, too long, or has special characters"
+		} else if _, err := IsValidArticle(order.Signature, newsMaxSignatureLength); err != nil {
+			result.Status, result.Reason = "failed", "signature is empty, too long, or has special characters"
+		} else if count, err := q.ReadEmpireSCCountInSystem(e.Store.Context, sqlite.ReadEmpireSCCountInSystemParams{
+			EmpireID: order.EmpireID,
+			AsOfDt:   turnNo,
+			SystemID: order.SystemID,
+		}); err != nil {
+			return err
+		} else if count == 0 {
+			result.Status, result.Reason = "failed", "no ship or colony in system"
+		} else {
+			err = q.CreateNewsItem(e.Store.Context, sqlite.CreateNewsItemParams{
+				Effdt:     turnNo,
+				EmpireID:  sql.NullInt64{Int64: order.EmpireID, Valid: true},
+				SystemID:  sql.NullInt64{Int64: order.SystemID, Valid: true},
+				OrbitNo:   order.OrbitNo,
+				Article:   order.Article,
+				Signature: order.Signature,
+			})
+			if err != nil {
+				return err
+			}
+		}
+		log.Printf("game %q: turn %d: empire %d: news %d: %s %q\n", gameCode, turnNo, order.EmpireID, order.NewsID, result.Status, result.Reason)
+		err = q.CreateEmpireNewsResult(e.Store.Context, result)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+type CreateAnnouncementParams_t struct {
+	Article   string // text of the announcement
+	Signature string // signature printed after the announcement
+}
+
+// CreateAnnouncementCommand posts an announcement from the GM in the
+// Galactic News for the current turn. Announcements are sent to every
+// empire.
+func CreateAnnouncementCommand(e *Engine_t, cfg *CreateAnnouncementParams_t) error {
+	if _, err := IsValidArticle(cfg.Article, newsMaxArticleLength); err != nil {
+		return err
+	} else if _, err = IsValidArticle(cfg.Signature, newsMaxSignatureLength); err != nil {
+		return err
+	}
+	turnNo, err := e.Store.Queries.ReadCurrentTurn(e.Store.Context)
+	if err != nil {
+		return err
+	}
+	return e.Store.Queries.CreateNewsItem(e.Store.Context, sqlite.CreateNewsItemParams{
+		Effdt:     turnNo,
+		Article:   cfg.Article,
+		Signature: cfg.Signature,
+	})
+}
+
+// readNewsReports returns the items in the Galactic News that an empire
+// can observe, and the results of the news orders it gave on the turn.
+func (e *Engine_t) readNewsReports(empireID, turnNo int64) ([]*NewsReport_t, []*NewsOrderReport_t, error) {
+	rows, err := e.Store.Queries.ReadAllNewsByEmpire(e.Store.Context, sqlite.ReadAllNewsByEmpireParams{
+		Effdt:    turnNo,
+		EmpireID: empireID,
+		RangeSq:  newsRange * newsRange,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	var news []*NewsReport_t
+	for _, row := range rows {
+		report := &NewsReport_t{
+			Location:  "All Empires",
+			Article:   row.Article,
+			Signature: row.Signature,
+		}
+		if row.SystemName != "" {
+			report.Location = newsLocation(row.SystemName, row.OrbitNo)
+		}
+		news = append(news, report)
+	}
+	resultRows, err := e.Store.Queries.ReadAllNewsResultsByEmpire(e.Store.Context, sqlite.ReadAllNewsResultsByEmpireParams{
+		EmpireID: empireID,
+		Effdt:    turnNo,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	var orders []*NewsOrderReport_t
+	for _, row := range resultRows {
+		orders = append(orders, &NewsOrderReport_t{
+			Location: newsLocation(row.SystemName, row.OrbitNo),
+			Status:   row.Status,
+			Reason:   row.Reason,
+		})
+	}
+	return news, orders, nil
+}
+
+// newsLocation returns the display for the location of a news item.
+func newsLocation(systemName string, orbitNo int64) string {
+	if orbitNo == 0 {
+		return systemName
+	}
+	return fmt.Sprintf("%s Orbit # %d", systemName, orbitNo)
+}
@@ -0,0 +1,203 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package engine
+
+import (
+	"database/sql"
+	"errors"
+	"github.com/playbymail/empyr/internal/domains"
+	"github.com/playbymail/empyr/repos/sqlite"
+	"log"
+)
+
+// ExecutePermissions executes all the grant and revoke orders for the current turn.
+//
+// An empire may grant colonize and trade rights only in an orbit where it
+// owns a colony. Rights are effective-dated: a grant starts on the turn it
+// is given and a revoke ends the right on the turn it is given. The orders
+// are executed in the order they were given, so a right can be revoked and
+// granted again on the same turn.
+func (e *Engine_t) ExecutePermissions(gameCode string, turnNo int64) error {
+	// start a transaction
+	q, tx, err := e.Store.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// get a list of all the grant and revoke orders. these are the orders that need to be executed.
+	permissionOrderRows, err := q.ReadAllPermissionOrdersByTurn(e.Store.Context, turnNo)
+	if err != nil {
+		return err
+	}
+
+	for _, order := range permissionOrderRows {
+		result := sqlite.CreateEmpirePermissionResultParams{
+			PermissionID: order.PermissionID,
+			Effdt:        turnNo,
+			Status:       "succeeded",
+		}
+		reason, err := e.executePermissionOrder(q, order, turnNo)
+		if err != nil {
+			log.Printf("game %q: turn %d: empire %d: permission %d: %s %s %d: %v\n", gameCode, turnNo, order.EmpireID, order.PermissionID, order.Action, order.Kind, order.GranteeID, err)
+			return err
+		} else if reason != "" {
+			result.Status, result.Reason = "failed", reason
+		}
+		log.Printf("game %q: turn %d: empire %d: permission %d: %s %s %d: %s %q\n", gameCode, turnNo, order.EmpireID, order.PermissionID, order.Action, order.Kind, order.GranteeID, result.Status, result.Reason)
+		err = q.CreateEmpirePermissionResult(e.Store.Context, result)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// executePermissionOrder grants or revokes a right. It returns the reason
+// the order failed, or an empty string if it succeeded.
+func (e *Engine_t) executePermissionOrder(q *sqlite.Queries, order sqlite.ReadAllPermissionOrdersByTurnRow, turnNo int64) (string, error) {
+	if order.GranteeID == order.EmpireID {
+		return "may not grant rights to yourself", nil
+	}
+	isActive, err := q.IsEmpireActive(e.Store.Context, order.GranteeID)
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && isActive != 1) {
+		return "no such empire", nil
+	} else if err != nil {
+		return "", err
+	}
+	owners, err := q.ReadOrbitColonyOwners(e.Store.Context, sqlite.ReadOrbitColonyOwnersParams{OrbitID: order.OrbitID, AsOfDt: turnNo})
+	if err != nil {
+		return "", err
+	}
+	hasColony := false
+	for _, owner := range owners {
+		hasColony = hasColony || owner == order.EmpireID
+	}
+	if !hasColony {
+		return "no colony in orbit", nil
+	}
+
+	effdt, err := q.ReadEmpirePermission(e.Store.Context, sqlite.ReadEmpirePermissionParams{
+		EmpireID:  order.EmpireID,
+		OrbitID:   order.OrbitID,
+		Kind:      order.Kind,
+		GranteeID: order.GranteeID,
+		AsOfDt:    turnNo,
+	})
+	isGranted := err == nil
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return "", err
+	}
+
+	switch order.Action {
+	case "grant":
+		if isGranted {
+			return "already granted", nil
+		}
+		return "", q.CreateEmpirePermission(e.Store.Context, sqlite.CreateEmpirePermissionParams{
+			EmpireID:  order.EmpireID,
+			OrbitID:   order.OrbitID,
+			Kind:      order.Kind,
+			GranteeID: order.GranteeID,
+			Effdt:     turnNo,
+			Enddt:     domains.MaxGameTurnNo,
+		})
+	case "revoke":
+		if !isGranted {
+			return "not granted", nil
+		}
+		return "", q.UpdateEmpirePermissionEndDt(e.Store.Context, sqlite.UpdateEmpirePermissionEndDtParams{
+			Enddt:     turnNo,
+			EmpireID:  order.EmpireID,
+			OrbitID:   order.OrbitID,
+			Kind:      order.Kind,
+			GranteeID: order.GranteeID,
+			Effdt:     effdt,
+		})
+	}
+	return "unknown action", nil
+}
+
+// hasPermission returns true if an empire has granted a right in an orbit
+// to the grantee. An empire always has the right to deal with itself.
+// Trade and transfer orders use this to check the right to deal with the
+// other empire's ships and colonies.
+func (e *Engine_t) hasPermission(q *sqlite.Queries, empireID, orbitID int64, kind string, granteeID, turnNo int64) (bool, error) {
+	if empireID == granteeID {
+		return true, nil
+	}
+	_, err := q.ReadEmpirePermission(e.Store.Context, sqlite.ReadEmpirePermissionParams{
+		EmpireID:  empireID,
+		OrbitID:   orbitID,
+		Kind:      kind,
+		GranteeID: granteeID,
+		AsOfDt:    turnNo,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// mayColonize returns true if an empire may set up a colony in an orbit.
+// Every other empire that owns a colony in the orbit must have granted
+// the empire the right to colonize.
+func (e *Engine_t) mayColonize(q *sqlite.Queries, orbitID, empireID, turnNo int64) (bool, error) {
+	owners, err := q.ReadOrbitColonyOwners(e.Store.Context, sqlite.ReadOrbitColonyOwnersParams{OrbitID: orbitID, AsOfDt: turnNo})
+	if err != nil {
+		return false, err
+	}
+	for _, owner := range owners {
+		if ok, err := e.hasPermission(q, owner, orbitID, "colonize", empireID, turnNo); err != nil || !ok {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// readPermissionReports returns the rights granted by and to an empire,
+// and the results of the grant and revoke orders it gave on the turn.
+func (e *Engine_t) readPermissionReports(empireID, turnNo int64) ([]*PermissionReport_t, []*PermissionOrderReport_t, error) {
+	rows, err := e.Store.Queries.ReadAllPermissionsByEmpire(e.Store.Context, sqlite.ReadAllPermissionsByEmpireParams{
+		EmpireID: empireID,
+		AsOfDt:   turnNo,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	var rights []*PermissionReport_t
+	for _, row := range rows {
+		rights = append(rights, &PermissionReport_t{
+			Kind:        row.Kind,
+			Coordinates: row.StarName,
+			OrbitNo:     row.OrbitNo,
+			GrantedBy:   row.EmpireID,
+			GrantedTo:   row.GranteeID,
+			IsReceived:  row.GranteeID == empireID,
+			Since:       row.Effdt,
+		})
+	}
+	resultRows, err := e.Store.Queries.ReadAllPermissionResultsByEmpire(e.Store.Context, sqlite.ReadAllPermissionResultsByEmpireParams{
+		EmpireID: empireID,
+		Effdt:    turnNo,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	var orders []*PermissionOrderReport_t
+	for _, row := range resultRows {
+		orders = append(orders, &PermissionOrderReport_t{
+			Action:      row.Action,
+			Kind:        row.Kind,
+			Coordinates: row.StarName,
+			OrbitNo:     row.OrbitNo,
+			GranteeID:   row.GranteeID,
+			Status:      row.Status,
+			Reason:      row.Reason,
+		})
+	}
+	return rights, orders, nil
+}
@@ -0,0 +1,305 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package engine
+
+import (
+	"database/sql"
+	"errors"
+	"github.com/playbymail/empyr/repos/sqlite"
+	"log"
+	"math"
+	"math/rand/v2"
+)
+
+// probeResult_t holds the outcome of a probe and the data that it gathered.
+type probeResult_t struct {
+	result sqlite.CreateSCProbeResultParams
+	stars  []sqlite.CreateSCProbeStarResultsParams
+	orbits []sqlite.CreateSCProbeStarOrbitResultsParams
+}
+
+// probeTarget_t is the system, stars, and orbit that a probe is aimed at.
+type probeTarget_t struct {
+	systemID int64
+	location Point_t
+	stars    []int64 // every star that is probed
+	orbitID  int64   // when not zero, only this orbit is probed
+}
+
+// ExecuteProbes executes all the probe orders for the current turn.
+//
+// A probe may target a system, a star, or a single orbit. Targets in the
+// same system are probed with the assembled sensors of the ship or colony,
+// which burn 0.05 x TL fuel per unit. Otherwise, a robot probe vehicle is
+// expended; an RPV can reach a system up to TL light years away.
+//
+// A system or star probe reports the number of orbits around every star.
+// All probes report the kind of each orbit and the log10 estimate of the
+// resources on the planet. The accuracy of the estimate depends on the tech
+// level of the sensor or RPV and on the distance to the target; see
+// probeEstimate. The noise is drawn from a random number generator that is
+// seeded from the game and turn, so executing the turn again gives the same
+// estimates.
+//
+// Successful probes also update the empire's knowledge of the stars, orbits,
+// and foreign ships and colonies in the target.
+func (e *Engine_t) ExecuteProbes(gameCode string, turnNo int64) error {
+	// start a transaction
+	q, tx, err := e.Store.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// get a list of all the probe orders. these are the orders that need to be executed.
+	probeOrderRows, err := q.ReadAllProbeOrdersByTurn(e.Store.Context, turnNo)
+	if err != nil {
+		return err
+	}
+	r := turnRand(gameCode, turnNo)
+
+	for _, probeOrder := range probeOrderRows {
+		probe, err := e.executeProbe(q, r, turnNo, probeOrder)
+		if err != nil {
+			log.Printf("game %q: turn %d: sc %d: probe %d: %v\n", gameCode, turnNo, probeOrder.ScID, probeOrder.ProbeID, err)
+			return err
+		}
+		log.Printf("game %q: turn %d: sc %d: probe %d: %s %q\n", gameCode, turnNo, probeOrder.ScID, probeOrder.ProbeID, probe.result.Status, probe.result.Reason)
+		err = q.CreateSCProbeResult(e.Store.Context, probe.result)
+		if err != nil {
+			return err
+		}
+		for _, star := range probe.stars {
+			err = q.CreateSCProbeStarResults(e.Store.Context, star)
+			if err != nil {
+				return err
+			}
+		}
+		for _, orbit := range probe.orbits {
+			err = q.CreateSCProbeStarOrbitResults(e.Store.Context, orbit)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return tx.Commit()
+}
+
+// executeProbe executes a single probe order and returns the result.
+// Errors that are the player's fault are returned in the result;
+// the error is reserved for problems with the database.
+func (e *Engine_t) executeProbe(q *sqlite.Queries, r *rand.Rand, turnNo int64, order sqlite.ReadAllProbeOrdersByTurnRow) (*probeResult_t, error) {
+	probe := &probeResult_t{
+		result: sqlite.CreateSCProbeResultParams{
+			ProbeID: order.ProbeID,
+			Effdt:   turnNo,
+			Status:  "failed",
+		},
+	}
+
+	loc, err := q.ReadSCLocation(e.Store.Context, sqlite.ReadSCLocationParams{ScID: order.ScID, AsOfDt: turnNo})
+	if errors.Is(err, sql.ErrNoRows) {
+		probe.result.Reason = "ship or colony has no location"
+		return probe, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	target, reason, err := e.readProbeTarget(q, order)
+	if err != nil {
+		return nil, err
+	} else if reason != "" {
+		probe.result.Reason = reason
+		return probe, nil
+	}
+
+	inventory, err := q.ReadSCInventory(e.Store.Context, sqlite.ReadSCInventoryParams{ScID: order.ScID, AsOfDt: turnNo})
+	if err != nil {
+		return nil, err
+	}
+	var fuelOnHand, sensorFuel float64
+	var sensors, sensorTechLevel int64
+	var rpvs []sqlite.ReadSCInventoryRow
+	for _, row := range inventory {
+		if row.UnitCd == "SEN" && row.IsAssembled == 1 {
+			sensors += row.Qty
+			sensorTechLevel = max(sensorTechLevel, row.UnitTechLevel)
+			sensorFuel += unitFuel(row.UnitCd, row.UnitTechLevel, row.Qty)
+		} else if row.UnitCd == "RPV" && row.Qty > 0 {
+			rpvs = append(rpvs, row)
+		} else if row.UnitCd == "FUEL" {
+			fuelOnHand += float64(row.Qty)
+		}
+	}
+
+	// sensors are preferred because they aren't expended, but they can only
+	// reach targets in the same system. inventory is sorted by tech level,
+	// so the first RPV in range is the cheapest one that can be used.
+	distance := Point_t{X: loc.X, Y: loc.Y, Z: loc.Z}.DistanceTo(target.location)
+	if target.systemID == loc.SystemID && sensors > 0 && fuelOnHand >= math.Ceil(sensorFuel) {
+		probe.result.UnitCd, probe.result.UnitTechLevel = "SEN", sensorTechLevel
+		probe.result.UnitsUsed, probe.result.FuelUsed = sensors, int64(math.Ceil(sensorFuel))
+		err = e.adjustInventory(q, order.ScID, "FUEL", 0, turnNo, -probe.result.FuelUsed)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		for _, rpv := range rpvs {
+			if distance <= float64(rpv.UnitTechLevel) {
+				probe.result.UnitCd, probe.result.UnitTechLevel, probe.result.UnitsUsed = "RPV", rpv.UnitTechLevel, 1
+				break
+			}
+		}
+		if probe.result.UnitCd == "" {
+			if sensors == 0 && len(rpvs) == 0 {
+				probe.result.Reason = "no assembled sensors or probe vehicles"
+			} else if len(rpvs) != 0 {
+				probe.result.Reason = "target is out of range of probe vehicles"
+			} else if target.systemID != loc.SystemID {
+				probe.result.Reason = "sensors can only probe targets in the same system"
+			} else {
+				probe.result.Reason = "insufficient fuel"
+			}
+			return probe, nil
+		}
+		err = e.adjustInventory(q, order.ScID, "RPV", probe.result.UnitTechLevel, turnNo, -1)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// gather the data for every star and orbit in the target
+	for _, starID := range target.stars {
+		star, err := q.ReadStarSystem(e.Store.Context, starID)
+		if err != nil {
+			return nil, err
+		}
+		if target.orbitID == 0 {
+			probe.stars = append(probe.stars, sqlite.CreateSCProbeStarResultsParams{
+				ProbeID:     order.ProbeID,
+				Effdt:       turnNo,
+				StarID:      starID,
+				Location:    star.StarName,
+				NbrOfOrbits: star.NbrOfOrbits,
+			})
+			err = e.recordStarKnowledge(q, sqlite.CreateEmpireStarKnowledgeParams{
+				EmpireID:    order.EmpireID,
+				StarID:      starID,
+				Effdt:       turnNo,
+				SystemID:    star.SystemID,
+				NbrOfOrbits: star.NbrOfOrbits,
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+		orbitRows, err := q.ReadAllOrbitEstimatesByStar(e.Store.Context, sqlite.ReadAllOrbitEstimatesByStarParams{StarID: starID, AsOfDt: turnNo})
+		if err != nil {
+			return nil, err
+		}
+		for _, orbit := range orbitRows {
+			if target.orbitID != 0 && orbit.OrbitID != target.orbitID {
+				continue
+			}
+			result := sqlite.CreateSCProbeStarOrbitResultsParams{
+				ProbeID:   order.ProbeID,
+				Effdt:     turnNo,
+				StarID:    starID,
+				OrbitNo:   orbit.OrbitNo,
+				OrbitKind: orbit.OrbitKind,
+				FuelEst:   probeEstimate(r, orbit.FuelEstQty, probe.result.UnitTechLevel, distance),
+				GoldEst:   probeEstimate(r, orbit.GoldEstQty, probe.result.UnitTechLevel, distance),
+				MetsEst:   probeEstimate(r, orbit.MetsEstQty, probe.result.UnitTechLevel, distance),
+				NmtsEst:   probeEstimate(r, orbit.NmtsEstQty, probe.result.UnitTechLevel, distance),
+			}
+			probe.orbits = append(probe.orbits, result)
+
+			// the empire now knows what the probe reported, not the actual values
+			err = e.recordOrbitKnowledge(q, sqlite.CreateEmpireOrbitKnowledgeParams{
+				EmpireID:  order.EmpireID,
+				OrbitID:   orbit.OrbitID,
+				Effdt:     turnNo,
+				OrbitKind: result.OrbitKind,
+				FuelEst:   result.FuelEst,
+				GoldEst:   result.GoldEst,
+				MetsEst:   result.MetsEst,
+				NmtsEst:   result.NmtsEst,
+			})
+			if err != nil {
+				return nil, err
+			}
+			err = e.recordOrbitSCKnowledge(q, order.EmpireID, orbit.OrbitID, turnNo)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	probe.result.Status, probe.result.Reason = "probed", ""
+
+	return probe, nil
+}
+
+// readProbeTarget resolves the target of a probe order to a system and the
+// stars in it. If the target is not valid, the reason is returned.
+func (e *Engine_t) readProbeTarget(q *sqlite.Queries, order sqlite.ReadAllProbeOrdersByTurnRow) (*probeTarget_t, string, error) {
+	target := &probeTarget_t{}
+	switch order.Kind {
+	case "system":
+		starRows, err := q.ReadAllStarsInSystem(e.Store.Context, order.TargetID)
+		if err != nil {
+			return nil, "", err
+		} else if len(starRows) == 0 {
+			return nil, "no such system", nil
+		}
+		target.systemID = order.TargetID
+		target.location = Point_t{X: starRows[0].X, Y: starRows[0].Y, Z: starRows[0].Z}
+		for _, row := range starRows {
+			target.stars = append(target.stars, row.ID)
+		}
+		return target, "", nil
+	case "star":
+		target.stars = []int64{order.TargetID}
+	case "orbit":
+		orbit, err := q.ReadOrbitStar(e.Store.Context, order.TargetID)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, "no such orbit", nil
+		} else if err != nil {
+			return nil, "", err
+		}
+		target.stars, target.orbitID = []int64{orbit.StarID}, order.TargetID
+	default:
+		return nil, "probe target must be a system, star, or orbit", nil
+	}
+	star, err := q.ReadStarSystem(e.Store.Context, target.stars[0])
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, "no such star", nil
+	} else if err != nil {
+		return nil, "", err
+	}
+	target.systemID = star.SystemID
+	target.location = Point_t{X: star.X, Y: star.Y, Z: star.Z}
+	return target, "", nil
+}
+
+// probeEstimate returns the estimate of a resource that is reported by a
+// probe. The actual estimate is the log10 of the quantity on the planet.
+//
+// Noise is added to the actual estimate. The standard deviation of the noise
+// is (10 - TL) / 6, so TL-10 probes are exact, and it grows by 10% for every
+// light year to the target. The result is then rounded down to a band: TL-1
+// to TL-3 probes report multiples of 3, TL-4 to TL-6 probes multiples of 2.
+func probeEstimate(r *rand.Rand, actual, techLevel int64, distance float64) int64 {
+	stdDev := float64(10-min(techLevel, 10)) / 6 * (1 + distance/10)
+	estimate := int64(math.Round(bellCurve(r, float64(actual), stdDev)))
+	if estimate < 0 {
+		estimate = 0
+	}
+	switch {
+	case techLevel <= 3:
+		return estimate / 3 * 3
+	case techLevel <= 6:
+		return estimate / 2 * 2
+	}
+	return estimate
+}
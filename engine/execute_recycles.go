@@ -0,0 +1,297 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package engine
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/playbymail/empyr/repos/sqlite"
+	"log"
+)
+
+// recycleWIP_t is the work in progress of the factories in a group with a
+// single tech level.
+type recycleWIP_t struct {
+	techLevel    int64
+	productionDt int64
+	wip          [3]int64
+}
+
+// ExecuteRecycles executes all the recycle and scrap orders for the current
+// turn.
+//
+// Units are taken out of storage, out of a factory or mine group, or out of
+// a factory group's work in progress, and broken down. Recycled units return
+// metals and non-metals to storage. Orders are executed in the order they
+// were given. See recycle.go for the rules.
+func (e *Engine_t) ExecuteRecycles(gameCode string, turnNo int64) error {
+	// start a transaction
+	q, tx, err := e.Store.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// get a list of all the recycle orders. these are the orders that need to be executed.
+	recycleOrderRows, err := q.ReadAllRecycleOrdersByTurn(e.Store.Context, turnNo)
+	if err != nil {
+		return err
+	}
+
+	for _, order := range recycleOrderRows {
+		owner, err := q.ReadSCOwner(e.Store.Context, sqlite.ReadSCOwnerParams{ScID: order.ScID, AsOfDt: turnNo})
+		if err != nil {
+			return err
+		}
+		result := sqlite.CreateSCRecycleResultParams{
+			RecycleID: order.RecycleID,
+			Effdt:     turnNo,
+			EmpireID:  owner.EmpireID,
+			Status:    "succeeded",
+		}
+		var reason string
+		result.MetsReturned, result.NmtsReturned, reason, err = e.executeRecycleOrder(q, order, turnNo)
+		if err != nil {
+			return err
+		} else if reason != "" {
+			result.Status, result.Reason = "failed", reason
+		} else {
+			result.Qty = order.Qty
+		}
+		log.Printf("game %q: turn %d: sc %d: recycle %d: %s %d %s: mets %d nmts %d: %s %q\n", gameCode, turnNo, order.ScID, order.RecycleID, order.Kind, order.Qty, codeTL(order.UnitCd, order.TechLevel), result.MetsReturned, result.NmtsReturned, result.Status, result.Reason)
+		err = q.CreateSCRecycleResult(e.Store.Context, result)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// executeRecycleOrder validates a recycle or scrap order and, if it is
+// valid, breaks the units down. It returns the metals and non-metals added
+// to storage, or the reason that the order failed.
+func (e *Engine_t) executeRecycleOrder(q *sqlite.Queries, order sqlite.ReadAllRecycleOrdersByTurnRow, turnNo int64) (int64, int64, string, error) {
+	code := codeTL(order.UnitCd, order.TechLevel)
+	if unit, ok := unitTable[order.UnitCd]; !ok {
+		return 0, 0, fmt.Sprintf("no such unit %s", order.UnitCd), nil
+	} else if unit.IsResource {
+		return 0, 0, fmt.Sprintf("can't %s %s", order.Kind, code), nil
+	}
+
+	// find the units and the materials they return
+	var groupID int64
+	var isGroupUnit bool
+	var pipeline []*recycleWIP_t
+	var itemCd string
+	var itemTechLevel, itemQty int64
+	var mets, nmts int64
+	var volume float64
+	switch order.GroupKind {
+	case "":
+		stored, err := e.readStoredQty(q, order.ScID, order.UnitCd, order.TechLevel, turnNo)
+		if err != nil {
+			return 0, 0, "", err
+		} else if stored < order.Qty {
+			return 0, 0, fmt.Sprintf("not enough %s in storage", code), nil
+		}
+		mets, nmts = recycleMaterials(order.UnitCd, order.TechLevel, order.Qty, 100)
+		volume = inventoryVolume(order.UnitCd, order.TechLevel, order.Qty, false, true)
+	case "factory", "mine":
+		var ok bool
+		var err error
+		groupID, ok, err = e.findGroup(q, order.ScID, order.GroupKind, order.GroupNo, turnNo)
+		if err != nil {
+			return 0, 0, "", err
+		} else if !ok {
+			return 0, 0, fmt.Sprintf("no %s group %d", order.GroupKind, order.GroupNo), nil
+		}
+		if order.UnitCd == recycleGroupUnit(order.GroupKind) {
+			isGroupUnit = true
+			row, err := q.ReadSCGroupUnit(e.Store.Context, sqlite.ReadSCGroupUnitParams{GroupID: groupID, TechLevel: order.TechLevel, AsOfDt: turnNo})
+			if errors.Is(err, sql.ErrNoRows) {
+				row.NbrOfUnits = 0
+			} else if err != nil {
+				return 0, 0, "", err
+			}
+			if row.NbrOfUnits < order.Qty {
+				return 0, 0, fmt.Sprintf("not enough %s in %s group %d", code, order.GroupKind, order.GroupNo), nil
+			}
+			mets, nmts = recycleMaterials(order.UnitCd, order.TechLevel, order.Qty, 100)
+			volume = inventoryVolume(order.UnitCd, order.TechLevel, order.Qty, true, false)
+			if order.GroupKind == "factory" && row.NbrOfUnits == order.Qty {
+				// the last factories of a tech level take their work in progress with them
+				wip, err := e.readRecycleWIP(q, groupID, order.TechLevel, turnNo)
+				if err != nil {
+					return 0, 0, "", err
+				} else if wip != nil {
+					tooling, err := q.ReadSCGroupToolingByGroup(e.Store.Context, sqlite.ReadSCGroupToolingByGroupParams{GroupID: groupID, AsOfDt: turnNo})
+					if err != nil {
+						return 0, 0, "", err
+					}
+					pipeline = append(pipeline, wip)
+					itemCd, itemTechLevel, itemQty = tooling.ItemCd, tooling.ItemTechLevel, wip.wip[0]+wip.wip[1]+wip.wip[2]
+				}
+			}
+		} else if order.GroupKind == "factory" {
+			tooling, err := q.ReadSCGroupToolingByGroup(e.Store.Context, sqlite.ReadSCGroupToolingByGroupParams{GroupID: groupID, AsOfDt: turnNo})
+			if errors.Is(err, sql.ErrNoRows) {
+				return 0, 0, fmt.Sprintf("factory group %d has no tooling", order.GroupNo), nil
+			} else if err != nil {
+				return 0, 0, "", err
+			} else if tooling.ItemCd != order.UnitCd || tooling.ItemTechLevel != order.TechLevel {
+				return 0, 0, fmt.Sprintf("factory group %d isn't manufacturing %s", order.GroupNo, code), nil
+			}
+			rows, err := q.ReadSCGroupUnitsByGroup(e.Store.Context, sqlite.ReadSCGroupUnitsByGroupParams{GroupID: groupID, AsOfDt: turnNo})
+			if err != nil {
+				return 0, 0, "", err
+			}
+			var total int64
+			for _, row := range rows {
+				wip, err := e.readRecycleWIP(q, groupID, row.TechLevel, turnNo)
+				if err != nil {
+					return 0, 0, "", err
+				} else if wip != nil {
+					pipeline = append(pipeline, wip)
+					total += wip.wip[0] + wip.wip[1] + wip.wip[2]
+				}
+			}
+			if total < order.Qty {
+				return 0, 0, fmt.Sprintf("not enough %s in progress in factory group %d", code, order.GroupNo), nil
+			}
+			itemCd, itemTechLevel, itemQty = order.UnitCd, order.TechLevel, order.Qty
+		} else {
+			return 0, 0, fmt.Sprintf("%s isn't in mine groups", code), nil
+		}
+	default:
+		return 0, 0, fmt.Sprintf("can't %s from %s groups", order.Kind, order.GroupKind), nil
+	}
+
+	// take the items out of the pipelines, most complete stage first
+	for _, wip := range pipeline {
+		taken := recyclePipeline(&wip.wip, itemQty)
+		for i, qty := range taken {
+			m, n := recycleMaterials(itemCd, itemTechLevel, qty, 25*int64(i+1))
+			mets, nmts, itemQty = mets+m, nmts+n, itemQty-qty
+		}
+	}
+	if order.Kind == "scrap" {
+		mets, nmts = 0, 0
+	}
+
+	// the returned materials must fit in the space freed by the units
+	capacity, err := e.readCapacity(q, order.ScID, turnNo)
+	if err != nil {
+		return 0, 0, "", err
+	}
+	capacity.addUnits(order.UnitCd, order.TechLevel, -order.Qty, -volume, isGroupUnit)
+	capacity.addUnits("METS", 0, mets, inventoryVolume("METS", 0, mets, false, true), false)
+	capacity.addUnits("NMTS", 0, nmts, inventoryVolume("NMTS", 0, nmts, false, true), false)
+	if !capacity.hasRoomFor(0) {
+		return 0, 0, "not enough space", nil
+	}
+
+	// remove the units and work in progress and store the materials
+	for _, wip := range pipeline {
+		if err = e.updateRecycleWIP(q, groupID, wip, turnNo); err != nil {
+			return 0, 0, "", err
+		}
+	}
+	switch {
+	case order.GroupKind == "":
+		err = e.adjustInventory(q, order.ScID, order.UnitCd, order.TechLevel, turnNo, -order.Qty)
+	case isGroupUnit:
+		if err = e.adjustGroupUnits(q, groupID, order.TechLevel, turnNo, -order.Qty); err == nil {
+			err = e.adjustInventoryEntry(q, order.ScID, order.UnitCd, order.TechLevel, true, turnNo, -order.Qty)
+		}
+	}
+	if err != nil {
+		return 0, 0, "", err
+	} else if err = e.adjustInventory(q, order.ScID, "METS", 0, turnNo, mets); err != nil {
+		return 0, 0, "", err
+	} else if err = e.adjustInventory(q, order.ScID, "NMTS", 0, turnNo, nmts); err != nil {
+		return 0, 0, "", err
+	}
+	return mets, nmts, "", nil
+}
+
+// updateRecycleWIP records the work in progress left after recycling as
+// of the turn. Entries created this turn are updated in place. Otherwise a
+// new entry is created for the turn, with an empty production entry for it
+// to refer to, so that the earlier entries aren't changed.
+func (e *Engine_t) updateRecycleWIP(q *sqlite.Queries, groupID int64, wip *recycleWIP_t, turnNo int64) error {
+	if wip.productionDt == turnNo {
+		return q.UpdateSCGroupUnitWIP(e.Store.Context, sqlite.UpdateSCGroupUnitWIPParams{
+			Wip25pctQty:  wip.wip[0],
+			Wip50pctQty:  wip.wip[1],
+			Wip75pctQty:  wip.wip[2],
+			GroupID:      groupID,
+			TechLevel:    wip.techLevel,
+			ProductionDt: wip.productionDt,
+		})
+	}
+	err := q.CreateSCGroupUnitProduction(e.Store.Context, sqlite.CreateSCGroupUnitProductionParams{
+		GroupID:      groupID,
+		TechLevel:    wip.techLevel,
+		ProductionDt: turnNo,
+	})
+	if err != nil {
+		return err
+	}
+	return q.CreateSCGroupUnitProductionWIP(e.Store.Context, sqlite.CreateSCGroupUnitProductionWIPParams{
+		GroupID:      groupID,
+		TechLevel:    wip.techLevel,
+		ProductionDt: turnNo,
+		Wip25pctQty:  wip.wip[0],
+		Wip50pctQty:  wip.wip[1],
+		Wip75pctQty:  wip.wip[2],
+	})
+}
+
+// readRecycleWIP returns the latest work in progress for the factories in
+// a group with a single tech level, or nil if there is none.
+func (e *Engine_t) readRecycleWIP(q *sqlite.Queries, groupID, techLevel, turnNo int64) (*recycleWIP_t, error) {
+	row, err := q.ReadSCGroupUnitWIP(e.Store.Context, sqlite.ReadSCGroupUnitWIPParams{GroupID: groupID, TechLevel: techLevel, AsOfDt: turnNo})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return &recycleWIP_t{
+		techLevel:    techLevel,
+		productionDt: row.ProductionDt,
+		wip:          [3]int64{row.Wip25pctQty, row.Wip50pctQty, row.Wip75pctQty},
+	}, nil
+}
+
+// readRecycleReports returns the results of the recycle and scrap orders
+// given by an empire's ships and colonies.
+func (e *Engine_t) readRecycleReports(empireID, turnNo int64) ([]*RecycleReport_t, error) {
+	rows, err := e.Store.Queries.ReadAllRecycleResultsByEmpire(e.Store.Context, sqlite.ReadAllRecycleResultsByEmpireParams{
+		EmpireID: empireID,
+		Effdt:    turnNo,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var recycles []*RecycleReport_t
+	for _, row := range rows {
+		report := &RecycleReport_t{
+			ScID:         row.ScID,
+			Kind:         row.Kind,
+			Unit:         codeTL(row.UnitCd, row.TechLevel),
+			OrderedQty:   commas(row.OrderedQty),
+			Qty:          commas(row.Qty),
+			MetsReturned: commas(row.MetsReturned),
+			NmtsReturned: commas(row.NmtsReturned),
+			Status:       row.Status,
+			Reason:       row.Reason,
+		}
+		if row.GroupKind != "" {
+			report.Group = fmt.Sprintf("%s %02d", row.GroupKind, row.GroupNo)
+		}
+		recycles = append(recycles, report)
+	}
+	return recycles, nil
+}
@@ -0,0 +1,214 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package engine
+
+import (
+	"fmt"
+	"github.com/playbymail/empyr/repos/sqlite"
+	"log"
+)
+
+// labInputs_t is the labor assigned to a ship or colony's groups and the
+// fuel and power that it has left for its lab groups.
+type labInputs_t struct {
+	staffing    *staffing_t
+	fuel, power int64
+	working     int64 // labs working in the groups run so far
+}
+
+// ExecuteResearch runs the lab groups and executes all the research orders
+// for the current turn.
+//
+// Lab groups produce research first, so research produced on a turn can be
+// spent on the same turn. A research order spends the research stored in a
+// ship or colony to raise its tech level by one. See research.go for the
+// rules.
+func (e *Engine_t) ExecuteResearch(gameCode string, turnNo int64) error {
+	// start a transaction
+	q, tx, err := e.Store.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// run the lab groups
+	labGroupRows, err := q.ReadAllLabGroupsByTurn(e.Store.Context, turnNo)
+	if err != nil {
+		return err
+	}
+	inputs := map[int64]*labInputs_t{}
+	for _, group := range labGroupRows {
+		input, ok := inputs[group.ScID]
+		if !ok {
+			input = &labInputs_t{}
+			if input.staffing, err = e.readStaffing(q, group.ScID, turnNo); err != nil {
+				return err
+			} else if input.fuel, err = e.readStoredQty(q, group.ScID, "FUEL", 0, turnNo); err != nil {
+				return err
+			} else if _, input.power, err = e.readPower(q, group.ScID, turnNo); err != nil {
+				return err
+			}
+			inputs[group.ScID] = input
+		}
+		summary, err := e.executeLabGroup(q, group.ScID, group.GroupID, input, turnNo)
+		if err != nil {
+			return err
+		}
+		log.Printf("game %q: turn %d: sc %d: lab group %d: pro %d usk %d aut %d fuel %d power %d: rsch %d\n", gameCode, turnNo, group.ScID, group.GroupNo, summary.ProConsumed, summary.UskConsumed, summary.AutConsumed, summary.FuelConsumed, summary.PowerConsumed, summary.QtyProduced)
+	}
+
+	// get a list of all the research orders. these are the orders that need to be executed.
+	researchOrderRows, err := q.ReadAllResearchOrdersByTurn(e.Store.Context, turnNo)
+	if err != nil {
+		return err
+	}
+
+	for _, order := range researchOrderRows {
+		owner, err := q.ReadSCOwner(e.Store.Context, sqlite.ReadSCOwnerParams{ScID: order.ScID, AsOfDt: turnNo})
+		if err != nil {
+			return err
+		}
+		sc, err := q.ReadSC(e.Store.Context, order.ScID)
+		if err != nil {
+			return err
+		}
+		result := sqlite.CreateSCResearchResultParams{
+			ResearchID:    order.ResearchID,
+			Effdt:         turnNo,
+			EmpireID:      owner.EmpireID,
+			FromTechLevel: sc.ScTechLevel,
+			ToTechLevel:   sc.ScTechLevel,
+			Status:        "succeeded",
+		}
+		var reason string
+		result.RschUsed, reason, err = e.executeResearchOrder(q, order.ScID, sc.ScTechLevel, turnNo)
+		if err != nil {
+			return err
+		} else if reason != "" {
+			result.Status, result.Reason = "failed", reason
+		} else {
+			result.ToTechLevel = sc.ScTechLevel + 1
+		}
+		log.Printf("game %q: turn %d: sc %d: research %d: tl %d: %s %q\n", gameCode, turnNo, order.ScID, order.ResearchID, result.ToTechLevel, result.Status, result.Reason)
+		err = q.CreateSCResearchResult(e.Store.Context, result)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// executeLabGroup fuels as many of the group's staffed labs as it can,
+// adds the research produced to storage, and records the production summary
+// for the group. The fuel and power used are taken out of the inputs.
+func (e *Engine_t) executeLabGroup(q *sqlite.Queries, scID, groupID int64, input *labInputs_t, turnNo int64) (sqlite.CreateSCGroupProductionSummaryParams, error) {
+	summary := sqlite.CreateSCGroupProductionSummaryParams{
+		GroupID:      groupID,
+		ProductionDt: turnNo,
+	}
+	rows, err := q.ReadSCGroupUnitsByGroup(e.Store.Context, sqlite.ReadSCGroupUnitsByGroupParams{GroupID: groupID, AsOfDt: turnNo})
+	if err != nil {
+		return summary, err
+	}
+	group := input.staffing.group(groupID)
+	var working int64
+	for _, row := range rows {
+		var unit *unitStaffing_t
+		if group != nil {
+			unit = group.unit(row.TechLevel)
+		}
+		if unit == nil {
+			continue
+		}
+		qty := labsWorking(row.TechLevel, unit.staffed, input.fuel+input.power)
+		power, fuel := powerAndFuel(float64(labFuel(row.TechLevel, qty)), float64(input.power))
+		input.fuel, input.power = input.fuel-int64(fuel), input.power-int64(power)
+		proPerLab, uskPerLab := laborPerUnit("lab", unit.nbrOfUnits)
+		usk, aut := staffUnskilled(qty*uskPerLab, unit.used.usk, unit.used.aut)
+		summary.ProConsumed += qty * proPerLab
+		summary.UskConsumed += usk
+		summary.AutConsumed += aut
+		summary.FuelConsumed += int64(fuel)
+		summary.PowerConsumed += int64(power)
+		working += qty
+	}
+	// research is counted for the ship or colony as a whole, so each group
+	// produces its share of the whole units.
+	summary.QtyProduced = labResearch(input.working+working, turnNo) - labResearch(input.working, turnNo)
+	input.working += working
+
+	if err = e.adjustInventory(q, scID, "FUEL", 0, turnNo, -summary.FuelConsumed); err != nil {
+		return summary, err
+	} else if err = e.adjustInventory(q, scID, "RSCH", 0, turnNo, summary.QtyProduced); err != nil {
+		return summary, err
+	}
+	return summary, q.CreateSCGroupProductionSummary(e.Store.Context, summary)
+}
+
+// executeResearchOrder spends the research stored in a ship or colony to
+// raise its tech level by one. It returns the research used, or the reason
+// that the order failed.
+func (e *Engine_t) executeResearchOrder(q *sqlite.Queries, scID, techLevel, turnNo int64) (int64, string, error) {
+	if techLevel >= researchMaxTechLevel {
+		return 0, fmt.Sprintf("already at tech level %d", techLevel), nil
+	}
+	cost := researchCost(techLevel)
+	rsch, err := e.readStoredQty(q, scID, "RSCH", 0, turnNo)
+	if err != nil {
+		return 0, "", err
+	} else if rsch < cost {
+		return 0, fmt.Sprintf("not enough research (%s needed)", commas(cost)), nil
+	}
+	if err = e.adjustInventory(q, scID, "RSCH", 0, turnNo, -cost); err != nil {
+		return 0, "", err
+	}
+	err = q.UpdateSCTechLevel(e.Store.Context, sqlite.UpdateSCTechLevelParams{ScTechLevel: techLevel + 1, ScID: scID})
+	if err != nil {
+		return 0, "", err
+	}
+	return cost, "", nil
+}
+
+// readResearchReports returns the research produced by the lab groups of
+// an empire's ships and colonies and the results of their research orders.
+func (e *Engine_t) readResearchReports(empireID, turnNo int64) ([]*LabReport_t, []*ResearchReport_t, error) {
+	labRows, err := e.Store.Queries.ReadAllLabProductionByEmpire(e.Store.Context, sqlite.ReadAllLabProductionByEmpireParams{
+		EmpireID: empireID,
+		AsOfDt:   turnNo,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	var labs []*LabReport_t
+	for _, row := range labRows {
+		labs = append(labs, &LabReport_t{
+			ScID:         row.ScID,
+			GroupNo:      fmt.Sprintf("%02d", row.GroupNo),
+			ProUsed:      commas(row.ProConsumed),
+			UskUsed:      commas(row.UskConsumed),
+			FuelUsed:     commas(row.FuelConsumed),
+			PowerUsed:    commas(row.PowerConsumed),
+			RschProduced: commas(row.QtyProduced),
+		})
+	}
+	orderRows, err := e.Store.Queries.ReadAllResearchResultsByEmpire(e.Store.Context, sqlite.ReadAllResearchResultsByEmpireParams{
+		EmpireID: empireID,
+		Effdt:    turnNo,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	var orders []*ResearchReport_t
+	for _, row := range orderRows {
+		orders = append(orders, &ResearchReport_t{
+			ScID:          row.ScID,
+			FromTechLevel: row.FromTechLevel,
+			ToTechLevel:   row.ToTechLevel,
+			RschUsed:      commas(row.RschUsed),
+			Status:        row.Status,
+			Reason:        row.Reason,
+		})
+	}
+	return labs, orders, nil
+}
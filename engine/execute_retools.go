@@ -0,0 +1,151 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package engine
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/playbymail/empyr/repos/sqlite"
+	"log"
+)
+
+// ExecuteRetools executes all the retool orders for the current turn.
+//
+// A retool order changes the item that a factory group manufactures. The
+// item may not have a higher tech level than the ship or colony, so research
+// should be executed before retools. Retooled groups stop producing for
+// three turns; see the sc_group_tooling table for the details.
+func (e *Engine_t) ExecuteRetools(gameCode string, turnNo int64) error {
+	// start a transaction
+	q, tx, err := e.Store.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// get a list of all the retool orders. these are the orders that need to be executed.
+	retoolOrderRows, err := q.ReadAllRetoolOrdersByTurn(e.Store.Context, turnNo)
+	if err != nil {
+		return err
+	}
+
+	for _, order := range retoolOrderRows {
+		owner, err := q.ReadSCOwner(e.Store.Context, sqlite.ReadSCOwnerParams{ScID: order.ScID, AsOfDt: turnNo})
+		if err != nil {
+			return err
+		}
+		result := sqlite.CreateSCRetoolResultParams{
+			RetoolID: order.RetoolID,
+			Effdt:    turnNo,
+			EmpireID: owner.EmpireID,
+			Status:   "succeeded",
+		}
+		reason, err := e.executeRetoolOrder(q, order, turnNo)
+		if err != nil {
+			return err
+		} else if reason != "" {
+			result.Status, result.Reason = "failed", reason
+		}
+		log.Printf("game %q: turn %d: sc %d: retool %d: group %d %s: %s %q\n", gameCode, turnNo, order.ScID, order.RetoolID, order.GroupNo, codeTL(order.ItemCd, order.ItemTechLevel), result.Status, result.Reason)
+		err = q.CreateSCRetoolResult(e.Store.Context, result)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// executeRetoolOrder validates a retool order and, if it is valid, changes
+// the tooling of the factory group. It returns the reason that the order
+// failed, or an empty string if it succeeded.
+func (e *Engine_t) executeRetoolOrder(q *sqlite.Queries, order sqlite.ReadAllRetoolOrdersByTurnRow, turnNo int64) (string, error) {
+	sc, err := q.ReadSC(e.Store.Context, order.ScID)
+	if err != nil {
+		return "", err
+	} else if reason := manufactureReason(order.ItemCd, order.ItemTechLevel, sc.ScCd, sc.ScTechLevel); reason != "" {
+		return reason, nil
+	}
+	groupID, ok, err := e.findGroup(q, order.ScID, "factory", order.GroupNo, turnNo)
+	if err != nil {
+		return "", err
+	} else if !ok {
+		return fmt.Sprintf("no factory group %d", order.GroupNo), nil
+	}
+	tooling, err := q.ReadSCGroupToolingByGroup(e.Store.Context, sqlite.ReadSCGroupToolingByGroupParams{GroupID: groupID, AsOfDt: turnNo})
+	if errors.Is(err, sql.ErrNoRows) {
+		return fmt.Sprintf("factory group %d has no tooling", order.GroupNo), nil
+	} else if err != nil {
+		return "", err
+	} else if tooling.ItemCd == order.ItemCd && tooling.ItemTechLevel == order.ItemTechLevel {
+		return fmt.Sprintf("already tooled for %s", codeTL(order.ItemCd, order.ItemTechLevel)), nil
+	}
+
+	// tooling created this turn is updated in place
+	if tooling.Effdt == turnNo {
+		return "", q.UpdateSCGroupToolingItem(e.Store.Context, sqlite.UpdateSCGroupToolingItemParams{
+			ItemCd:        order.ItemCd,
+			ItemTechLevel: order.ItemTechLevel,
+			GroupID:       groupID,
+			Effdt:         tooling.Effdt,
+		})
+	}
+
+	// otherwise, end-date the current tooling and create a new one
+	err = q.UpdateSCGroupToolingEndDt(e.Store.Context, sqlite.UpdateSCGroupToolingEndDtParams{
+		Enddt:   turnNo,
+		GroupID: groupID,
+		Effdt:   tooling.Effdt,
+	})
+	if err != nil {
+		return "", err
+	}
+	return "", q.CreateSCGroupTooling(e.Store.Context, sqlite.CreateSCGroupToolingParams{
+		GroupID:       groupID,
+		Effdt:         turnNo,
+		Enddt:         tooling.Enddt,
+		ItemCd:        order.ItemCd,
+		ItemTechLevel: order.ItemTechLevel,
+		Retooled:      1,
+	})
+}
+
+// manufactureReason returns the reason that a factory group of a ship or
+// colony with the given kind and tech level can't manufacture an item, or
+// an empty string if it can.
+func manufactureReason(itemCd string, itemTechLevel int64, scCd string, scTechLevel int64) string {
+	if unit, ok := unitTable[itemCd]; !ok {
+		return "no item to manufacture"
+	} else if unit.IsResource {
+		return fmt.Sprintf("can't manufacture %s", itemCd)
+	} else if itemCd == "SLS" && scCd != "CORB" {
+		return "SLS may only be built in orbital colonies"
+	} else if itemTechLevel > scTechLevel {
+		return fmt.Sprintf("can't manufacture %s above tech level %d", codeTL(itemCd, itemTechLevel), scTechLevel)
+	}
+	return ""
+}
+
+// readRetoolReports returns the results of the retool orders given by an
+// empire's ships and colonies.
+func (e *Engine_t) readRetoolReports(empireID, turnNo int64) ([]*RetoolReport_t, error) {
+	rows, err := e.Store.Queries.ReadAllRetoolResultsByEmpire(e.Store.Context, sqlite.ReadAllRetoolResultsByEmpireParams{
+		EmpireID: empireID,
+		Effdt:    turnNo,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var retools []*RetoolReport_t
+	for _, row := range rows {
+		retools = append(retools, &RetoolReport_t{
+			ScID:    row.ScID,
+			GroupNo: fmt.Sprintf("%02d", row.GroupNo),
+			Item:    codeTL(row.ItemCd, row.ItemTechLevel),
+			Status:  row.Status,
+			Reason:  row.Reason,
+		})
+	}
+	return retools, nil
+}
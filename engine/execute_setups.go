@@ -0,0 +1,327 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package engine
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/playbymail/empyr/internal/domains"
+	"github.com/playbymail/empyr/repos/sqlite"
+	"log"
+)
+
+// setupItem_t is a unit or population group that a setup order transfers
+// from the parent to the new ship or colony.
+type setupItem_t struct {
+	code         string
+	techLevel    int64
+	qty          int64
+	isPopulation bool
+}
+
+// ExecuteSetups executes all the setup orders for the current turn.
+//
+// A setup order founds a new ship or colony at the location of the parent
+// ship or colony. The units and population listed in the order are moved
+// from the parent to the new ship or colony. Structure, light structure,
+// and life supports are assembled; everything else is put in storage.
+//
+// The new ship or colony must receive enough structure to enclose the
+// units it receives and, unless it is an open surface colony, enough life
+// support for the population it receives. See capacity.go for the rules.
+func (e *Engine_t) ExecuteSetups(gameCode string, turnNo int64) error {
+	// start a transaction
+	q, tx, err := e.Store.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// get a list of all the setup orders. these are the orders that need to be executed.
+	setupOrderRows, err := q.ReadAllSetupOrdersByTurn(e.Store.Context, turnNo)
+	if err != nil {
+		return err
+	}
+
+	for _, order := range setupOrderRows {
+		owner, err := q.ReadSCOwner(e.Store.Context, sqlite.ReadSCOwnerParams{ScID: order.ScID, AsOfDt: turnNo})
+		if err != nil {
+			return err
+		}
+		result := sqlite.CreateSCSetupResultParams{
+			SetupID:  order.SetupID,
+			Effdt:    turnNo,
+			EmpireID: owner.EmpireID,
+			Status:   "succeeded",
+		}
+		var reason string
+		result.ScCd, result.NewScID, reason, err = e.executeSetupOrder(q, order, owner.EmpireID, turnNo)
+		if err != nil {
+			return err
+		} else if reason != "" {
+			result.Status, result.Reason = "failed", reason
+		}
+		log.Printf("game %q: turn %d: sc %d: setup %d: %s %d: %s %q\n", gameCode, turnNo, order.ScID, order.SetupID, result.ScCd, result.NewScID, result.Status, result.Reason)
+		err = q.CreateSCSetupResult(e.Store.Context, result)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// executeSetupOrder validates a setup order and, if it is valid, founds the
+// new ship or colony. It returns the code and id of the new ship or colony,
+// or the reason that the order failed.
+func (e *Engine_t) executeSetupOrder(q *sqlite.Queries, order sqlite.ReadAllSetupOrdersByTurnRow, empireID, turnNo int64) (string, int64, string, error) {
+	location, err := q.ReadSCLocation(e.Store.Context, sqlite.ReadSCLocationParams{ScID: order.ScID, AsOfDt: turnNo})
+	if err != nil {
+		return "", 0, "", err
+	} else if location.OrbitID != order.OrbitID {
+		return "", 0, "parent is not at the location", nil
+	}
+	orbit, err := q.ReadOrbitStar(e.Store.Context, order.OrbitID)
+	if err != nil {
+		return "", 0, "", err
+	}
+	scCd, isOnSurface := setupSCCode(order.Kind, location.OrbitKind, orbit.Habitability)
+	if order.Kind == "colony" {
+		if ok, err := e.mayColonize(q, order.OrbitID, empireID, turnNo); err != nil {
+			return "", 0, "", err
+		} else if !ok {
+			return scCd, 0, "no right to colonize", nil
+		}
+	}
+
+	items, reason, err := e.readSetupItems(q, order, turnNo)
+	if err != nil {
+		return "", 0, "", err
+	} else if reason != "" {
+		return scCd, 0, reason, nil
+	}
+
+	// check the capacity of the new ship or colony
+	typeFactor, err := q.ReadSCTypeFactor(e.Store.Context, scCd)
+	if err != nil {
+		return "", 0, "", err
+	}
+	capacity := newCapacity(scCd, typeFactor)
+	for _, item := range items {
+		if item.isPopulation {
+			capacity.population += item.qty
+		} else if isStructure(item.code) {
+			capacity.addUnits(item.code, item.techLevel, item.qty, inventoryVolume(item.code, item.techLevel, item.qty, true, false), true)
+		} else {
+			capacity.addUnits(item.code, item.techLevel, item.qty, inventoryVolume(item.code, item.techLevel, item.qty, false, true), false)
+		}
+	}
+	if capacity.enclosed == 0 {
+		return scCd, 0, "no structure", nil
+	} else if !capacity.hasRoomFor(0) {
+		return scCd, 0, "not enough structure", nil
+	} else if !capacity.canSupport(0) {
+		return scCd, 0, "not enough life support", nil
+	}
+
+	// found the new ship or colony
+	parent, err := q.ReadSC(e.Store.Context, order.ScID)
+	if err != nil {
+		return "", 0, "", err
+	}
+	scID, err := q.CreateSC(e.Store.Context, sqlite.CreateSCParams{
+		EmpireID:    empireID,
+		ScCd:        scCd,
+		ScTechLevel: parent.ScTechLevel,
+	})
+	if err != nil {
+		return "", 0, "", err
+	}
+	err = q.CreateSCOwner(e.Store.Context, sqlite.CreateSCOwnerParams{
+		ScID:     scID,
+		Effdt:    turnNo,
+		Enddt:    domains.MaxGameTurnNo,
+		EmpireID: empireID,
+	})
+	if err != nil {
+		return "", 0, "", err
+	}
+	locationParams := sqlite.CreateSCLocationParams{
+		ScID:    scID,
+		Effdt:   turnNo,
+		Enddt:   domains.MaxGameTurnNo,
+		OrbitID: order.OrbitID,
+	}
+	if isOnSurface {
+		locationParams.IsOnSurface = 1
+	}
+	err = q.CreateSCLocation(e.Store.Context, locationParams)
+	if err != nil {
+		return "", 0, "", err
+	}
+	err = q.CreateSCName(e.Store.Context, sqlite.CreateSCNameParams{
+		ScID:  scID,
+		Name:  "Not Named",
+		Effdt: turnNo,
+		Enddt: domains.MaxGameTurnNo,
+	})
+	if err != nil {
+		return "", 0, "", err
+	}
+	if isColony(scCd) {
+		// colonies start with the rates of the parent
+		rates, err := q.ReadSCRates(e.Store.Context, sqlite.ReadSCRatesParams{ScID: order.ScID, AsOfDt: turnNo})
+		if errors.Is(err, sql.ErrNoRows) {
+			rates.Rations = 1
+		} else if err != nil {
+			return "", 0, "", err
+		}
+		err = q.CreateSCRates(e.Store.Context, sqlite.CreateSCRatesParams{
+			ScID:      scID,
+			Effdt:     turnNo,
+			Enddt:     domains.MaxGameTurnNo,
+			Rations:   rates.Rations,
+			Sol:       rates.Sol,
+			BirthRate: rates.BirthRate,
+			DeathRate: rates.DeathRate,
+		})
+		if err != nil {
+			return "", 0, "", err
+		}
+	}
+
+	// move the units and population out of the parent
+	for _, item := range items {
+		if item.isPopulation {
+			if err = e.adjustPopulation(q, order.ScID, item.code, turnNo, -item.qty); err != nil {
+				return "", 0, "", err
+			} else if err = e.adjustPopulation(q, scID, item.code, turnNo, item.qty); err != nil {
+				return "", 0, "", err
+			}
+			continue
+		}
+		if err = e.adjustInventory(q, order.ScID, item.code, item.techLevel, turnNo, -item.qty); err != nil {
+			return "", 0, "", err
+		} else if err = e.adjustInventoryEntry(q, scID, item.code, item.techLevel, isStructure(item.code), turnNo, item.qty); err != nil {
+			return "", 0, "", err
+		}
+	}
+
+	return scCd, scID, "", nil
+}
+
+// readSetupItems returns the units and population that a setup order
+// transfers, with repeated items combined. It returns the reason the order
+// can't be executed if the parent doesn't have enough of an item in storage.
+func (e *Engine_t) readSetupItems(q *sqlite.Queries, order sqlite.ReadAllSetupOrdersByTurnRow, turnNo int64) ([]*setupItem_t, string, error) {
+	rows, err := q.ReadAllSetupItemsBySetup(e.Store.Context, order.SetupID)
+	if err != nil {
+		return nil, "", err
+	}
+	var items []*setupItem_t
+	itemsByCode := map[string]*setupItem_t{}
+	for _, row := range rows {
+		code := codeTL(row.UnitCd, row.TechLevel)
+		if item, ok := itemsByCode[code]; ok {
+			item.qty += row.Qty
+			continue
+		}
+		item := &setupItem_t{code: row.UnitCd, techLevel: row.TechLevel, qty: row.Qty}
+		if _, err := q.ReadPopulationBasePayRate(e.Store.Context, row.UnitCd); err == nil {
+			item.isPopulation = true
+		} else if !errors.Is(err, sql.ErrNoRows) {
+			return nil, "", err
+		}
+		itemsByCode[code] = item
+		items = append(items, item)
+	}
+	if len(items) == 0 {
+		return nil, "nothing to transfer", nil
+	}
+
+	for _, item := range items {
+		if item.isPopulation {
+			row, err := q.ReadSCPopulationCode(e.Store.Context, sqlite.ReadSCPopulationCodeParams{
+				ScID:         order.ScID,
+				PopulationCd: item.code,
+				AsOfDt:       turnNo,
+			})
+			if errors.Is(err, sql.ErrNoRows) {
+				row.Qty = 0
+			} else if err != nil {
+				return nil, "", err
+			}
+			if row.Qty < item.qty {
+				return nil, fmt.Sprintf("not enough %s", item.code), nil
+			}
+			continue
+		}
+		row, err := q.ReadSCInventoryUnit(e.Store.Context, sqlite.ReadSCInventoryUnitParams{
+			ScID:          order.ScID,
+			UnitCd:        item.code,
+			UnitTechLevel: item.techLevel,
+			IsAssembled:   0,
+			AsOfDt:        turnNo,
+		})
+		if errors.Is(err, sql.ErrNoRows) {
+			row.Qty = 0
+		} else if err != nil {
+			return nil, "", err
+		}
+		if row.Qty < item.qty {
+			return nil, fmt.Sprintf("not enough %s", codeTL(item.code, item.techLevel)), nil
+		}
+	}
+	return items, "", nil
+}
+
+// setupSCCode returns the code for a new ship or colony and whether it is
+// on the surface. Colonies on habitable planets are open; colonies on other
+// planets and asteroid belts are enclosed; colonies anywhere else orbit.
+func setupSCCode(kind, orbitKind string, habitability int64) (string, bool) {
+	if kind == "ship" {
+		return "SHIP", false
+	}
+	switch orbitKind {
+	case "TERR":
+		if habitability > 0 {
+			return "COPN", true
+		}
+		return "CENC", true
+	case "ASTR":
+		return "CENC", true
+	}
+	return "CORB", false
+}
+
+// readSetupReports returns the results of the setup orders given by an
+// empire's ships and colonies.
+func (e *Engine_t) readSetupReports(empireID, turnNo int64) ([]*SetupReport_t, error) {
+	rows, err := e.Store.Queries.ReadAllSetupResultsByEmpire(e.Store.Context, sqlite.ReadAllSetupResultsByEmpireParams{
+		EmpireID: empireID,
+		Effdt:    turnNo,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var setups []*SetupReport_t
+	for _, row := range rows {
+		report := &SetupReport_t{
+			ScID:        row.ScID,
+			Kind:        row.Kind,
+			Coordinates: row.StarName,
+			OrbitNo:     row.OrbitNo,
+			Status:      row.Status,
+			Reason:      row.Reason,
+		}
+		if row.NewScID != 0 {
+			report.IdCode = fmt.Sprintf("CC-%d", row.NewScID)
+			if row.ScCd == "SHIP" {
+				report.IdCode = fmt.Sprintf("SS-%d", row.NewScID)
+			}
+		}
+		setups = append(setups, report)
+	}
+	return setups, nil
+}
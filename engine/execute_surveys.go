@@ -3,6 +3,10 @@
 package engine
 
 import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/playbymail/empyr/repos/sqlite"
 	"log"
 )
 
@@ -10,70 +14,115 @@ import (
 // It updates the survey results table with the results of the survey.
 // The survey includes the location, habitability, population of the
 // orbit, and data on all deposits in the orbit.
+//
+// A ship or colony can only survey the orbit that it is in. Surveys that
+// can't be executed are recorded as failed, with the reason, and are shown
+// on the turn report. Surveys update the empire's knowledge of the deposits
+// and the foreign ships and colonies in the orbit.
 func (e *Engine_t) ExecuteSurveys(gameCode string, turnNo int64) error {
-	// get a list of all the survey orders. these are the orders that need to be executed.
-	surveyOrderRows, err := e.Store.Queries.ReadAllSurveyOrdersForGameTurn(e.Store.Context, turnNo)
+	// start a transaction
+	q, tx, err := e.Store.Begin()
 	if err != nil {
 		return err
 	}
+	defer tx.Rollback()
 
-	// start a transaction
-	q, tx, err := e.Store.Begin()
+	// get a list of all the survey orders. these are the orders that need to be executed.
+	surveyOrderRows, err := q.ReadAllSurveyOrdersByTurn(e.Store.Context, turnNo)
 	if err != nil {
 		return err
 	}
-	_ = q
-	defer tx.Rollback()
 
 	for _, surveyOrder := range surveyOrderRows {
-		log.Printf("sorc %d: turn %d: survey %d\n", surveyOrder.ScID, surveyOrder.TurnNo, surveyOrder.TargetID)
-
 		// scID and orbitID are the id of the SC executing the survey and the orbit being surveyed.
-		scID, turnNo, orbitID := surveyOrder.ScID, surveyOrder.TurnNo, surveyOrder.TargetID
-		log.Printf("sorc %d: turn %d: survey %d\n", scID, turnNo, orbitID)
+		scID, surveyID, orbitID := surveyOrder.ScID, surveyOrder.SurveyID, surveyOrder.TargetID
+		log.Printf("game %q: turn %d: sc %d: survey %d: orbit %d\n", gameCode, turnNo, scID, surveyID, orbitID)
+
+		result := sqlite.CreateSCSurveyResultParams{
+			SurveyID: surveyID,
+			Effdt:    turnNo,
+			Status:   "surveyed",
+		}
+		if surveyOrder.Kind != "orbit" {
+			result.Status, result.Reason = "failed", "only orbits may be surveyed"
+		} else if loc, err := q.ReadSCLocation(e.Store.Context, sqlite.ReadSCLocationParams{ScID: scID, AsOfDt: turnNo}); errors.Is(err, sql.ErrNoRows) {
+			result.Status, result.Reason = "failed", "no location"
+		} else if err != nil {
+			return err
+		} else if loc.OrbitID != orbitID {
+			result.Status, result.Reason = "failed", fmt.Sprintf("not in orbit %d", orbitID)
+		}
+		err = q.CreateSCSurveyResult(e.Store.Context, result)
+		if err != nil {
+			return err
+		} else if result.Status == "failed" {
+			log.Printf("game %q: turn %d: sc %d: survey %d: %s: %s\n", gameCode, turnNo, scID, surveyID, result.Status, result.Reason)
+			continue
+		}
 
-		// think ReadOrbitSurvey
+		orbitRow, err := q.ReadOrbitStar(e.Store.Context, orbitID)
+		if err != nil {
+			return err
+		}
+		population, err := q.ReadOrbitPopulation(e.Store.Context, sqlite.ReadOrbitPopulationParams{OrbitID: orbitID, AsOfDt: turnNo})
+		if err != nil {
+			return err
+		}
+		farmlandInUse, err := q.ReadOrbitFarmlandInUse(e.Store.Context, sqlite.ReadOrbitFarmlandInUseParams{OrbitID: orbitID, AsOfDt: turnNo})
+		if err != nil {
+			return err
+		}
+		err = q.CreateSCSurveyOrbitResult(e.Store.Context, sqlite.CreateSCSurveyOrbitResultParams{
+			SurveyID:       surveyID,
+			Effdt:          turnNo,
+			OrbitID:        orbitID,
+			Location:       orbitRow.StarName,
+			OrbitNo:        orbitRow.OrbitNo,
+			HabitabilityNo: orbitRow.Habitability,
+			FarmlandInUse:  farmlandInUse,
+			Population:     population,
+		})
+		if err != nil {
+			return err
+		}
 
-		//// we may need to create a report for the SC that is executing the survey
-		//scReportID, err := q.ReadReport(e.Store.Context, sqlc.ReadReportParams{ScID: scID, TurnNo: turnNo})
-		//if errors.Is(err, sql.ErrNoRows) {
-		//	sorcReportID, err = q.CreateReport(e.Store.Context, sqlc.CreateReportParams{ScID: scID, TurnNo: turnNo})
-		//}
-		//if err != nil {
-		//	log.Printf("sorc %d: survey: %d: %d: %v\n", scID, orbitID, row.TechLevel, err)
-		//	return err
-		//}
-		////log.Printf("report: %d\n", reportID)
-		//
-		//// create a record for this survey report
-		//surveyReportID, err := q.CreateReportSurvey(e.Store.Context, sqlc.CreateReportSurveyParams{ReportID: sorcReportID, OrbitID: orbitID})
-		//if err != nil {
-		//	log.Printf("sorc %d: survey: %d: %d: %v\n", scID, orbitID, row.TechLevel, err)
-		//	return err
-		//}
-		////log.Printf("survey: %d\n", surveyID)
-		//
-		//// get the survey data for this orbit and add it to the report.
-		//// each deposit in the list gets a separate row in the table.
-		//surveyRows, err := e.Store.Queries.ReadOrbitSurvey(e.Store.Context, row.OrbitID)
-		//if err != nil {
-		//	return err
-		//}
-		//for _, surveyRow := range surveyRows {
-		//	// log.Printf("survey: %d %q %d %d\n", surveyRow.DepositNo, surveyRow.DepositKind, surveyRow.DepositQty, surveyRow.YieldPct)
-		//	parms := sqlc.CreateReportSurveyDepositParams{
-		//		ReportID:        surveyReportID,
-		//		DepositNo:       surveyRow.DepositNo,
-		//		DepositKind:     surveyRow.DepositKind,
-		//		DepositQty:      surveyRow.DepositQty,
-		//		DepositYieldPct: surveyRow.YieldPct,
-		//	}
-		//	err = q.CreateReportSurveyDeposit(e.Store.Context, parms)
-		//	if err != nil {
-		//		log.Printf("survey: %d %q %d %d: %v\n", surveyRow.DepositNo, surveyRow.DepositKind, surveyRow.DepositQty, surveyRow.YieldPct, err)
-		//		return err
-		//	}
-		//}
+		// get the survey data for this orbit and add it to the results.
+		// each deposit in the list gets a separate row in the table.
+		surveyRows, err := q.ReadOrbitSurvey(e.Store.Context, sqlite.ReadOrbitSurveyParams{OrbitID: orbitID, TurnNo: turnNo})
+		if err != nil {
+			return err
+		}
+		for _, surveyRow := range surveyRows {
+			err = q.CreateSCSurveyDepositResult(e.Store.Context, sqlite.CreateSCSurveyDepositResultParams{
+				SurveyID:    surveyID,
+				Effdt:       turnNo,
+				DepositNo:   surveyRow.DepositNo,
+				DepositKind: surveyRow.DepositKind,
+				DepositQty:  surveyRow.DepositQty,
+				YieldPct:    surveyRow.YieldPct,
+			})
+			if err != nil {
+				log.Printf("survey: %d %q %d %d: %v\n", surveyRow.DepositNo, surveyRow.DepositKind, surveyRow.DepositQty, surveyRow.YieldPct, err)
+				return err
+			}
+			err = e.recordDepositKnowledge(q, sqlite.CreateEmpireDepositKnowledgeParams{
+				EmpireID:    surveyOrder.EmpireID,
+				DepositID:   surveyRow.DepositID,
+				Effdt:       turnNo,
+				OrbitID:     orbitID,
+				DepositNo:   surveyRow.DepositNo,
+				DepositKind: surveyRow.DepositKind,
+				DepositQty:  surveyRow.DepositQty,
+				YieldPct:    surveyRow.YieldPct,
+			})
+			if err != nil {
+				return err
+			}
+		}
+		err = e.recordOrbitSCKnowledge(q, surveyOrder.EmpireID, orbitID, turnNo)
+		if err != nil {
+			return err
+		}
 	}
 
 	return tx.Commit()
@@ -0,0 +1,245 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package engine
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/playbymail/empyr/repos/sqlite"
+	"log"
+	"sort"
+)
+
+// ExecuteTransfers executes all the transfer orders for the current turn,
+// including the orders queued on earlier turns.
+//
+// A transfer order moves units from storage, or people, to another ship or
+// colony at the same location. The cargo is carried by the transports of
+// the sending ship or colony. The receiver must have enough space and life
+// support for the whole order, and its owner must have granted the sender
+// the right to trade in the orbit. See transport.go for the rules.
+func (e *Engine_t) ExecuteTransfers(gameCode string, turnNo int64) error {
+	// start a transaction
+	q, tx, err := e.Store.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// get a list of all the transfer orders. these are the orders that need to be executed.
+	transferOrderRows, err := q.ReadAllTransferOrdersByTurn(e.Store.Context, turnNo)
+	if err != nil {
+		return err
+	}
+
+	// transports and capacity are loaded once per ship or colony and updated
+	// as the orders are executed.
+	transports := map[int64]*transport_t{}
+	capacities := map[int64]*capacity_t{}
+
+	for _, order := range transferOrderRows {
+		owner, err := q.ReadSCOwner(e.Store.Context, sqlite.ReadSCOwnerParams{ScID: order.ScID, AsOfDt: turnNo})
+		if err != nil {
+			return err
+		}
+		transport, ok := transports[order.ScID]
+		if !ok {
+			if transport, err = e.readTransport(q, order.ScID, turnNo); err != nil {
+				return err
+			}
+			transports[order.ScID] = transport
+		}
+		result := sqlite.CreateSCTransferResultParams{
+			TransferID: order.TransferID,
+			Effdt:      turnNo,
+			EmpireID:   owner.EmpireID,
+			Status:     "succeeded",
+		}
+		err = e.executeTransferOrder(q, order, owner.EmpireID, transport, capacities, turnNo, &result)
+		if err != nil {
+			return err
+		}
+		log.Printf("game %q: turn %d: sc %d: transfer %d: %d %s to %d: %d: %s %q\n", gameCode, turnNo, order.ScID, order.TransferID, order.Qty-order.QtyMoved, codeTL(order.UnitCd, order.TechLevel), order.TargetID, result.Qty, result.Status, result.Reason)
+		err = q.CreateSCTransferResult(e.Store.Context, result)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// executeTransferOrder validates a transfer order and, if it is valid, moves
+// as much of the cargo as the transports can carry. It updates the result
+// with the quantity moved, the fuel used, and the status of the order.
+func (e *Engine_t) executeTransferOrder(q *sqlite.Queries, order sqlite.ReadAllTransferOrdersByTurnRow, empireID int64, transport *transport_t, capacities map[int64]*capacity_t, turnNo int64, result *sqlite.CreateSCTransferResultParams) error {
+	fail := func(reason string) error {
+		result.Status, result.Reason = "failed", reason
+		return nil
+	}
+	code, remaining := codeTL(order.UnitCd, order.TechLevel), order.Qty-order.QtyMoved
+	if order.ScID == order.TargetID {
+		return fail("can't transfer to itself")
+	}
+
+	// the receiver must be at the same location and allow the transfer
+	location, err := q.ReadSCLocation(e.Store.Context, sqlite.ReadSCLocationParams{ScID: order.ScID, AsOfDt: turnNo})
+	if err != nil {
+		return err
+	}
+	target, err := q.ReadSCLocation(e.Store.Context, sqlite.ReadSCLocationParams{ScID: order.TargetID, AsOfDt: turnNo})
+	if errors.Is(err, sql.ErrNoRows) {
+		return fail(fmt.Sprintf("no ship or colony %d", order.TargetID))
+	} else if err != nil {
+		return err
+	} else if target.OrbitID != location.OrbitID {
+		return fail(fmt.Sprintf("S/C %d is not at the same location", order.TargetID))
+	}
+	targetOwner, err := q.ReadSCOwner(e.Store.Context, sqlite.ReadSCOwnerParams{ScID: order.TargetID, AsOfDt: turnNo})
+	if err != nil {
+		return err
+	} else if ok, err := e.hasPermission(q, targetOwner.EmpireID, location.OrbitID, "trade", empireID, turnNo); err != nil {
+		return err
+	} else if !ok {
+		return fail(fmt.Sprintf("no right to transfer to S/C %d", order.TargetID))
+	}
+
+	// the sender must have the cargo
+	isPopulation := false
+	if _, err := q.ReadPopulationBasePayRate(e.Store.Context, order.UnitCd); err == nil {
+		isPopulation = true
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		return err
+	} else if _, ok := unitTable[order.UnitCd]; !ok {
+		return fail(fmt.Sprintf("no such unit %s", order.UnitCd))
+	}
+	var stock int64
+	if isPopulation {
+		stock, err = e.readPopulationQty(q, order.ScID, order.UnitCd, turnNo)
+	} else {
+		stock, err = e.readStoredQty(q, order.ScID, order.UnitCd, order.TechLevel, turnNo)
+	}
+	if err != nil {
+		return err
+	} else if stock < remaining {
+		return fail(fmt.Sprintf("not enough %s", code))
+	}
+
+	// the receiver must have room for the whole order
+	receiver, ok := capacities[order.TargetID]
+	if !ok {
+		if receiver, err = e.readCapacity(q, order.TargetID, turnNo); err != nil {
+			return err
+		}
+		capacities[order.TargetID] = receiver
+	}
+	massPerUnit := float64(transportMassPerPerson)
+	if isPopulation {
+		if !receiver.canSupport(remaining) {
+			return fail(fmt.Sprintf("not enough life support at S/C %d", order.TargetID))
+		}
+	} else {
+		massPerUnit = Mass(order.UnitCd, order.TechLevel, 1)
+		if !receiver.hasRoomFor(inventoryVolume(order.UnitCd, order.TechLevel, remaining, false, true)) {
+			return fail(fmt.Sprintf("not enough space at S/C %d", order.TargetID))
+		}
+	}
+
+	// the transports carry as much as they can and queue the rest
+	if transport.capacity == 0 {
+		return fail("no crewed transports")
+	}
+	qty := transport.fit(remaining, massPerUnit, order.UnitCd == "FUEL")
+	if qty == 0 {
+		result.Status = "queued"
+		if transport.available() < massPerUnit {
+			result.Reason = "not enough transport capacity"
+		} else {
+			result.Reason = "not enough fuel"
+		}
+		return nil
+	} else if qty < remaining {
+		result.Status = "queued"
+		result.Reason = fmt.Sprintf("%s left to transfer", commas(remaining-qty))
+	}
+	result.Qty, result.Mass = qty, float64(qty)*massPerUnit
+	result.FuelUsed = transport.carry(qty, massPerUnit, order.UnitCd == "FUEL")
+	if err = e.adjustInventory(q, order.ScID, "FUEL", 0, turnNo, -result.FuelUsed); err != nil {
+		return err
+	}
+	if isPopulation {
+		if err = e.adjustPopulation(q, order.ScID, order.UnitCd, turnNo, -qty); err != nil {
+			return err
+		} else if err = e.adjustPopulation(q, order.TargetID, order.UnitCd, turnNo, qty); err != nil {
+			return err
+		}
+		receiver.population += qty
+		if sender, ok := capacities[order.ScID]; ok {
+			sender.population -= qty
+		}
+		return nil
+	}
+	if err = e.adjustInventory(q, order.ScID, order.UnitCd, order.TechLevel, turnNo, -qty); err != nil {
+		return err
+	} else if err = e.adjustInventory(q, order.TargetID, order.UnitCd, order.TechLevel, turnNo, qty); err != nil {
+		return err
+	}
+	volume := inventoryVolume(order.UnitCd, order.TechLevel, qty, false, true)
+	receiver.addUnits(order.UnitCd, order.TechLevel, qty, volume, false)
+	if sender, ok := capacities[order.ScID]; ok {
+		sender.addUnits(order.UnitCd, order.TechLevel, -qty, -volume, false)
+	}
+	return nil
+}
+
+// readTransport returns the transport capacity of a ship or colony. The
+// transports with the highest tech level are crewed first.
+func (e *Engine_t) readTransport(q *sqlite.Queries, scID, turnNo int64) (*transport_t, error) {
+	transport := &transport_t{}
+	pro, err := e.readPopulationQty(q, scID, "PRO", turnNo)
+	if err != nil {
+		return nil, err
+	} else if transport.fuel, err = e.readStoredQty(q, scID, "FUEL", 0, turnNo); err != nil {
+		return nil, err
+	}
+	rows, err := q.ReadSCInventory(e.Store.Context, sqlite.ReadSCInventoryParams{ScID: scID, AsOfDt: turnNo})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		return rows[i].UnitTechLevel > rows[j].UnitTechLevel
+	})
+	for _, row := range rows {
+		if row.UnitCd == "TPT" && row.IsAssembled == 0 {
+			pro -= transport.addTransports(row.UnitTechLevel, row.Qty, pro)
+		}
+	}
+	return transport, nil
+}
+
+// readTransferReports returns the results of the transfer orders given by
+// an empire's ships and colonies.
+func (e *Engine_t) readTransferReports(empireID, turnNo int64) ([]*TransferReport_t, error) {
+	rows, err := e.Store.Queries.ReadAllTransferResultsByEmpire(e.Store.Context, sqlite.ReadAllTransferResultsByEmpireParams{
+		EmpireID: empireID,
+		Effdt:    turnNo,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var transfers []*TransferReport_t
+	for _, row := range rows {
+		transfers = append(transfers, &TransferReport_t{
+			ScID:       row.ScID,
+			TargetID:   row.TargetID,
+			Cargo:      codeTL(row.UnitCd, row.TechLevel),
+			OrderedQty: commas(row.OrderedQty),
+			Qty:        commas(row.Qty),
+			FuelUsed:   commas(row.FuelUsed),
+			Status:     row.Status,
+			Reason:     row.Reason,
+		})
+	}
+	return transfers, nil
+}
@@ -78,6 +78,7 @@ type FactoryGroupInputs_t struct {
 	Aut        float64
 	Gold       float64
 	Fuel       float64
+	Power      float64
 	Mets       float64
 	Nmts       float64
 }
@@ -93,6 +94,7 @@ type FactoryGroupConstraints_t struct {
 	Aut        float64
 	Gold       float64
 	Fuel       float64
+	Power      float64
 	Mets       float64
 	Nmts       float64
 }
@@ -182,9 +184,9 @@ func (unit *FactoryGroupUnit_t) Allocate(constraints FactoryGroupConstraints_t)
 	// Calculate the maximum number of units that can be allocated based
 	// on the constraints (the available amount of fuel and labor)
 	for changed := true; changed && constraints.NbrOfUnits > 0; changed = false {
-		// limit the maximum number of units to the amount of fuel available
-		if fuelPerUnit*constraints.NbrOfUnits > constraints.Fuel {
-			constraints.NbrOfUnits = math.Floor(constraints.Fuel / fuelPerUnit)
+		// limit the maximum number of units to the amount of fuel and power available
+		if fuelPerUnit*constraints.NbrOfUnits > constraints.Fuel+constraints.Power {
+			constraints.NbrOfUnits = math.Floor((constraints.Fuel + constraints.Power) / fuelPerUnit)
 			changed = true
 		}
 		// limit the maximum number of units to the amount of PRO available
@@ -207,12 +209,10 @@ func (unit *FactoryGroupUnit_t) Allocate(constraints FactoryGroupConstraints_t)
 	// allocate resources based on the maximum number of units that satisfy the constraints
 	allocated.NbrOfUnits = constraints.NbrOfUnits
 	allocated.Pro = constraints.NbrOfUnits * proPerUnit
-	// always allocate AUT before USK
-	allocated.Aut = math.Min(constraints.Aut, constraints.NbrOfUnits*uskPerUnit)
-	if allocated.Aut < constraints.NbrOfUnits*uskPerUnit {
-		allocated.Usk = constraints.NbrOfUnits*uskPerUnit - allocated.Aut
-	}
-	allocated.Fuel = constraints.NbrOfUnits * fuelPerUnit
+	// AUT covers any shortfall of USK
+	allocated.Usk, allocated.Aut = staffUnskilled(constraints.NbrOfUnits*uskPerUnit, constraints.Usk, constraints.Aut)
+	// always allocate power before fuel
+	allocated.Power, allocated.Fuel = powerAndFuel(constraints.NbrOfUnits*fuelPerUnit, constraints.Power)
 
 	return allocated
 }
@@ -286,19 +286,8 @@ func (unit *FactoryGroupUnit_t) fuelRequiredPerUnit() (fuel float64) {
 
 // factories require a variable number of PRO and USK per unit per turn
 func (unit *FactoryGroupUnit_t) laborRequiredPerUnit() (pro, usk float64) {
-	if unit.NbrOfUnits < 5 {
-		return 6, 18
-	} else if unit.NbrOfUnits < 50 {
-		return 5, 15
-	} else if unit.NbrOfUnits < 500 {
-		return 4, 12
-	} else if unit.NbrOfUnits < 5_000 {
-		return 3, 9
-	} else if unit.NbrOfUnits < 50_000 {
-		return 2, 6
-	} else {
-		return 1, 3
-	}
+	p, u := laborPerUnit("factory", unit.NbrOfUnits)
+	return float64(p), float64(u)
 }
 
 // factories consume 30 mass units per technology level per unit per year.
@@ -33,6 +33,7 @@ type FarmGroupInputs_t struct {
 	Usk        float64
 	Aut        float64
 	Fuel       float64
+	Power      float64
 }
 
 type FarmGroupOutputs_t struct {
@@ -51,6 +52,7 @@ func (grp *FarmGroup_t) Allocate(constraints FarmGroupConstraints_t) FarmGroupCo
 		constraints.Usk -= unit.Allocated.Usk
 		constraints.Aut -= unit.Allocated.Aut
 		constraints.Fuel -= unit.Allocated.Fuel
+		constraints.Power -= unit.Allocated.Power
 	}
 	return constraints
 }
@@ -81,12 +83,15 @@ func (grp *FarmGroup_t) Summarize() {
 	grp.Summary.Produced = &FarmGroupOutputs_t{}
 	for _, unit := range grp.Units {
 		grp.Summary.Wanted.Fuel += unit.Wanted.Fuel
+		grp.Summary.Wanted.Power += unit.Wanted.Power
 		grp.Summary.Wanted.Pro += unit.Wanted.Pro
 		grp.Summary.Wanted.Usk += unit.Wanted.Usk
 		grp.Summary.Allocated.Fuel += unit.Allocated.Fuel
+		grp.Summary.Allocated.Power += unit.Allocated.Power
 		grp.Summary.Allocated.Pro += unit.Allocated.Pro
 		grp.Summary.Allocated.Usk += unit.Allocated.Usk
 		grp.Summary.Consumed.Fuel += unit.Consumed.Fuel
+		grp.Summary.Consumed.Power += unit.Consumed.Power
 		grp.Summary.Consumed.Pro += unit.Consumed.Pro
 		grp.Summary.Consumed.Usk += unit.Consumed.Usk
 		grp.Summary.Produced.Food += unit.Produced.Food
@@ -98,6 +103,7 @@ type FarmGroupConstraints_t struct {
 	Pro        float64
 	Usk        float64
 	Fuel       float64
+	Power      float64
 	Aut        float64
 }
 
@@ -109,9 +115,9 @@ func (unit *FarmGroupUnit_t) Allocate(constraints FarmGroupConstraints_t) *FarmG
 	// calculate the maximum number of units that can be allocated based
 	// on the constraints (the available amount of fuel and labor)
 	for changed := true; changed && constraints.NbrOfUnits > 0; changed = false {
-		// limit the maximum number of units to the amount of fuel available
-		if fuelPerUnit*constraints.NbrOfUnits > constraints.Fuel {
-			constraints.NbrOfUnits = math.Floor(constraints.Fuel / fuelPerUnit)
+		// limit the maximum number of units to the amount of fuel and power available
+		if fuelPerUnit*constraints.NbrOfUnits > constraints.Fuel+constraints.Power {
+			constraints.NbrOfUnits = math.Floor((constraints.Fuel + constraints.Power) / fuelPerUnit)
 			changed = true
 		}
 		// limit the maximum number of units to the amount of PRO available
@@ -136,12 +142,10 @@ func (unit *FarmGroupUnit_t) Allocate(constraints FarmGroupConstraints_t) *FarmG
 	// allocate resources based on the maximum number of units that satisfy the constraints
 	allocated.NbrOfUnits = constraints.NbrOfUnits
 	allocated.Pro = constraints.NbrOfUnits * proPerUnit
-	// always allocate AUT before USK
-	allocated.Aut = math.Min(constraints.Aut, constraints.NbrOfUnits*uskPerUnit)
-	if allocated.Aut < constraints.NbrOfUnits*uskPerUnit {
-		allocated.Usk = constraints.NbrOfUnits*uskPerUnit - allocated.Aut
-	}
-	allocated.Fuel = constraints.NbrOfUnits * fuelPerUnit
+	// AUT covers any shortfall of USK
+	allocated.Usk, allocated.Aut = staffUnskilled(constraints.NbrOfUnits*uskPerUnit, constraints.Usk, constraints.Aut)
+	// always allocate power before fuel
+	allocated.Power, allocated.Fuel = powerAndFuel(constraints.NbrOfUnits*fuelPerUnit, constraints.Power)
 
 	return allocated
 }
@@ -156,6 +160,7 @@ func (unit *FarmGroupUnit_t) Consume(allocated *FarmGroupInputs_t) *FarmGroupInp
 		Usk:        allocated.Usk,
 		Aut:        allocated.Aut,
 		Fuel:       allocated.Fuel,
+		Power:      allocated.Power,
 	}
 }
 
@@ -198,5 +203,6 @@ func (unit *FarmGroupUnit_t) fuelRequiredPerUnit() (fuel float64) {
 
 // farms require 1 PRO and 3 USK per unit per turn
 func (unit *FarmGroupUnit_t) laborRequiredPerUnit() (pro, usk float64) {
-	return float64(unit.NbrOfUnits), float64(unit.NbrOfUnits) * 3
+	p, u := laborPerUnit("farm", unit.NbrOfUnits)
+	return float64(p), float64(u)
 }
@@ -0,0 +1,131 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package engine
+
+import (
+	"database/sql"
+	"errors"
+	"github.com/playbymail/empyr/internal/domains"
+	"github.com/playbymail/empyr/repos/sqlite"
+)
+
+// this file implements helpers for updating the factory, farm, lab, and mine
+// groups of ships and colonies.
+
+const (
+	ErrInsufficientGroupUnits = Error("insufficient group units")
+)
+
+// findGroup returns the id of a ship or colony's group with the given kind
+// and number as of the turn. It returns false if there is no such group.
+func (e *Engine_t) findGroup(q *sqlite.Queries, scID int64, kind string, groupNo, turnNo int64) (int64, bool, error) {
+	rows, err := q.ReadSCGroups(e.Store.Context, sqlite.ReadSCGroupsParams{ScID: scID, Kind: kind, AsOfDt: turnNo})
+	if err != nil {
+		return 0, false, err
+	}
+	for _, row := range rows {
+		if row.GroupNo == groupNo {
+			return row.GroupID, true, nil
+		}
+	}
+	return 0, false, nil
+}
+
+// createGroup creates a new, empty group for a ship or colony, effective on
+// the turn, and returns its id. The caller must make sure that the number
+// isn't already used by another group of the same kind.
+func (e *Engine_t) createGroup(q *sqlite.Queries, scID int64, kind string, groupNo, turnNo int64) (int64, error) {
+	groupID, err := q.CreateSCGroup(e.Store.Context, sqlite.CreateSCGroupParams{
+		ScID:  scID,
+		Kind:  kind,
+		Effdt: turnNo,
+		Enddt: domains.MaxGameTurnNo,
+	})
+	if err != nil {
+		return 0, err
+	}
+	err = q.CreateSCGroupNo(e.Store.Context, sqlite.CreateSCGroupNoParams{
+		GroupID: groupID,
+		Effdt:   turnNo,
+		Enddt:   domains.MaxGameTurnNo,
+		GroupNo: groupNo,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return groupID, nil
+}
+
+// adjustGroupUnits adds (or removes, if delta is negative) units with a
+// single tech level from a group.
+//
+// Group units are effective-dated. The current entry is end-dated on the
+// turn and a new entry is created with the new number of units. If the
+// entry was created on this turn, it is updated in place.
+func (e *Engine_t) adjustGroupUnits(q *sqlite.Queries, groupID, techLevel, turnNo, delta int64) error {
+	if delta == 0 {
+		return nil
+	}
+	row, err := q.ReadSCGroupUnit(e.Store.Context, sqlite.ReadSCGroupUnitParams{
+		GroupID:   groupID,
+		TechLevel: techLevel,
+		AsOfDt:    turnNo,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		if delta < 0 {
+			return ErrInsufficientGroupUnits
+		}
+		return q.CreateSCGroupUnit(e.Store.Context, sqlite.CreateSCGroupUnitParams{
+			GroupID:    groupID,
+			TechLevel:  techLevel,
+			Effdt:      turnNo,
+			Enddt:      domains.MaxGameTurnNo,
+			NbrOfUnits: delta,
+		})
+	} else if err != nil {
+		return err
+	}
+	qty := row.NbrOfUnits + delta
+	if qty < 0 {
+		return ErrInsufficientGroupUnits
+	}
+
+	// entries created this turn are updated in place
+	if row.Effdt == turnNo {
+		if qty == 0 {
+			return q.UpdateSCGroupUnitEndDt(e.Store.Context, sqlite.UpdateSCGroupUnitEndDtParams{
+				Enddt:     turnNo,
+				GroupID:   groupID,
+				TechLevel: techLevel,
+				Effdt:     row.Effdt,
+			})
+		}
+		return q.UpdateSCGroupUnitQty(e.Store.Context, sqlite.UpdateSCGroupUnitQtyParams{
+			NbrOfUnits: qty,
+			GroupID:    groupID,
+			TechLevel:  techLevel,
+			Effdt:      row.Effdt,
+		})
+	}
+
+	// otherwise, end-date the current entry and create a new one
+	err = q.UpdateSCGroupUnitEndDt(e.Store.Context, sqlite.UpdateSCGroupUnitEndDtParams{
+		Enddt:     turnNo,
+		GroupID:   groupID,
+		TechLevel: techLevel,
+		Effdt:     row.Effdt,
+	})
+	if err != nil {
+		return err
+	}
+	if qty == 0 {
+		return nil
+	}
+	return q.CreateSCGroupUnit(e.Store.Context, sqlite.CreateSCGroupUnitParams{
+		GroupID:    groupID,
+		TechLevel:  techLevel,
+		Effdt:      turnNo,
+		Enddt:      row.Enddt,
+		NbrOfUnits: qty,
+	})
+}
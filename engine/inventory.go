@@ -0,0 +1,209 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package engine
+
+import (
+	"database/sql"
+	"errors"
+	"github.com/playbymail/empyr/internal/domains"
+	"github.com/playbymail/empyr/repos/sqlite"
+)
+
+// this file implements helpers for updating the inventory of ships and colonies.
+
+const (
+	ErrInsufficientInventory = Error("insufficient inventory")
+)
+
+// adjustInventory adds (or removes, if delta is negative) units from the
+// storage of a ship or colony.
+func (e *Engine_t) adjustInventory(q *sqlite.Queries, scID int64, unitCd string, techLevel, turnNo, delta int64) error {
+	return e.adjustInventoryEntry(q, scID, unitCd, techLevel, false, turnNo, delta)
+}
+
+// readStoredQty returns the number of units in the storage of a ship or
+// colony as of the turn.
+func (e *Engine_t) readStoredQty(q *sqlite.Queries, scID int64, unitCd string, techLevel, turnNo int64) (int64, error) {
+	row, err := q.ReadSCInventoryUnit(e.Store.Context, sqlite.ReadSCInventoryUnitParams{
+		ScID:          scID,
+		UnitCd:        unitCd,
+		UnitTechLevel: techLevel,
+		IsAssembled:   0,
+		AsOfDt:        turnNo,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	} else if err != nil {
+		return 0, err
+	}
+	return row.Qty, nil
+}
+
+// adjustInventoryEntry adds (or removes, if delta is negative) units from
+// the inventory of a ship or colony. Assembled units and units in storage
+// are kept in separate entries.
+//
+// Inventory is effective-dated. The current entry is end-dated on the turn
+// and a new entry is created with the new quantity. If the entry was created
+// on this turn, it is updated in place.
+func (e *Engine_t) adjustInventoryEntry(q *sqlite.Queries, scID int64, unitCd string, techLevel int64, isAssembled bool, turnNo, delta int64) error {
+	if delta == 0 {
+		return nil
+	}
+	var assembled, stored int64 = 0, 1
+	if isAssembled {
+		assembled, stored = 1, 0
+	}
+	row, err := q.ReadSCInventoryUnit(e.Store.Context, sqlite.ReadSCInventoryUnitParams{
+		ScID:          scID,
+		UnitCd:        unitCd,
+		UnitTechLevel: techLevel,
+		IsAssembled:   assembled,
+		AsOfDt:        turnNo,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		if delta < 0 {
+			return ErrInsufficientInventory
+		}
+		return q.CreateSCInventory(e.Store.Context, sqlite.CreateSCInventoryParams{
+			ScID:          scID,
+			UnitCd:        unitCd,
+			UnitTechLevel: techLevel,
+			Effdt:         turnNo,
+			Enddt:         domains.MaxGameTurnNo,
+			Qty:           delta,
+			Mass:          Mass(unitCd, techLevel, delta),
+			Volume:        inventoryVolume(unitCd, techLevel, delta, isAssembled, !isAssembled),
+			IsAssembled:   assembled,
+			IsStored:      stored,
+		})
+	} else if err != nil {
+		return err
+	}
+	qty := row.Qty + delta
+	if qty < 0 {
+		return ErrInsufficientInventory
+	}
+	isStored := row.IsStored == 1
+
+	// entries created this turn are updated in place
+	if row.Effdt == turnNo {
+		if qty == 0 {
+			return q.UpdateSCInventoryEndDt(e.Store.Context, sqlite.UpdateSCInventoryEndDtParams{
+				Enddt:         turnNo,
+				ScID:          scID,
+				UnitCd:        unitCd,
+				UnitTechLevel: techLevel,
+				IsAssembled:   assembled,
+				Effdt:         row.Effdt,
+			})
+		}
+		return q.UpdateSCInventoryQty(e.Store.Context, sqlite.UpdateSCInventoryQtyParams{
+			Qty:           qty,
+			Mass:          Mass(unitCd, techLevel, qty),
+			Volume:        inventoryVolume(unitCd, techLevel, qty, isAssembled, isStored),
+			ScID:          scID,
+			UnitCd:        unitCd,
+			UnitTechLevel: techLevel,
+			IsAssembled:   assembled,
+			Effdt:         row.Effdt,
+		})
+	}
+
+	// otherwise, end-date the current entry and create a new one
+	err = q.UpdateSCInventoryEndDt(e.Store.Context, sqlite.UpdateSCInventoryEndDtParams{
+		Enddt:         turnNo,
+		ScID:          scID,
+		UnitCd:        unitCd,
+		UnitTechLevel: techLevel,
+		IsAssembled:   assembled,
+		Effdt:         row.Effdt,
+	})
+	if err != nil {
+		return err
+	}
+	if qty == 0 {
+		return nil
+	}
+	return q.CreateSCInventory(e.Store.Context, sqlite.CreateSCInventoryParams{
+		ScID:          scID,
+		UnitCd:        unitCd,
+		UnitTechLevel: techLevel,
+		Effdt:         turnNo,
+		Enddt:         domains.MaxGameTurnNo,
+		Qty:           qty,
+		Mass:          Mass(unitCd, techLevel, qty),
+		Volume:        inventoryVolume(unitCd, techLevel, qty, isAssembled, isStored),
+		IsAssembled:   row.IsAssembled,
+		IsStored:      row.IsStored,
+	})
+}
+
+// inventoryVolume returns the volume of an inventory entry. It uses the
+// same rules as the setup for the home colony.
+func inventoryVolume(unitCd string, techLevel, qty int64, isAssembled, isStored bool) float64 {
+	if isStored {
+		return VolumeStored(unitCd, techLevel, qty)
+	} else if !IsOperational(unitCd) {
+		return 0
+	} else if isAssembled {
+		return VolumeAssembled(unitCd, techLevel, qty)
+	}
+	return VolumeDisassembled(unitCd, techLevel, qty)
+}
+
+// inventoryMass returns the total mass of a ship or colony's inventory.
+func inventoryMass(rows []sqlite.ReadSCInventoryRow) (mass float64) {
+	for _, row := range rows {
+		mass += row.Mass
+	}
+	return mass
+}
+
+// readCapacity returns the space and life support of a ship or colony as
+// of the turn.
+func (e *Engine_t) readCapacity(q *sqlite.Queries, scID, turnNo int64) (*capacity_t, error) {
+	sc, err := q.ReadSC(e.Store.Context, scID)
+	if err != nil {
+		return nil, err
+	}
+	typeFactor, err := q.ReadSCTypeFactor(e.Store.Context, sc.ScCd)
+	if err != nil {
+		return nil, err
+	}
+	capacity := newCapacity(sc.ScCd, typeFactor)
+	inventoryRows, err := q.ReadSCInventory(e.Store.Context, sqlite.ReadSCInventoryParams{ScID: scID, AsOfDt: turnNo})
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range inventoryRows {
+		capacity.addUnits(row.UnitCd, row.UnitTechLevel, row.Qty, row.Volume, row.IsAssembled == 1)
+	}
+	populationRows, err := q.ReadSCPopulation(e.Store.Context, sqlite.ReadSCPopulationParams{ScID: scID, AsOfDt: turnNo})
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range populationRows {
+		capacity.population += row.Qty
+	}
+	return capacity, nil
+}
+
+// readPower returns the power produced by a ship or colony's assembled power
+// plants on the turn and the power that its groups haven't used yet.
+func (e *Engine_t) readPower(q *sqlite.Queries, scID, turnNo int64) (produced, available int64, err error) {
+	inventoryRows, err := q.ReadSCInventory(e.Store.Context, sqlite.ReadSCInventoryParams{ScID: scID, AsOfDt: turnNo})
+	if err != nil {
+		return 0, 0, err
+	}
+	for _, row := range inventoryRows {
+		if row.UnitCd == "PWP" && row.IsAssembled == 1 {
+			produced += powerProduced(row.UnitTechLevel, row.Qty)
+		}
+	}
+	consumed, err := q.ReadSCPowerConsumed(e.Store.Context, sqlite.ReadSCPowerConsumedParams{ScID: scID, ProductionDt: turnNo})
+	if err != nil {
+		return 0, 0, err
+	}
+	return produced, max(produced-consumed, 0), nil
+}
@@ -0,0 +1,184 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package engine
+
+import (
+	"fmt"
+	"github.com/playbymail/empyr/internal/domains"
+	"github.com/playbymail/empyr/repos/sqlite"
+	"sort"
+)
+
+// this file implements helpers for recording what an empire knows about the cluster.
+//
+// knowledge is effective-dated. recording an observation end-dates the
+// current entry and creates a new one that is effective on the turn, so
+// the effdt of the current entry is the turn it was last observed.
+
+// recordStarKnowledge records that an empire observed a star.
+func (e *Engine_t) recordStarKnowledge(q *sqlite.Queries, arg sqlite.CreateEmpireStarKnowledgeParams) error {
+	err := q.UpdateEmpireStarKnowledgeEndDt(e.Store.Context, sqlite.UpdateEmpireStarKnowledgeEndDtParams{
+		Effdt:    arg.Effdt,
+		EmpireID: arg.EmpireID,
+		StarID:   arg.StarID,
+	})
+	if err != nil {
+		return err
+	}
+	arg.Enddt = domains.MaxGameTurnNo
+	return q.CreateEmpireStarKnowledge(e.Store.Context, arg)
+}
+
+// recordOrbitKnowledge records that an empire observed an orbit.
+func (e *Engine_t) recordOrbitKnowledge(q *sqlite.Queries, arg sqlite.CreateEmpireOrbitKnowledgeParams) error {
+	err := q.UpdateEmpireOrbitKnowledgeEndDt(e.Store.Context, sqlite.UpdateEmpireOrbitKnowledgeEndDtParams{
+		Effdt:    arg.Effdt,
+		EmpireID: arg.EmpireID,
+		OrbitID:  arg.OrbitID,
+	})
+	if err != nil {
+		return err
+	}
+	arg.Enddt = domains.MaxGameTurnNo
+	return q.CreateEmpireOrbitKnowledge(e.Store.Context, arg)
+}
+
+// recordDepositKnowledge records that an empire observed a deposit.
+func (e *Engine_t) recordDepositKnowledge(q *sqlite.Queries, arg sqlite.CreateEmpireDepositKnowledgeParams) error {
+	err := q.UpdateEmpireDepositKnowledgeEndDt(e.Store.Context, sqlite.UpdateEmpireDepositKnowledgeEndDtParams{
+		Effdt:     arg.Effdt,
+		EmpireID:  arg.EmpireID,
+		DepositID: arg.DepositID,
+	})
+	if err != nil {
+		return err
+	}
+	arg.Enddt = domains.MaxGameTurnNo
+	return q.CreateEmpireDepositKnowledge(e.Store.Context, arg)
+}
+
+// recordSCKnowledge records that an empire observed a foreign ship or colony.
+// Ships and colonies owned by the empire are ignored.
+func (e *Engine_t) recordSCKnowledge(q *sqlite.Queries, arg sqlite.CreateEmpireSCKnowledgeParams) error {
+	if arg.OwnerID == arg.EmpireID {
+		return nil
+	}
+	err := q.UpdateEmpireSCKnowledgeEndDt(e.Store.Context, sqlite.UpdateEmpireSCKnowledgeEndDtParams{
+		Effdt:    arg.Effdt,
+		EmpireID: arg.EmpireID,
+		ScID:     arg.ScID,
+	})
+	if err != nil {
+		return err
+	}
+	arg.Enddt = domains.MaxGameTurnNo
+	return q.CreateEmpireSCKnowledge(e.Store.Context, arg)
+}
+
+// recordOrbitSCKnowledge records all the foreign ships and colonies in an
+// orbit as observed by an empire.
+func (e *Engine_t) recordOrbitSCKnowledge(q *sqlite.Queries, empireID, orbitID, turnNo int64) error {
+	scRows, err := q.ReadAllSCsByOrbit(e.Store.Context, sqlite.ReadAllSCsByOrbitParams{OrbitID: orbitID, AsOfDt: turnNo})
+	if err != nil {
+		return err
+	}
+	for _, scRow := range scRows {
+		err = e.recordSCKnowledge(q, sqlite.CreateEmpireSCKnowledgeParams{
+			EmpireID:    empireID,
+			ScID:        scRow.ScID,
+			Effdt:       turnNo,
+			OwnerID:     scRow.EmpireID,
+			ScCd:        scRow.ScCd,
+			OrbitID:     orbitID,
+			IsOnSurface: scRow.IsOnSurface,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readKnownStarReports returns the stars and orbits that an empire knows
+// about as of the turn. Orbits that were probed without probing the star
+// are reported under the star, without the number of orbits. Stars are
+// shown with the names the empire gave them.
+func (e *Engine_t) readKnownStarReports(empireID, turnNo int64, names *PrivateNames_t) ([]*KnownStarReport_t, error) {
+	starRows, err := e.Store.Queries.ReadAllEmpireStarKnowledge(e.Store.Context, sqlite.ReadAllEmpireStarKnowledgeParams{EmpireID: empireID, AsOfDt: turnNo})
+	if err != nil {
+		return nil, err
+	}
+	var stars []*KnownStarReport_t
+	starReports := map[int64]*KnownStarReport_t{}
+	for _, starRow := range starRows {
+		starReport := &KnownStarReport_t{
+			Name:         names.Coordinates(starRow.SystemID, starRow.StarID, starRow.StarName),
+			NbrOfOrbits:  fmt.Sprintf("%d", starRow.NbrOfOrbits),
+			LastObserved: starRow.ObservedDt,
+		}
+		starReports[starRow.StarID] = starReport
+		stars = append(stars, starReport)
+	}
+
+	orbitRows, err := e.Store.Queries.ReadAllEmpireOrbitKnowledge(e.Store.Context, sqlite.ReadAllEmpireOrbitKnowledgeParams{EmpireID: empireID, AsOfDt: turnNo})
+	if err != nil {
+		return nil, err
+	}
+	orbitReports := map[int64]*KnownOrbitReport_t{}
+	orbitsByStar := map[int64]map[int64]*KnownOrbitReport_t{}
+	for _, orbitRow := range orbitRows {
+		starReport, ok := starReports[orbitRow.StarID]
+		if !ok {
+			star, err := e.Store.Queries.ReadStarSystem(e.Store.Context, orbitRow.StarID)
+			if err != nil {
+				return nil, err
+			}
+			starReport = &KnownStarReport_t{Name: names.Coordinates(star.SystemID, orbitRow.StarID, star.StarName), LastObserved: orbitRow.ObservedDt}
+			starReports[orbitRow.StarID] = starReport
+			stars = append(stars, starReport)
+		}
+		starReport.LastObserved = max(starReport.LastObserved, orbitRow.ObservedDt)
+		orbitReport := &KnownOrbitReport_t{
+			OrbitNo:      orbitRow.OrbitNo,
+			Kind:         orbitRow.OrbitKind,
+			Fuel:         orbitRow.FuelEst,
+			Gold:         orbitRow.GoldEst,
+			Mets:         orbitRow.MetsEst,
+			Nmts:         orbitRow.NmtsEst,
+			LastObserved: orbitRow.ObservedDt,
+		}
+		starReport.Orbits = append(starReport.Orbits, orbitReport)
+		orbitReports[orbitRow.OrbitID] = orbitReport
+		if orbitsByStar[orbitRow.StarID] == nil {
+			orbitsByStar[orbitRow.StarID] = map[int64]*KnownOrbitReport_t{}
+		}
+		orbitsByStar[orbitRow.StarID][orbitRow.OrbitNo] = orbitReport
+	}
+
+	depositRows, err := e.Store.Queries.ReadAllEmpireDepositKnowledge(e.Store.Context, sqlite.ReadAllEmpireDepositKnowledgeParams{EmpireID: empireID, AsOfDt: turnNo})
+	if err != nil {
+		return nil, err
+	}
+	for _, depositRow := range depositRows {
+		if orbitReport, ok := orbitReports[depositRow.OrbitID]; ok {
+			orbitReport.Deposits++
+		}
+	}
+
+	scRows, err := e.Store.Queries.ReadAllEmpireSCKnowledge(e.Store.Context, sqlite.ReadAllEmpireSCKnowledgeParams{EmpireID: empireID, AsOfDt: turnNo})
+	if err != nil {
+		return nil, err
+	}
+	for _, scRow := range scRows {
+		orbitReport, ok := orbitsByStar[scRow.StarID][scRow.OrbitNo]
+		if !ok {
+			continue
+		}
+		orbitReport.ForeignSCs = append(orbitReport.ForeignSCs, fmt.Sprintf("%s-%d (E%03d)", scRow.ScCd, scRow.ScID, scRow.OwnerID))
+	}
+
+	sort.Slice(stars, func(i, j int) bool {
+		return stars[i].Name < stars[j].Name
+	})
+	return stars, nil
+}
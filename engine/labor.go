@@ -0,0 +1,84 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package engine
+
+// this file implements the rules for assigning labor to groups.
+//
+// Factories, farms, labs, and mines need professionals (PRO) and unskilled
+// workers (USK) to operate. Farms and mines need 1 PRO and 3 USK per unit
+// and labs need 3 PRO and 1 USK per unit. Factories need fewer workers per
+// unit as the number of units with the same tech level grows (see
+// laborPerUnit).
+//
+// Each assembled automation unit (AUT) replaces TL² unskilled workers.
+// Automation is only used to cover a shortfall of unskilled workers.
+//
+// Labor is assigned to all of a ship or colony's groups in group number
+// order, lowest first. Groups with the same number are assigned in order
+// of kind (factory, farm, lab, mine). Within a group, units are staffed from
+// the lowest tech level up. Units that can't be fully staffed don't operate.
+
+// labor_t is the professionals, unskilled workers, and automation that are
+// available to (or used by) groups.
+type labor_t struct {
+	pro int64
+	usk int64
+	aut int64 // measured in unskilled workers replaced
+}
+
+// staff staffs up to qty units that need proPerUnit professionals and
+// uskPerUnit unskilled workers each. Unskilled workers are used before
+// automation. It returns the number of units staffed and the labor used,
+// which is taken out of the labor available.
+func (l *labor_t) staff(qty, proPerUnit, uskPerUnit int64) (int64, labor_t) {
+	if proPerUnit > 0 {
+		qty = min(qty, l.pro/proPerUnit)
+	}
+	if uskPerUnit > 0 {
+		qty = min(qty, (l.usk+l.aut)/uskPerUnit)
+	}
+	qty = max(qty, 0)
+	used := labor_t{pro: qty * proPerUnit}
+	used.usk, used.aut = staffUnskilled(qty*uskPerUnit, l.usk, l.aut)
+	l.pro, l.usk, l.aut = l.pro-used.pro, l.usk-used.usk, l.aut-used.aut
+	return qty, used
+}
+
+// staffUnskilled splits the unskilled workers needed between the unskilled
+// workers and automation available. Automation covers the shortfall.
+func staffUnskilled[T int64 | float64](needed, usk, aut T) (uskUsed, autUsed T) {
+	uskUsed = max(min(needed, usk), 0)
+	autUsed = max(min(needed-uskUsed, aut), 0)
+	return uskUsed, autUsed
+}
+
+// automationLabor returns the number of unskilled workers replaced by
+// assembled automation units.
+func automationLabor(techLevel, qty int64) int64 {
+	return techLevel * techLevel * qty
+}
+
+// laborPerUnit returns the professionals and unskilled workers needed to
+// operate one unit in a group with nbrOfUnits units.
+func laborPerUnit(kind string, nbrOfUnits int64) (pro, usk int64) {
+	switch kind {
+	case "factory":
+		if nbrOfUnits < 5 {
+			return 6, 18
+		} else if nbrOfUnits < 50 {
+			return 5, 15
+		} else if nbrOfUnits < 500 {
+			return 4, 12
+		} else if nbrOfUnits < 5_000 {
+			return 3, 9
+		} else if nbrOfUnits < 50_000 {
+			return 2, 6
+		}
+		return 1, 3
+	case "farm", "mine":
+		return 1, 3
+	case "lab":
+		return 3, 1
+	}
+	return 0, 0
+}
@@ -0,0 +1,113 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package engine
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/playbymail/empyr/parsers/orders"
+	"github.com/playbymail/empyr/repos/sqlite"
+	"log"
+	"strings"
+)
+
+// LoadOrders parses an empire's orders file and stores the orders for the
+// turn so that the execute commands can find them.
+//
+// Orders that don't parse, or that are for ships and colonies the empire
+// doesn't own, are logged and skipped. Orders that the engine doesn't
+// store yet are ignored.
+func (e *Engine_t) LoadOrders(empireID, turnNo int64, input []byte) error {
+	// turn 0 is the setup turn. orders are executed from turn 1.
+	if turnNo < 1 {
+		return ErrGameNotStarted
+	}
+
+	lexemes, err := orders.Scan(input)
+	if err != nil {
+		return err
+	}
+	for _, order := range orders.Parse(lexemes) {
+		switch o := order.(type) {
+		case *orders.Jump:
+			if err := e.loadJumpOrder(empireID, turnNo, o); err != nil {
+				log.Printf("orders: empire %d: line %d: jump: %v\n", empireID, o.Line, err)
+			}
+		case *orders.Move:
+			if err := e.loadMoveOrder(empireID, turnNo, o); err != nil {
+				log.Printf("orders: empire %d: line %d: move: %v\n", empireID, o.Line, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// loadJumpOrder stores a jump order. The destination must name an orbit.
+// A system without a star suffix means the first star in the system.
+func (e *Engine_t) loadJumpOrder(empireID, turnNo int64, o *orders.Jump) error {
+	if len(o.Errors) != 0 {
+		return errors.Join(o.Errors...)
+	}
+	scID := int64(o.Id)
+	if err := e.checkOrderOwner(empireID, turnNo, scID); err != nil {
+		return err
+	}
+	if o.Location.Orbit == 0 {
+		return fmt.Errorf("location: orbit is required")
+	}
+	sequence := strings.ToUpper(o.Location.System)
+	if sequence == "" {
+		sequence = "A"
+	}
+	orbitID, err := e.Store.Queries.ReadOrbitByCoordinates(e.Store.Context, sqlite.ReadOrbitByCoordinatesParams{
+		X:        int64(o.Location.X),
+		Y:        int64(o.Location.Y),
+		Z:        int64(o.Location.Z),
+		Sequence: sequence,
+		OrbitNo:  int64(o.Location.Orbit),
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("location: no such orbit")
+	} else if err != nil {
+		return err
+	}
+	_, err = e.Store.CreateSCJumpOrder(scID, turnNo, orbitID)
+	return err
+}
+
+// loadMoveOrder stores a move order. The orbit is around the star that
+// the ship is at when the order is loaded. The order language has no way
+// to ask for the surface, so the move is always to the orbit.
+func (e *Engine_t) loadMoveOrder(empireID, turnNo int64, o *orders.Move) error {
+	if len(o.Errors) != 0 {
+		return errors.Join(o.Errors...)
+	}
+	scID := int64(o.Id)
+	if err := e.checkOrderOwner(empireID, turnNo, scID); err != nil {
+		return err
+	}
+	location, err := e.Store.Queries.ReadSCLocation(e.Store.Context, sqlite.ReadSCLocationParams{ScID: scID, AsOfDt: turnNo})
+	if err != nil {
+		return fmt.Errorf("ship %d: location: %w", scID, err)
+	}
+	orbitID, err := e.Store.Queries.ReadOrbitByStarOrbitNo(e.Store.Context, sqlite.ReadOrbitByStarOrbitNoParams{StarID: location.StarID, OrbitNo: int64(o.Orbit)})
+	if errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("orbit %d: no such orbit", o.Orbit)
+	} else if err != nil {
+		return err
+	}
+	_, err = e.Store.CreateSCMoveOrder(scID, turnNo, orbitID, false)
+	return err
+}
+
+// checkOrderOwner returns an error if the empire doesn't own the ship or
+// colony on the turn.
+func (e *Engine_t) checkOrderOwner(empireID, turnNo, scID int64) error {
+	owner, err := e.Store.Queries.ReadSCOwner(e.Store.Context, sqlite.ReadSCOwnerParams{ScID: scID, AsOfDt: turnNo})
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && owner.EmpireID != empireID) {
+		return fmt.Errorf("ship %d: not owned by empire", scID)
+	}
+	return err
+}
@@ -0,0 +1,101 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package engine
+
+import (
+	"math"
+	"sort"
+)
+
+// this file implements the rules for clearing the market.
+//
+// Ships and colonies give buy and sell orders for a quantity of a product,
+// which is a unit code and tech level, at a price in GOLD per unit. The
+// market is local: there is an order book for every product in every
+// orbit, and orders are matched only with orders in the same book.
+//
+// Buy orders are ranked by bid, highest first, and sell orders by ask,
+// lowest first. Ties go to the order that was given first. Each buy order,
+// in rank order, takes units from the sell orders in rank order until it
+// is filled or the next ask is higher than its bid. Each lot trades at the
+// midpoint of the bid and the ask, and the GOLD for the lot is rounded to
+// the nearest unit. A buyer never pays more in total than the GOLD it
+// committed to the order. A ship or colony may not trade with itself, and
+// ships and colonies of different empires may trade only if one empire has
+// granted the other trade rights in the orbit.
+//
+// Sellers must have the units in storage, and buyers must have the GOLD to
+// pay their bid for the whole order, when the market opens. Buyers must
+// also have the space to store the whole order. Units, GOLD, and space
+// committed to one order can't be used for another.
+
+// marketOrder_t is a buy or sell order in an order book.
+type marketOrder_t struct {
+	id       int64
+	scID     int64
+	empireID int64
+	qty      int64   // units ordered
+	price    float64 // bid or ask per unit
+	filled   int64   // units bought or sold
+	gold     int64   // GOLD paid or received
+}
+
+// marketTrade_t is a lot traded between a buy and a sell order.
+type marketTrade_t struct {
+	buy, sell *marketOrder_t
+	qty       int64
+	price     float64
+	gold      int64
+}
+
+// marketBid returns the GOLD a buyer must commit to an order.
+func marketBid(qty int64, bid float64) int64 {
+	return int64(math.Ceil(float64(qty) * bid))
+}
+
+// clearMarket matches the buy and sell orders in an order book and
+// returns the lots traded. The orders are updated with the units and GOLD
+// that changed hands. mayTrade reports whether the buyer and the seller
+// are allowed to trade with each other.
+func clearMarket(buys, sells []*marketOrder_t, mayTrade func(buy, sell *marketOrder_t) (bool, error)) ([]*marketTrade_t, error) {
+	sort.SliceStable(buys, func(i, j int) bool {
+		if buys[i].price == buys[j].price {
+			return buys[i].id < buys[j].id
+		}
+		return buys[i].price > buys[j].price
+	})
+	sort.SliceStable(sells, func(i, j int) bool {
+		if sells[i].price == sells[j].price {
+			return sells[i].id < sells[j].id
+		}
+		return sells[i].price < sells[j].price
+	})
+
+	var trades []*marketTrade_t
+	for _, buy := range buys {
+		for _, sell := range sells {
+			if buy.filled == buy.qty || sell.price > buy.price {
+				break
+			} else if sell.filled == sell.qty || sell.scID == buy.scID {
+				continue
+			}
+			if ok, err := mayTrade(buy, sell); err != nil {
+				return nil, err
+			} else if !ok {
+				continue
+			}
+			trade := &marketTrade_t{
+				buy:   buy,
+				sell:  sell,
+				qty:   min(buy.qty-buy.filled, sell.qty-sell.filled),
+				price: (buy.price + sell.price) / 2,
+			}
+			// rounding each lot must not take more than the buyer committed
+			trade.gold = min(int64(math.Round(float64(trade.qty)*trade.price)), marketBid(buy.qty, buy.price)-buy.gold)
+			buy.filled, buy.gold = buy.filled+trade.qty, buy.gold+trade.gold
+			sell.filled, sell.gold = sell.filled+trade.qty, sell.gold+trade.gold
+			trades = append(trades, trade)
+		}
+	}
+	return trades, nil
+}
@@ -35,6 +35,7 @@ type MineGroupInputs_t struct {
 	Usk        float64
 	Aut        float64
 	Fuel       float64
+	Power      float64
 	Ore        float64
 }
 
@@ -48,6 +49,7 @@ type MineGroupConstraints_t struct {
 	Usk        float64
 	Aut        float64
 	Fuel       float64
+	Power      float64
 	Ore        float64
 }
 
@@ -63,6 +65,7 @@ func (grp *MineGroup_t) Allocate(constraints MineGroupConstraints_t) MineGroupCo
 		constraints.Usk -= unit.Allocated.Usk
 		constraints.Aut -= unit.Allocated.Aut
 		constraints.Fuel -= unit.Allocated.Fuel
+		constraints.Power -= unit.Allocated.Power
 		constraints.Ore -= unit.Allocated.Ore
 	}
 	return constraints
@@ -89,9 +92,9 @@ func (unit *MineGroupUnit_t) Allocate(constraints MineGroupConstraints_t) *MineG
 	// calculate the maximum number of units that can be allocated based
 	// on the constraints (the available amount of fuel and labor and ore)
 	for changed := true; changed && constraints.NbrOfUnits > 0; changed = false {
-		// limit the maximum number of units to the amount of fuel available
-		if fuelPerUnit*constraints.NbrOfUnits > constraints.Fuel {
-			constraints.NbrOfUnits = math.Floor(constraints.Fuel / fuelPerUnit)
+		// limit the maximum number of units to the amount of fuel and power available
+		if fuelPerUnit*constraints.NbrOfUnits > constraints.Fuel+constraints.Power {
+			constraints.NbrOfUnits = math.Floor((constraints.Fuel + constraints.Power) / fuelPerUnit)
 			changed = true
 		}
 		// limit the maximum number of units to the amount of PRO available
@@ -121,12 +124,10 @@ func (unit *MineGroupUnit_t) Allocate(constraints MineGroupConstraints_t) *MineG
 	// allocate resources based on the maximum number of units that satisfy the constraints
 	allocated.NbrOfUnits = constraints.NbrOfUnits
 	allocated.Pro = constraints.NbrOfUnits * proPerUnit
-	// always allocate AUT before USK
-	allocated.Aut = math.Min(constraints.Aut, constraints.NbrOfUnits*uskPerUnit)
-	if allocated.Aut < constraints.NbrOfUnits*uskPerUnit {
-		allocated.Usk = constraints.NbrOfUnits*uskPerUnit - allocated.Aut
-	}
-	allocated.Fuel = constraints.NbrOfUnits * fuelPerUnit
+	// AUT covers any shortfall of USK
+	allocated.Usk, allocated.Aut = staffUnskilled(constraints.NbrOfUnits*uskPerUnit, constraints.Usk, constraints.Aut)
+	// always allocate power before fuel
+	allocated.Power, allocated.Fuel = powerAndFuel(constraints.NbrOfUnits*fuelPerUnit, constraints.Power)
 
 	return allocated
 }
@@ -141,6 +142,7 @@ func (unit *MineGroupUnit_t) Consume(allocated *MineGroupInputs_t) *MineGroupInp
 		Usk:        allocated.Usk,
 		Aut:        allocated.Aut,
 		Fuel:       allocated.Fuel,
+		Power:      allocated.Power,
 		Ore:        allocated.Ore,
 	}
 }
@@ -182,7 +184,8 @@ func (unit *MineGroupUnit_t) fuelRequiredPerUnit() (fuel float64) {
 
 // mines require 1 PRO and 3 USK per unit per turn
 func (unit *MineGroupUnit_t) laborRequiredPerUnit() (pro, usk float64) {
-	return float64(unit.NbrOfUnits), float64(unit.NbrOfUnits) * 3
+	p, u := laborPerUnit("mine", unit.NbrOfUnits)
+	return float64(p), float64(u)
 }
 
 // mines consume 100 mass units per technology level per unit per year.
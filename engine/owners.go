@@ -0,0 +1,54 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package engine
+
+import (
+	"github.com/playbymail/empyr/internal/domains"
+	"github.com/playbymail/empyr/repos/sqlite"
+)
+
+// this file implements helpers for changing the owner of ships and colonies.
+
+const (
+	ErrAlreadyTransferred = Error("already changed owner this turn")
+)
+
+// isColony returns true if the code is for a colony.
+func isColony(scCd string) bool {
+	switch scCd {
+	case "COPN", "CENC", "CORB":
+		return true
+	}
+	return false
+}
+
+// transferSC changes the owner of a ship or colony, effective on the turn.
+//
+// Ownership is effective-dated, so reports for earlier turns still show the
+// previous owner. The inventory, population, and factory groups belong to
+// the ship or colony, so everything in it passes to the new owner. A ship
+// or colony may change owner only once a turn.
+func (e *Engine_t) transferSC(q *sqlite.Queries, scID, empireID, turnNo int64) error {
+	row, err := q.ReadSCOwner(e.Store.Context, sqlite.ReadSCOwnerParams{ScID: scID, AsOfDt: turnNo})
+	if err != nil {
+		return err
+	} else if row.EmpireID == empireID {
+		return nil
+	} else if row.Effdt == turnNo {
+		return ErrAlreadyTransferred
+	}
+	err = q.UpdateSCOwnerEndDt(e.Store.Context, sqlite.UpdateSCOwnerEndDtParams{
+		Enddt: turnNo,
+		ScID:  scID,
+		Effdt: row.Effdt,
+	})
+	if err != nil {
+		return err
+	}
+	return q.CreateSCOwner(e.Store.Context, sqlite.CreateSCOwnerParams{
+		ScID:     scID,
+		Effdt:    turnNo,
+		Enddt:    domains.MaxGameTurnNo,
+		EmpireID: empireID,
+	})
+}
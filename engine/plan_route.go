@@ -0,0 +1,148 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package engine
+
+import (
+	"errors"
+	"github.com/playbymail/empyr/pkg/empyr"
+	"github.com/playbymail/empyr/repos/sqlite"
+	"log"
+)
+
+const (
+	ErrInvalidSystemID = Error("invalid system id")
+	ErrUnknownSystem   = Error("unknown system")
+)
+
+type PlanRouteParams_t struct {
+	ShipID   int64 // ship that will make the jumps
+	SystemID int64 // destination system
+	Cheapest bool  // when true, plan the route that needs the least fuel
+}
+
+// Route_t is a series of jumps between systems.
+type Route_t struct {
+	ShipID     int64
+	From       string // name of the system the ship starts in
+	To         string // name of the destination system
+	Legs       []*RouteLeg_t
+	Distance   float64 // total light years jumped
+	Fuel       float64 // total fuel needed
+	FuelOnHand float64
+}
+
+// RouteLeg_t is a single jump in a route.
+type RouteLeg_t struct {
+	From     string
+	To       string
+	Distance float64
+	Fuel     float64
+}
+
+// PlanRouteCommand plans a series of jumps that takes a ship from its current
+// system to the destination system. The default is the route with the fewest
+// jumps; set Cheapest to get the route that needs the least fuel.
+//
+// The jump rules are from pkg/empyr. The drive setup is built from the ship's
+// current inventory, so the route is only good as long as the ship's mass
+// and drives don't change. The route only goes through systems that the
+// ship's empire knows about; ErrUnknownSystem is returned if the destination
+// isn't one of them.
+//
+// If the ship doesn't carry enough fuel for the route, the route is returned
+// along with empyr.ErrInsufficientFuel.
+func PlanRouteCommand(e *Engine_t, cfg *PlanRouteParams_t) (*Route_t, error) {
+	gameRow, err := e.Store.Queries.ReadAllGameInfo(e.Store.Context)
+	if err != nil {
+		log.Printf("error: %v\n", err)
+		return nil, err
+	}
+	turnNo := gameRow.CurrentTurn
+
+	loc, err := e.Store.Queries.ReadSCLocation(e.Store.Context, sqlite.ReadSCLocationParams{ScID: cfg.ShipID, AsOfDt: turnNo})
+	if err != nil {
+		log.Printf("error: ship %d: location: %v\n", cfg.ShipID, err)
+		return nil, err
+	}
+	inventory, err := e.Store.Queries.ReadSCInventory(e.Store.Context, sqlite.ReadSCInventoryParams{ScID: cfg.ShipID, AsOfDt: turnNo})
+	if err != nil {
+		log.Printf("error: ship %d: inventory: %v\n", cfg.ShipID, err)
+		return nil, err
+	}
+	pro, err := e.readPopulationQty(e.Store.Queries, cfg.ShipID, "PRO", turnNo)
+	if err != nil {
+		log.Printf("error: ship %d: population: %v\n", cfg.ShipID, err)
+		return nil, err
+	}
+	owner, err := e.Store.Queries.ReadSCOwner(e.Store.Context, sqlite.ReadSCOwnerParams{ScID: cfg.ShipID, AsOfDt: turnNo})
+	if err != nil {
+		log.Printf("error: ship %d: owner: %v\n", cfg.ShipID, err)
+		return nil, err
+	}
+	knowledgeRows, err := e.Store.Queries.ReadAllEmpireStarKnowledge(e.Store.Context, sqlite.ReadAllEmpireStarKnowledgeParams{EmpireID: owner.EmpireID, AsOfDt: turnNo})
+	if err != nil {
+		log.Printf("error: empire %d: knowledge: %v\n", owner.EmpireID, err)
+		return nil, err
+	}
+	known := map[int64]bool{loc.SystemID: true}
+	for _, row := range knowledgeRows {
+		known[row.SystemID] = true
+	}
+	systemRows, err := e.Store.Queries.ReadAllSystems(e.Store.Context)
+	if err != nil {
+		log.Printf("error: %v\n", err)
+		return nil, err
+	}
+
+	// map the known systems to locations so that we can find the names for the legs
+	var from, to empyr.Location
+	var systems []empyr.Location
+	names := map[empyr.Location]string{}
+	route := &Route_t{ShipID: cfg.ShipID}
+	isSystem := false
+	for _, row := range systemRows {
+		if row.SystemID == cfg.SystemID {
+			isSystem = true
+		}
+		if !known[row.SystemID] {
+			continue
+		}
+		location := empyr.Location{X: int(row.X), Y: int(row.Y), Z: int(row.Z)}
+		systems = append(systems, location)
+		names[location] = row.SystemName
+		if row.SystemID == loc.SystemID {
+			from, route.From = location, row.SystemName
+		}
+		if row.SystemID == cfg.SystemID {
+			to, route.To = location, row.SystemName
+		}
+	}
+	if !isSystem {
+		return nil, ErrInvalidSystemID
+	} else if route.To == "" {
+		return nil, ErrUnknownSystem
+	}
+
+	ship := shipForJump(inventory, pro)
+	ship.Location.Current = from
+	route.FuelOnHand = ship.Fuel
+	kind := empyr.FewestJumps
+	if cfg.Cheapest {
+		kind = empyr.LeastFuel
+	}
+	plan, err := ship.PlanRoute(to, systems, kind)
+	if err != nil && !errors.Is(err, empyr.ErrInsufficientFuel) {
+		return nil, err
+	}
+	for _, leg := range plan.Legs {
+		route.Legs = append(route.Legs, &RouteLeg_t{
+			From:     names[leg.From],
+			To:       names[leg.To],
+			Distance: leg.Distance,
+			Fuel:     leg.Fuel,
+		})
+	}
+	route.Distance, route.Fuel = plan.Distance, plan.Fuel
+
+	return route, err
+}
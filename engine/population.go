@@ -0,0 +1,161 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package engine
+
+import (
+	"database/sql"
+	"errors"
+	"github.com/playbymail/empyr/internal/domains"
+	"github.com/playbymail/empyr/repos/sqlite"
+)
+
+// this file implements helpers for updating the population of ships and colonies.
+
+const (
+	ErrInsufficientPopulation = Error("insufficient population")
+)
+
+// readPopulationQty returns the number of people in a population group of
+// a ship or colony as of the turn.
+func (e *Engine_t) readPopulationQty(q *sqlite.Queries, scID int64, populationCd string, turnNo int64) (int64, error) {
+	row, err := q.ReadSCPopulationCode(e.Store.Context, sqlite.ReadSCPopulationCodeParams{
+		ScID:         scID,
+		PopulationCd: populationCd,
+		AsOfDt:       turnNo,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	} else if err != nil {
+		return 0, err
+	}
+	return row.Qty, nil
+}
+
+// adjustPopulation adds (or removes, if delta is negative) people from a
+// population group of a ship or colony.
+//
+// Population is effective-dated. The current entry is end-dated on the turn
+// and a new entry is created with the new quantity. If the entry was created
+// on this turn, it is updated in place. New groups are created with the base
+// pay rate and no rebels. Rebels are removed in proportion when the group
+// shrinks.
+func (e *Engine_t) adjustPopulation(q *sqlite.Queries, scID int64, populationCd string, turnNo, delta int64) error {
+	if delta == 0 {
+		return nil
+	}
+	row, err := q.ReadSCPopulationCode(e.Store.Context, sqlite.ReadSCPopulationCodeParams{
+		ScID:         scID,
+		PopulationCd: populationCd,
+		AsOfDt:       turnNo,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		if delta < 0 {
+			return ErrInsufficientPopulation
+		}
+		payRate, err := q.ReadPopulationBasePayRate(e.Store.Context, populationCd)
+		if err != nil {
+			return err
+		}
+		return q.CreateSCPopulation(e.Store.Context, sqlite.CreateSCPopulationParams{
+			ScID:         scID,
+			PopulationCd: populationCd,
+			Effdt:        turnNo,
+			Enddt:        domains.MaxGameTurnNo,
+			Qty:          delta,
+			PayRate:      payRate,
+		})
+	} else if err != nil {
+		return err
+	}
+	qty := row.Qty + delta
+	if qty < 0 {
+		return ErrInsufficientPopulation
+	}
+	rebelQty := row.RebelQty
+	if delta < 0 && row.Qty > 0 {
+		rebelQty = row.RebelQty * qty / row.Qty
+	}
+	return e.updatePopulation(q, scID, populationCd, turnNo, row, qty, rebelQty)
+}
+
+// adjustRebels turns loyal people into rebels (or, if delta is negative,
+// rebels back into loyal people) in the population groups of a ship or
+// colony. The change is shared out in proportion to the loyal people (or
+// rebels) in each group. It returns the number of people that changed.
+func (e *Engine_t) adjustRebels(q *sqlite.Queries, scID, turnNo, delta int64) (int64, error) {
+	if delta == 0 {
+		return 0, nil
+	}
+	population, err := q.ReadSCPopulation(e.Store.Context, sqlite.ReadSCPopulationParams{ScID: scID, AsOfDt: turnNo})
+	if err != nil {
+		return 0, err
+	}
+	weights := make([]int64, len(population))
+	for i, row := range population {
+		if delta > 0 {
+			weights[i] = row.Qty - row.RebelQty
+		} else {
+			weights[i] = row.RebelQty
+		}
+	}
+	var changed int64
+	for i, share := range shareOut(max(delta, -delta), weights) {
+		if share == 0 {
+			continue
+		}
+		row, err := q.ReadSCPopulationCode(e.Store.Context, sqlite.ReadSCPopulationCodeParams{
+			ScID:         scID,
+			PopulationCd: population[i].PopulationCd,
+			AsOfDt:       turnNo,
+		})
+		if err != nil {
+			return changed, err
+		}
+		rebelQty := row.RebelQty + share
+		if delta < 0 {
+			rebelQty = row.RebelQty - share
+		}
+		err = e.updatePopulation(q, scID, population[i].PopulationCd, turnNo, row, row.Qty, rebelQty)
+		if err != nil {
+			return changed, err
+		}
+		changed += share
+	}
+	return changed, nil
+}
+
+// updatePopulation sets the quantity and rebels of a population group.
+// Entries created on this turn are updated in place; otherwise, the
+// current entry is end-dated and a new one is created.
+func (e *Engine_t) updatePopulation(q *sqlite.Queries, scID int64, populationCd string, turnNo int64, row sqlite.ReadSCPopulationCodeRow, qty, rebelQty int64) error {
+	// entries created this turn are updated in place
+	if row.Effdt == turnNo {
+		return q.UpdateSCPopulationQty(e.Store.Context, sqlite.UpdateSCPopulationQtyParams{
+			Qty:          qty,
+			RebelQty:     rebelQty,
+			ScID:         scID,
+			PopulationCd: populationCd,
+			Effdt:        row.Effdt,
+		})
+	}
+
+	// otherwise, end-date the current entry and create a new one
+	err := q.UpdateSCPopulationEndDt(e.Store.Context, sqlite.UpdateSCPopulationEndDtParams{
+		Enddt:        turnNo,
+		ScID:         scID,
+		PopulationCd: populationCd,
+		Effdt:        row.Effdt,
+	})
+	if err != nil {
+		return err
+	}
+	return q.CreateSCPopulation(e.Store.Context, sqlite.CreateSCPopulationParams{
+		ScID:         scID,
+		PopulationCd: populationCd,
+		Effdt:        turnNo,
+		Enddt:        domains.MaxGameTurnNo,
+		Qty:          qty,
+		PayRate:      row.PayRate,
+		RebelQty:     rebelQty,
+	})
+}
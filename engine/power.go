@@ -0,0 +1,36 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package engine
+
+import "math"
+
+// this file implements the rules for power.
+//
+// Assembled power plants (PWP) produce TL power per turn. Power can't be
+// stored, so power that isn't used on a turn is lost. Factories, farms,
+// labs, and mines can run on power instead of fuel, one unit of power for
+// each unit of fuel. A ship or colony's power is shared by all its groups
+// and is always used before fuel.
+
+// powerProduced returns the power produced by assembled power plants.
+func powerProduced(techLevel, qty int64) int64 {
+	return techLevel * qty
+}
+
+// entityPower returns the power produced by the assembled power plants in
+// a ship or colony's inventory.
+func entityPower(sc *Entity_t) (power float64) {
+	for _, item := range sc.Inventory {
+		if item.Unit != nil && item.Unit.Code == "PWP" && item.IsAssembled {
+			power += float64(powerProduced(item.TechLevel, item.Qty))
+		}
+	}
+	return power
+}
+
+// powerAndFuel splits the energy needed to run units between the power
+// available and fuel. Power is used first.
+func powerAndFuel(energy, power float64) (powerUsed, fuelUsed float64) {
+	powerUsed = math.Max(math.Min(energy, power), 0)
+	return powerUsed, energy - powerUsed
+}
@@ -3,6 +3,7 @@
 package engine
 
 import (
+	"hash/fnv"
 	"math"
 	"math/rand/v2"
 	"sort"
@@ -32,6 +33,16 @@ func bellCurve(r *rand.Rand, mean, stdDev float64) float64 {
 	return r.NormFloat64()*stdDev + mean
 }
 
+// turnRand returns a random number generator that is seeded from the game
+// code and turn number. Executing the orders for a turn a second time (for
+// example, after resetting the turn results) produces the same values as
+// long as the orders are processed in the same sequence.
+func turnRand(gameCode string, turnNo int64) *rand.Rand {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(gameCode))
+	return rand.New(rand.NewPCG(h.Sum64(), uint64(turnNo)))
+}
+
 // normalRandInRange returns a random integer between min and max (inclusive) following
 // a normal distribution centered between the values. The function handles reversed
 // inputs and equal min/max values automatically.
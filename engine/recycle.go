@@ -0,0 +1,58 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package engine
+
+import "math"
+
+// this file implements the rules for recycling and scrapping units.
+//
+// Recycling breaks units down and returns recycleFraction of the metals
+// (METS) and non-metals (NMTS) it took to build them (see ResourcesToBuild)
+// to storage. Scrapping breaks units down and returns nothing. Resources
+// can't be recycled or scrapped.
+//
+// Units are taken from storage unless a factory or mine group is given.
+// Factories and mines are taken from their group and are assembled, so
+// they don't need to be stored first. Taking the last factories of a tech
+// level out of a group also breaks down their work in progress.
+//
+// Items in a factory group's work in progress can be recycled or scrapped
+// by giving the item the group is tooled for instead of a factory. They are
+// taken from the pipelines of the lowest tech level factories first and,
+// within a pipeline, from the most complete stage first. An item that is N%
+// complete returns N% of what a finished item would.
+
+const (
+	recycleFraction = 0.5 // fraction of the metals and non-metals returned by recycling a unit
+)
+
+// recycleMaterials returns the metals and non-metals returned by recycling
+// units that are pct percent complete.
+func recycleMaterials(unitCd string, techLevel, qty, pct int64) (mets, nmts int64) {
+	m, n := ResourcesToBuild(unitCd, max(techLevel, 1), qty)
+	fraction := recycleFraction * float64(pct) / 100
+	return int64(math.Floor(m * fraction)), int64(math.Floor(n * fraction))
+}
+
+// recycleGroupUnit returns the unit that works in a kind of group.
+func recycleGroupUnit(groupKind string) string {
+	switch groupKind {
+	case "factory":
+		return "FCT"
+	case "mine":
+		return "MIN"
+	}
+	return ""
+}
+
+// recyclePipeline takes up to qty items out of a pipeline, starting with
+// the most complete stage. wip holds the items that are 25%, 50%, and 75%
+// complete and is updated in place. It returns the number of items taken
+// from each stage.
+func recyclePipeline(wip *[3]int64, qty int64) (taken [3]int64) {
+	for i := 2; i >= 0 && qty > 0; i-- {
+		taken[i] = min(wip[i], qty)
+		wip[i], qty = wip[i]-taken[i], qty-taken[i]
+	}
+	return taken
+}
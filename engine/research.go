@@ -0,0 +1,59 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package engine
+
+import "math"
+
+// this file implements the rules for labs and research.
+//
+// Labs work in groups. Each lab needs 3 professionals, 1 unskilled worker,
+// and 0.5 x TL fuel or power to operate, and produces 0.25 research (RSCH)
+// per turn. Labs are staffed along with the ship or colony's other groups
+// (see labor.go) and fueled in group number order. Labs that can't be
+// staffed or fueled don't produce.
+//
+// Research is only stored in whole units. The fractions are not dropped;
+// the research for a turn is the whole units in the running total of all
+// the working labs in the ship or colony, less the whole units in the
+// total for the turn before. A single lab produces 1 research every 4th
+// turn.
+//
+// Research is spent to raise the tech level of the ship or colony that holds
+// it by one. Raising a ship or colony from tech level TL to TL+1 costs
+// researchPerTechLevel x TL² research. The highest tech level is 10.
+//
+// The tech level of a ship or colony limits the tech level of the items
+// that its factory groups can be tooled to manufacture.
+
+const (
+	researchPerLab       = 0.25  // research produced by one lab in a turn
+	researchPerTechLevel = 1_000 // multiplied by TL² to get the cost of the next tech level
+	researchMaxTechLevel = 10    // highest tech level that can be researched
+)
+
+// labsWorking returns the number of staffed labs, up to qty, that can be
+// fueled with the fuel and power available.
+func labsWorking(techLevel, qty, energy int64) int64 {
+	if fuelPerLab := unitFuel("LAB", techLevel, 1); fuelPerLab > 0 {
+		qty = min(qty, int64(float64(energy)/fuelPerLab))
+	}
+	return max(qty, 0)
+}
+
+// labFuel returns the fuel (or power) used by working labs.
+func labFuel(techLevel, qty int64) int64 {
+	return int64(math.Ceil(unitFuel("LAB", techLevel, qty)))
+}
+
+// labResearch returns the whole units of research produced on a turn by
+// the working labs in a ship or colony. The fraction left over is carried
+// to the following turns.
+func labResearch(qty, turnNo int64) int64 {
+	return int64(math.Floor(float64(qty*turnNo)*researchPerLab)) - int64(math.Floor(float64(qty*(turnNo-1))*researchPerLab))
+}
+
+// researchCost returns the research needed to raise a ship or colony from
+// its current tech level to the next one.
+func researchCost(techLevel int64) int64 {
+	return researchPerTechLevel * techLevel * techLevel
+}
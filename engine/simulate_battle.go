@@ -0,0 +1,181 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package engine
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	ErrInvalidBattleKind = Error("invalid battle kind")
+	ErrInvalidForce      = Error("invalid force")
+)
+
+// simulateBattleStream is the second seed for the random number generator.
+// It is fixed so that the same seed gives the same first battles no matter
+// how many runs are asked for.
+const simulateBattleStream = 0xcafedeed
+
+type SimulateBattleParams_t struct {
+	Kind     string // "bombard", "invade", or "raid"
+	Attacker string // force list, eg "SLD:20000,MTSP:50000,ASW-2:1000"
+	Defender string // force list
+	Runs     int    // number of battles to fight
+	Seed     uint64 // seed for the random number generator
+}
+
+// BattleOdds_t is the summary of a series of simulated battles.
+type BattleOdds_t struct {
+	Kind         string
+	Runs         int
+	AttackerWins int
+	WinPct       float64 // share of the battles won by the attacker
+	Rounds       float64 // average number of rounds fought
+	Attacker     *ExpectedLosses_t
+	Defender     *ExpectedLosses_t
+}
+
+// ExpectedLosses_t is the average losses of one side over a series of battles.
+type ExpectedLosses_t struct {
+	Factors  float64            // ground combat factors at the start of the battle
+	Units    map[string]float64 // units used or destroyed, keyed by code and tech level, eg "ASW-2"
+	Soldiers float64            // soldiers killed
+	MassPct  float64            // share of the total mass destroyed
+}
+
+// SimulateBattleCommand fights the same battle many times with a seeded
+// random number generator and returns the attacker's odds of winning and
+// the losses that each side should expect.
+//
+// Forces are given as a comma separated list of CODE-TL:QTY entries, using
+// the codes in the unit table. SLD is the number of soldiers. FUEL and
+// MTSP may be given without a tech level. MASS adds mass that doesn't take
+// part in the fight, such as the hull and cargo of a ship. Weapons and
+// shields are assumed to be assembled.
+func SimulateBattleCommand(cfg *SimulateBattleParams_t) (*BattleOdds_t, error) {
+	switch cfg.Kind {
+	case "bombard", "invade", "raid":
+	default:
+		return nil, fmt.Errorf("%q: %w", cfg.Kind, ErrInvalidBattleKind)
+	}
+	attacker, err := ParseCombatForce(cfg.Attacker)
+	if err != nil {
+		return nil, fmt.Errorf("attacker: %w", err)
+	}
+	defender, err := ParseCombatForce(cfg.Defender)
+	if err != nil {
+		return nil, fmt.Errorf("defender: %w", err)
+	}
+	runs := max(cfg.Runs, 1)
+
+	odds := &BattleOdds_t{
+		Kind:     cfg.Kind,
+		Runs:     runs,
+		Attacker: &ExpectedLosses_t{Units: map[string]float64{}},
+		Defender: &ExpectedLosses_t{Units: map[string]float64{}},
+	}
+	r := rand.New(rand.NewPCG(cfg.Seed, simulateBattleStream))
+	for n := 0; n < runs; n++ {
+		outcome := ResolveCombat(r, cfg.Kind, attacker, defender)
+		if outcome.AttackerWins {
+			odds.AttackerWins++
+		}
+		odds.Rounds += float64(outcome.Rounds)
+		odds.Attacker.add(outcome.Attacker)
+		odds.Defender.add(outcome.Defender)
+	}
+	odds.WinPct = float64(odds.AttackerWins) / float64(runs)
+	odds.Rounds /= float64(runs)
+	odds.Attacker.average(runs)
+	odds.Defender.average(runs)
+
+	return odds, nil
+}
+
+// Codes returns the codes of the units lost, sorted.
+func (l *ExpectedLosses_t) Codes() []string {
+	var codes []string
+	for code := range l.Units {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	return codes
+}
+
+func (l *ExpectedLosses_t) add(losses CombatLosses_t) {
+	l.Factors += losses.Factors
+	for _, byKey := range []map[CombatUnit_t]int64{losses.Expended, losses.Destroyed} {
+		for key, qty := range byKey {
+			l.Units[codeTL(key.Code, key.TechLevel)] += float64(qty)
+		}
+	}
+	l.Soldiers += float64(losses.Soldiers)
+	l.MassPct += losses.MassPct
+}
+
+func (l *ExpectedLosses_t) average(runs int) {
+	l.Factors /= float64(runs)
+	for code := range l.Units {
+		l.Units[code] /= float64(runs)
+	}
+	l.Soldiers /= float64(runs)
+	l.MassPct /= float64(runs)
+}
+
+// ParseCombatForce parses a force list, eg "SLD:20000,MTSP-1:50000,ASW-2:1000".
+// The mass of the force is the mass of the units in the list plus any MASS.
+func ParseCombatForce(list string) (*CombatForce_t, error) {
+	f := &CombatForce_t{Units: map[CombatUnit_t]int64{}}
+	for _, entry := range strings.Split(list, ",") {
+		if entry = strings.TrimSpace(entry); entry == "" {
+			continue
+		}
+		item, qtyText, ok := strings.Cut(entry, ":")
+		if !ok {
+			return nil, fmt.Errorf("%q: missing quantity: %w", entry, ErrInvalidForce)
+		}
+		qty, err := strconv.ParseInt(strings.ReplaceAll(qtyText, "_", ""), 10, 64)
+		if err != nil || qty < 0 {
+			return nil, fmt.Errorf("%q: invalid quantity: %w", entry, ErrInvalidForce)
+		}
+		code, tlText, hasTL := strings.Cut(strings.ToUpper(item), "-")
+		var techLevel int64
+		if hasTL {
+			techLevel, err = strconv.ParseInt(tlText, 10, 64)
+			if err != nil || techLevel < 1 || techLevel > 10 {
+				return nil, fmt.Errorf("%q: invalid tech level: %w", entry, ErrInvalidForce)
+			}
+		}
+		switch code {
+		case "MASS":
+			f.Mass += float64(qty)
+			continue
+		case "SLD":
+			f.Soldiers += qty
+			continue
+		}
+		if _, ok := unitTable[code]; !ok {
+			return nil, fmt.Errorf("%q: unknown unit: %w", entry, ErrInvalidForce)
+		}
+		switch code {
+		case "FUEL":
+			f.Fuel += qty
+			f.Mass += Mass(code, 0, qty)
+			continue
+		case "MTSP":
+			f.Supplies += qty
+			f.Mass += Mass(code, max(techLevel, 1), qty)
+			continue
+		}
+		if techLevel == 0 {
+			return nil, fmt.Errorf("%q: missing tech level: %w", entry, ErrInvalidForce)
+		}
+		f.Units[CombatUnit_t{Code: code, TechLevel: techLevel}] += qty
+		f.Mass += Mass(code, techLevel, qty)
+	}
+	return f, nil
+}
@@ -0,0 +1,118 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package engine
+
+import (
+	"github.com/playbymail/empyr/repos/sqlite"
+)
+
+// this file implements the service that assigns labor to the factory, farm,
+// lab, and mine groups of ships and colonies. see labor.go for the rules.
+
+// staffing_t is the labor assigned to all the groups of a ship or colony.
+type staffing_t struct {
+	available labor_t // labor at the start of the turn
+	left      labor_t // labor that wasn't assigned to any group
+	groups    []*groupStaffing_t
+}
+
+// groupStaffing_t is the labor assigned to a single group.
+type groupStaffing_t struct {
+	groupID int64
+	kind    string
+	groupNo int64
+	units   []*unitStaffing_t
+	wanted  labor_t // labor needed to staff every unit in the group
+	used    labor_t // labor assigned to the group
+}
+
+// unitStaffing_t is the labor assigned to the units in a group with a
+// single tech level.
+type unitStaffing_t struct {
+	techLevel  int64
+	nbrOfUnits int64
+	staffed    int64   // number of units that were fully staffed
+	used       labor_t // labor assigned to the staffed units
+}
+
+// group returns the labor assigned to a group, or nil if the ship or colony
+// doesn't have the group.
+func (s *staffing_t) group(groupID int64) *groupStaffing_t {
+	for _, group := range s.groups {
+		if group.groupID == groupID {
+			return group
+		}
+	}
+	return nil
+}
+
+// unit returns the labor assigned to the units in the group with a single
+// tech level, or nil if the group doesn't have any.
+func (g *groupStaffing_t) unit(techLevel int64) *unitStaffing_t {
+	for _, unit := range g.units {
+		if unit.techLevel == techLevel {
+			return unit
+		}
+	}
+	return nil
+}
+
+// shortage returns the professionals and unskilled workers that the group
+// needed but didn't get.
+func (g *groupStaffing_t) shortage() (pro, usk int64) {
+	return g.wanted.pro - g.used.pro, g.wanted.usk - g.used.usk - g.used.aut
+}
+
+// readStaffing assigns the professionals, unskilled workers, and automation
+// of a ship or colony to its groups as of the turn.
+func (e *Engine_t) readStaffing(q *sqlite.Queries, scID, turnNo int64) (*staffing_t, error) {
+	staffing := &staffing_t{}
+	var err error
+	if staffing.available.pro, err = e.readPopulationQty(q, scID, "PRO", turnNo); err != nil {
+		return nil, err
+	} else if staffing.available.usk, err = e.readPopulationQty(q, scID, "USK", turnNo); err != nil {
+		return nil, err
+	}
+	inventoryRows, err := q.ReadSCInventory(e.Store.Context, sqlite.ReadSCInventoryParams{ScID: scID, AsOfDt: turnNo})
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range inventoryRows {
+		if row.UnitCd == "AUT" && row.IsAssembled == 1 {
+			staffing.available.aut += automationLabor(row.UnitTechLevel, row.Qty)
+		}
+	}
+	staffing.left = staffing.available
+
+	groupRows, err := q.ReadSCGroupsByGroupNo(e.Store.Context, sqlite.ReadSCGroupsByGroupNoParams{ScID: scID, AsOfDt: turnNo})
+	if err != nil {
+		return nil, err
+	}
+	for _, groupRow := range groupRows {
+		group := &groupStaffing_t{
+			groupID: groupRow.GroupID,
+			kind:    groupRow.Kind,
+			groupNo: groupRow.GroupNo,
+		}
+		unitRows, err := q.ReadSCGroupUnitsByGroup(e.Store.Context, sqlite.ReadSCGroupUnitsByGroupParams{GroupID: groupRow.GroupID, AsOfDt: turnNo})
+		if err != nil {
+			return nil, err
+		}
+		for _, unitRow := range unitRows {
+			pro, usk := laborPerUnit(groupRow.Kind, unitRow.NbrOfUnits)
+			unit := &unitStaffing_t{
+				techLevel:  unitRow.TechLevel,
+				nbrOfUnits: unitRow.NbrOfUnits,
+			}
+			unit.staffed, unit.used = staffing.left.staff(unitRow.NbrOfUnits, pro, usk)
+			group.wanted.pro += unitRow.NbrOfUnits * pro
+			group.wanted.usk += unitRow.NbrOfUnits * usk
+			group.used.pro += unit.used.pro
+			group.used.usk += unit.used.usk
+			group.used.aut += unit.used.aut
+			group.units = append(group.units, unit)
+		}
+		staffing.groups = append(staffing.groups, group)
+	}
+	return staffing, nil
+}
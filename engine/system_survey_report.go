@@ -15,13 +15,17 @@ type SystemSurveyReport_t struct {
 }
 
 type SurveyReport_t struct {
-	ID       int64
-	SorCID   int64
-	Name     string // name of the system, eg "02/13/28A"
-	StarID   int64  // star ID, eg 1
-	OrbitID  int64
-	OrbitNo  int64
-	Deposits []*SurveyReportLine_t
+	ID            int64
+	SorCID        int64
+	Name          string // name of the system, eg "02/13/28A"
+	StarID        int64  // star ID, eg 1
+	OrbitID       int64
+	OrbitNo       int64
+	Habitability  int64
+	FarmlandInUse string
+	Population    string
+	Deposits      []*SurveyReportLine_t
+	Reason        string // set when the survey failed
 }
 
 type SurveyReportLine_t struct {
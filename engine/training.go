@@ -0,0 +1,53 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package engine
+
+// this file implements the rules for drafting and discharging people.
+//
+// A draft order takes unskilled workers (USK) and puts them into training.
+// The trainees (TRN) are paid the trainee rate while they train. When the
+// training is done, they join the population group for their profession and
+// are paid that group's rate. Training costs consumer goods (CNGD), paid
+// when the trainees are drafted. If the ship or colony has lost trainees
+// in the meantime, only the trainees that are left graduate.
+//
+// A discharge order returns construction workers, police, professionals,
+// soldiers, spies, or special agents to the unskilled workers. Discharges
+// take effect at once and don't cost anything.
+//
+// Every change is recorded as a new effective-dated population entry.
+
+// trainingRules_t is the cost and length of training for a profession.
+type trainingRules_t struct {
+	turns       int64 // number of turns before trainees graduate
+	cngdPerHead int64 // consumer goods used to train one person
+}
+
+// trainingRules is the training for each profession that can be drafted
+// or discharged.
+var trainingRules = map[string]trainingRules_t{
+	"CNW": {turns: 2, cngdPerHead: 1},
+	"PLC": {turns: 2, cngdPerHead: 1},
+	"PRO": {turns: 4, cngdPerHead: 2},
+	"SAG": {turns: 8, cngdPerHead: 5},
+	"SLD": {turns: 2, cngdPerHead: 1},
+	"SPY": {turns: 4, cngdPerHead: 4},
+}
+
+// isProfession returns true if people can be drafted into or discharged
+// from the population group.
+func isProfession(populationCd string) bool {
+	_, ok := trainingRules[populationCd]
+	return ok
+}
+
+// trainingCost returns the consumer goods used to train qty people.
+func trainingCost(populationCd string, qty int64) int64 {
+	return trainingRules[populationCd].cngdPerHead * qty
+}
+
+// isTrained returns true if people drafted on draftDt have finished their
+// training by turnNo.
+func isTrained(populationCd string, draftDt, turnNo int64) bool {
+	return turnNo-draftDt >= trainingRules[populationCd].turns
+}
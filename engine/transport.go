@@ -0,0 +1,104 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package engine
+
+import "math"
+
+// this file implements the rules for transports.
+//
+// Transfers between ships and colonies at the same location are carried by
+// the transports (TPT) of the sending ship or colony. Each transport carries
+// 20 x TL² mass per turn and needs 1 professional for every 10 transports;
+// transports without a crew don't carry anything. People have a mass of
+// transportMassPerPerson.
+//
+// A transport uses 0.1 x TL² fuel to carry its full load, so every tech
+// level uses 1 unit of fuel for every transportMassPerFuel units of mass
+// carried. Fuel is taken from the sender's storage.
+//
+// Transfers are carried in the order they were given. When the transports
+// run out of capacity or fuel, as much of the cargo as fits is moved and
+// the rest is queued for the next turn.
+
+const (
+	transportMassPerFuel   = 200 // mass carried for each unit of fuel
+	transportMassPerPerson = 1   // mass of one person
+)
+
+// transport_t is the transport capacity of a ship or colony.
+type transport_t struct {
+	capacity float64 // mass the crewed transports can carry in a turn
+	used     float64 // mass carried this turn
+	fuel     int64   // fuel in storage
+}
+
+// addTransports adds transports with a single tech level, crewing as many
+// as the professionals allow. It returns the number of professionals used.
+func (t *transport_t) addTransports(techLevel, qty, pro int64) int64 {
+	qty = min(qty, pro*10)
+	t.capacity += transportCapacity(techLevel, qty)
+	return transportCrew(qty)
+}
+
+// available returns the mass that the transports can still carry.
+func (t *transport_t) available() float64 {
+	return max(t.capacity-t.used, 0)
+}
+
+// fit returns the number of units, up to qty, with the given mass per unit
+// that the transports can carry with the capacity and fuel that is left.
+// isFuel is true if the cargo is fuel taken from the same storage.
+func (t *transport_t) fit(qty int64, massPerUnit float64, isFuel bool) int64 {
+	if massPerUnit > 0 {
+		qty = min(qty, int64(t.available()/massPerUnit))
+	}
+	fuelPerUnit := massPerUnit / transportMassPerFuel
+	if isFuel {
+		fuelPerUnit++
+	}
+	if fuelPerUnit > 0 {
+		qty = min(qty, int64(float64(t.fuel)/fuelPerUnit))
+	}
+	// the fuel used is rounded up, so the last unit may not fit
+	for qty > 0 && t.fuelNeeded(qty, massPerUnit, isFuel) > t.fuel {
+		qty--
+	}
+	return max(qty, 0)
+}
+
+// fuelNeeded returns the fuel needed to carry the cargo, including the
+// cargo itself if it is fuel.
+func (t *transport_t) fuelNeeded(qty int64, massPerUnit float64, isFuel bool) int64 {
+	fuel := transportFuel(float64(qty) * massPerUnit)
+	if isFuel {
+		fuel += qty
+	}
+	return fuel
+}
+
+// carry records the cargo carried and returns the fuel used by the
+// transports. If the cargo is fuel, it is also taken out of the fuel left.
+func (t *transport_t) carry(qty int64, massPerUnit float64, isFuel bool) int64 {
+	mass, fuel := float64(qty)*massPerUnit, transportFuel(float64(qty)*massPerUnit)
+	t.used, t.fuel = t.used+mass, t.fuel-fuel
+	if isFuel {
+		t.fuel -= qty
+	}
+	return fuel
+}
+
+// transportCapacity returns the mass that transports can carry in a turn.
+func transportCapacity(techLevel, qty int64) float64 {
+	return float64(20 * techLevel * techLevel * qty)
+}
+
+// transportCrew returns the number of professionals needed to crew
+// transports.
+func transportCrew(qty int64) int64 {
+	return (qty + 9) / 10
+}
+
+// transportFuel returns the fuel used to carry cargo with the given mass.
+func transportFuel(mass float64) int64 {
+	return int64(math.Ceil(mass / transportMassPerFuel))
+}
@@ -12,6 +12,34 @@ type TurnReport_t struct {
 	Ships    []*ShipReport_t   // list of ships sorted by ID
 	Surveys  []*SurveyReport_t // list of surveys sorted by ID
 
+	Battles      []*CombatReport_t     // attacks by or against the empire, sorted by target
+	CombatLosses []*CombatLossReport_t // units and population lost in combat, sorted by ID
+
+	Espionage     []*EspionageReport_t // missions by or against the empire, sorted by target
+	StolenReports []*StolenReport_t    // colony reports stolen by the empire's spies
+
+	MarketOrders []*MarketOrderReport_t // buy and sell orders given by the empire, sorted by ID
+	MarketPrices []*MarketPriceReport_t // prices published for the turn, sorted by product
+
+	Rights      []*PermissionReport_t      // rights granted by or to the empire, sorted by location
+	RightOrders []*PermissionOrderReport_t // grant and revoke orders given by the empire
+
+	News       []*NewsReport_t      // items in the Galactic News the empire can observe
+	NewsOrders []*NewsOrderReport_t // news orders given by the empire
+
+	NameOrders []*NameOrderReport_t // name orders given by the empire
+
+	Setups     []*SetupReport_t    // setup orders given by the empire's ships and colonies
+	Assemblies []*AssemblyReport_t // assemble and store orders given by the empire's ships and colonies
+	Labs       []*LabReport_t      // research produced by the empire's lab groups
+	Research   []*ResearchReport_t // research orders given by the empire's ships and colonies
+	Retools    []*RetoolReport_t   // retool orders given by the empire's ships and colonies
+	Recycles   []*RecycleReport_t  // recycle and scrap orders given by the empire's ships and colonies
+	Transfers  []*TransferReport_t // transfer orders given by the empire's ships and colonies
+	Drafts     []*DraftReport_t    // draft and discharge orders given by the empire's ships and colonies
+
+	KnownStars []*KnownStarReport_t // stars the empire has observed, sorted by name
+
 	CreatedDate     string // date the report was created
 	CreatedDateTime string // date and time the report was created
 }
@@ -22,6 +50,7 @@ type ReportHeading_t struct {
 	TurnCode   string // display for the turn number, eg "T00001"
 	EmpireNo   int64  // empire number, eg 1
 	EmpireCode string // display for the empire, eg "E001"
+	EmpireName string // name of the empire, eg "Galactic Federation"
 }
 
 type ColonyReport_t struct {
@@ -36,6 +65,7 @@ type ColonyReport_t struct {
 	Census             *ColonyCensusReport_t
 	Other              *ColonyOtherReport_t
 	Transports         *ColonyTransportReport_t
+	Labor              *ColonyLaborReport_t
 	Inventory          []*ColonyInventoryLine_t
 	ProductionConsumed []*ProductionConsumedLine_t
 	ProductionCreated  []*ProductionCreatedLine_t
@@ -62,14 +92,19 @@ type ColonyCensusReport_t struct {
 }
 
 type ColonyOtherReport_t struct {
-	TotalMass       string // total mass, eg "1,000,000"
-	TotalVolume     string // total space capacity, eg "1,000,000"
-	AvailableVolume string // space available, eg "1,000,000"
+	TotalMass            string // total mass, eg "1,000,000"
+	TotalVolume          string // total space capacity, eg "1,000,000"
+	AvailableVolume      string // space available, eg "1,000,000"
+	LifeSupport          string // people supported by life support, eg "1,000,000"
+	LifeSupportAvailable string // life support available, eg "1,000,000"
+	Power                string // power produced by power plants, eg "1,000"
+	PowerAvailable       string // power not used by groups, eg "1,000"
 }
 
 type ProductionConsumedLine_t struct {
 	Category  string // Farming, Mining, etc
 	Fuel      string // used, eg "1,000,000"
+	Power     string // used, eg "1,000,000"
 	Gold      string // used, eg "1,000,000"
 	Metals    string // used, eg "1,000,000"
 	NonMetals string // used, eg "1,000,000"
@@ -88,6 +123,26 @@ type ColonyTransportReport_t struct {
 	Available string // transport available, eg "1,000,000"
 }
 
+type ColonyLaborReport_t struct {
+	Groups       []*ColonyLaborGroupReport_t
+	ProAvailable string // professionals available, eg "1,000,000"
+	ProUnused    string // professionals not assigned to a group, eg "1,000,000"
+	UskAvailable string // unskilled workers available, eg "1,000,000"
+	UskUnused    string // unskilled workers not assigned to a group, eg "1,000,000"
+	AutAvailable string // unskilled workers replaced by automation, eg "1,000,000"
+	AutUnused    string // automation not assigned to a group, eg "1,000,000"
+}
+
+type ColonyLaborGroupReport_t struct {
+	Group     string // display for the group, eg "farm 01"
+	ProWanted string // professionals needed, eg "1,000"
+	ProUsed   string // professionals assigned, eg "1,000"
+	UskWanted string // unskilled workers needed, eg "1,000"
+	UskUsed   string // unskilled workers assigned, eg "1,000"
+	AutUsed   string // unskilled workers replaced by automation, eg "1,000"
+	Shortage  string // workers the group is short, eg "100 PRO, 300 USK" (empty if fully staffed)
+}
+
 type ColonyInventoryLine_t struct {
 	Code            string // inventory code, eg "FOOD"
 	NonAssemblyQty  string // quantity, eg "1,000,000"
@@ -144,8 +199,36 @@ type ColonySpyReport_t struct {
 }
 
 type ShipReport_t struct {
-	Id     int64
-	IdCode string // display for the colony, eg "SS-1"
+	Id          int64
+	IdCode      string // display for the colony, eg "SS-1"
+	Name        string // name of the ship, eg "Ship 1"
+	Coordinates string // display for the system, eg "02/13/28A"
+	OrbitNo     int64
+	IsOnSurface bool
+
+	Movement []*ShipMovementReport_t
+	Jumps    []*ShipJumpReport_t
+}
+
+type ShipJumpReport_t struct {
+	From     string // display for the starting location, eg "02/13/28A #3"
+	To       string // display for the ending location, eg "03/14/28A #1"
+	Distance string // distance jumped in light years, eg "2.45"
+	Mass     string // total mass jumped, eg "1,000,000"
+	Engines  string // number of hyper engines used, eg "1,000"
+	Fuel     string // fuel used, eg "1,000"
+	Status   string // status of the jump, eg "jumped" or "failed"
+	Reason   string // reason the jump failed, if it failed
+}
+
+type ShipMovementReport_t struct {
+	From   string // display for the starting location, eg "02/13/28A #3"
+	To     string // display for the ending location, eg "02/13/28A #4 (surface)"
+	Mass   string // total mass moved, eg "1,000,000"
+	Thrust string // thrust available, eg "1,000,000"
+	Fuel   string // fuel used, eg "1,000"
+	Status string // status of the move, eg "moved" or "failed"
+	Reason string // reason the move failed, if it failed
 }
 
 type PopulationReport_t struct {
@@ -157,3 +240,233 @@ type PopulationReport_t struct {
 	TotalPay    string // total pay, eg "1,000,000"
 	qty         int64
 }
+
+type KnownStarReport_t struct {
+	Name         string // display for the star, eg "02/13/28A" or "02/13/28A (Home)"
+	NbrOfOrbits  string // number of orbits, eg "10" (empty if the star hasn't been probed)
+	LastObserved int64  // turn the star was last observed
+	Orbits       []*KnownOrbitReport_t
+}
+
+type KnownOrbitReport_t struct {
+	OrbitNo      int64
+	Kind         string   // kind of orbit, eg "terrestrial"
+	Fuel         int64    // estimated fuel, log10
+	Gold         int64    // estimated gold, log10
+	Mets         int64    // estimated metals, log10
+	Nmts         int64    // estimated non-metals, log10
+	Deposits     int64    // number of deposits known from surveys
+	ForeignSCs   []string // display for foreign ships and colonies, eg "CC-12 (E004)"
+	LastObserved int64    // turn the orbit was last observed
+}
+
+type CombatReport_t struct {
+	ScID       int64  // ship or colony that gave the order
+	Kind       string // kind of order, eg "bombard" or "support-defend"
+	TargetID   int64  // ship or colony that was attacked
+	IsDefender bool   // true if the target belongs to the empire
+	Committed  string // share of the forces committed, eg "50%"
+	Rounds     int64  // number of rounds fought
+	Destroyed  string // share of the enemy mass destroyed, eg "12.5%"
+	Status     string // status of the order, eg "won", "lost", or "failed"
+	Reason     string // reason the order failed, or that the colony was captured
+}
+
+type CombatLossReport_t struct {
+	ScID int64
+	Code string // unit or population code, eg "ASW-2" or "SLD"
+	Qty  string // quantity lost, eg "1,000"
+}
+
+// EspionageReport_t is the outcome of an espionage mission. Missions by
+// foreign agents are reported only if some of the agents were caught or
+// counter-agents were killed, and without the details the defender can't
+// know.
+type EspionageReport_t struct {
+	ScID        string // ship or colony that ran the mission, or "unknown" for foreign agents
+	Kind        string // mission, eg "check-rebels" or "incite-rebels"
+	TargetID    int64  // ship or colony the mission was run against
+	IsDefender  bool   // true if the mission was run against the empire by foreign agents
+	Agents      string // agents sent, eg "1,000" (empty for foreign agents)
+	Lost        string // agents caught or killed, eg "12"
+	CounterLost string // counter-agents killed, eg "3"
+	Effect      string // outcome of the mission, eg "about 1,200 rebels"
+	Status      string // status of the mission, eg "succeeded" or "failed"
+	Reason      string // reason the mission failed, if it failed
+}
+
+// StolenReport_t is a copy of another empire's colony report that was
+// stolen by spies. The sections and lines the spies didn't see are
+// removed from the copy.
+type StolenReport_t struct {
+	ScID        int64  // ship or colony that ran the mission
+	TargetID    int64  // ship or colony the report was stolen from
+	Coordinates string // display for the system, eg "02/13/28A"
+	OrbitNo     int64
+	Agents      string // spies that got through, eg "25"
+	Colony      *ColonyReport_t
+}
+
+// PermissionReport_t is a colonize or trade right granted in an orbit.
+type PermissionReport_t struct {
+	Kind        string // kind of right, eg "colonize" or "trade"
+	Coordinates string // display for the system, eg "02/13/28A"
+	OrbitNo     int64
+	GrantedBy   int64 // empire that granted the right
+	GrantedTo   int64 // empire that received the right
+	IsReceived  bool  // true if the right was granted to the empire
+	Since       int64 // turn the right was granted
+}
+
+// PermissionOrderReport_t is the outcome of a grant or revoke order.
+type PermissionOrderReport_t struct {
+	Action      string // "grant" or "revoke"
+	Kind        string // kind of right, eg "colonize" or "trade"
+	Coordinates string // display for the system, eg "02/13/28A"
+	OrbitNo     int64
+	GranteeID   int64  // empire the right was granted to or revoked from
+	Status      string // status of the order, eg "succeeded" or "failed"
+	Reason      string // reason the order failed, if it failed
+}
+
+// MarketOrderReport_t is the outcome of a buy or sell order.
+type MarketOrderReport_t struct {
+	ScID      int64
+	Kind      string // "buy" or "sell"
+	Code      string // product, eg "FOOD" or "FCT-1"
+	Qty       string // units ordered, eg "1,000"
+	Price     string // bid or ask per unit, eg "2.50"
+	FilledQty string // units bought or sold, eg "750"
+	GoldQty   string // GOLD paid or received, eg "1,875"
+	Status    string // status of the order, eg "succeeded" or "failed"
+	Reason    string // reason the order failed or was partly filled
+}
+
+// MarketPriceReport_t is the price of a product on the market. Prices are
+// "-" if there was nothing to report.
+type MarketPriceReport_t struct {
+	Location  string // orbit of the market, eg "02/13/28A Orbit # 3"
+	Code      string // product, eg "FOOD" or "FCT-1"
+	TradedQty string // units traded, eg "1,000"
+	LowPrice  string // lowest price paid per unit, eg "2.25"
+	HighPrice string // highest price paid per unit, eg "2.75"
+	AvgPrice  string // average price paid per unit, eg "2.50"
+	BestBid   string // highest unfilled bid, eg "2.00"
+	BestAsk   string // lowest unfilled ask, eg "3.00"
+}
+
+// NewsReport_t is an item in the Galactic News.
+type NewsReport_t struct {
+	Location  string // where the item was posted, eg "02/13/28 Orbit # 3", or "All Empires"
+	Article   string
+	Signature string
+}
+
+// NewsOrderReport_t is the outcome of a news order.
+type NewsOrderReport_t struct {
+	Location string // where the item was posted, eg "02/13/28 Orbit # 3"
+	Status   string // status of the order, eg "succeeded" or "failed"
+	Reason   string // reason the order failed, if it failed
+}
+
+// NameOrderReport_t is the outcome of a name order.
+type NameOrderReport_t struct {
+	Kind   string // what was named, eg "empire", "system", "star", or "sc"
+	Target string // display for what was named, eg "E001", "02/13/28A", or "S/C # 12"
+	Name   string // name that was given
+	Status string // status of the order, eg "succeeded" or "failed"
+	Reason string // reason the order failed, if it failed
+}
+
+// SetupReport_t is the outcome of a setup order.
+type SetupReport_t struct {
+	ScID        int64  // parent ship or colony
+	Kind        string // "colony" or "ship"
+	Coordinates string // display for the system, eg "02/13/28A"
+	OrbitNo     int64
+	IdCode      string // display for the new ship or colony, eg "CC-12" (empty if the order failed)
+	Status      string // status of the order, eg "succeeded" or "failed"
+	Reason      string // reason the order failed, if it failed
+}
+
+// AssemblyReport_t is the outcome of an assemble or store order.
+type AssemblyReport_t struct {
+	ScID       int64  // ship or colony that gave the order
+	Kind       string // "assemble" or "store"
+	Unit       string // display for the unit, eg "FCT-1"
+	GroupNo    string // display for the group, eg "01" (empty if the units aren't in a group)
+	OrderedQty string // number of units ordered, eg "1,000"
+	Qty        string // number of units assembled or stored, eg "1,000"
+	CnwUsed    string // number of construction workers used, eg "1,000"
+	Status     string // status of the order, eg "succeeded" or "failed"
+	Reason     string // reason the order failed or was only partly executed
+}
+
+// LabReport_t is the research produced by a lab group.
+type LabReport_t struct {
+	ScID         int64  // ship or colony that owns the group
+	GroupNo      string // display for the group, eg "01"
+	ProUsed      string // number of professionals used, eg "1,000"
+	UskUsed      string // number of unskilled workers used, eg "1,000"
+	FuelUsed     string // fuel used, eg "1,000"
+	PowerUsed    string // power used, eg "1,000"
+	RschProduced string // research produced, eg "1,000"
+}
+
+// ResearchReport_t is the outcome of a research order.
+type ResearchReport_t struct {
+	ScID          int64  // ship or colony that gave the order
+	FromTechLevel int64  // tech level before the order
+	ToTechLevel   int64  // tech level after the order
+	RschUsed      string // research used, eg "1,000"
+	Status        string // status of the order, eg "succeeded" or "failed"
+	Reason        string // reason the order failed, if it failed
+}
+
+// RetoolReport_t is the outcome of a retool order.
+type RetoolReport_t struct {
+	ScID    int64  // ship or colony that gave the order
+	GroupNo string // display for the factory group, eg "01"
+	Item    string // display for the new item, eg "FCT-1"
+	Status  string // status of the order, eg "succeeded" or "failed"
+	Reason  string // reason the order failed, if it failed
+}
+
+// RecycleReport_t is the outcome of a recycle or scrap order.
+type RecycleReport_t struct {
+	ScID         int64  // ship or colony that gave the order
+	Kind         string // "recycle" or "scrap"
+	Group        string // display for the group, eg "factory 01" (empty if the units were in storage)
+	Unit         string // display for the unit, eg "FCT-1"
+	OrderedQty   string // number of units ordered, eg "1,000"
+	Qty          string // number of units broken down, eg "1,000"
+	MetsReturned string // metals returned to storage, eg "1,000"
+	NmtsReturned string // non-metals returned to storage, eg "1,000"
+	Status       string // status of the order, eg "succeeded" or "failed"
+	Reason       string // reason the order failed, if it failed
+}
+
+// TransferReport_t is the outcome of a transfer order.
+type TransferReport_t struct {
+	ScID       int64  // ship or colony that gave the order
+	TargetID   int64  // ship or colony receiving the cargo
+	Cargo      string // display for the cargo, eg "FUEL" or "PRO"
+	OrderedQty string // number of units or people ordered, eg "1,000"
+	Qty        string // number of units or people transferred, eg "1,000"
+	FuelUsed   string // fuel used by the transports, eg "1,000"
+	Status     string // status of the order, eg "succeeded", "queued", or "failed"
+	Reason     string // reason the order failed or was queued
+}
+
+// DraftReport_t is the outcome of a draft or discharge order, or of the
+// trainees from a draft order graduating.
+type DraftReport_t struct {
+	ScID         int64  // ship or colony that gave the order
+	Kind         string // kind of order, eg "draft" or "discharge"
+	PopulationCd string // profession drafted into or discharged from, eg "PRO"
+	OrderedQty   string // number of people ordered, eg "1,000"
+	Qty          string // number of people moved or graduated, eg "1,000"
+	CngdUsed     string // consumer goods used to train the people, eg "1,000"
+	Status       string // status of the order, eg "succeeded", "failed", or "graduated"
+	Reason       string // reason the order failed
+}
@@ -127,6 +127,7 @@ func mineFuel(sc *Entity_t, techLevel, quantity int64) (fuel float64) {
 // 1. farms on orbiting colonies within the fifth orbit require no fuel; they use solar power.
 // 2. factories on orbiting colonies within the fifth orbit require no fuel; they use solar power.
 // 3. hyper engines use 40 fuel per light year jumped; only the engines needed for the jump require fuel.
+// Factories, farms, labs, and mines can use power instead of fuel; see power.go.
 func unitFuel(code string, techLevel, quantity int64) (fuel float64) {
 	tl, qty := float64(techLevel), float64(quantity)
 	switch code {
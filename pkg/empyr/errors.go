@@ -9,4 +9,5 @@ const (
 	ErrDistanceExceedsCapacity = cerrors.Error("distance exceeds capacity")
 	ErrInsufficientFuel        = cerrors.Error("insufficient fuel")
 	ErrMassExceedsCapacity     = cerrors.Error("mass exceeds capacity")
+	ErrNoRoute                 = cerrors.Error("no route")
 )
@@ -2,6 +2,8 @@
 
 package empyr
 
+import "math"
+
 type JumpDrive = HyperEngine
 
 type HyperEngine struct {
@@ -10,3 +12,21 @@ type HyperEngine struct {
 	Mass      float64 // total mass, in tonnes
 	Volume    float64 // total volume, in cubic meters
 }
+
+// HyperEngineLift returns the mass, in tonnes, that a single hyper engine can lift.
+// Each engine can lift 1,045 x TL tonnes.
+func HyperEngineLift(techLevel int) float64 {
+	return 1_045 * float64(techLevel)
+}
+
+// HyperEngineRange returns the maximum distance, in light years, of a single jump.
+// The range is 3 x √TL light years.
+func HyperEngineRange(techLevel int) float64 {
+	return 3 * math.Sqrt(float64(techLevel))
+}
+
+// HyperEngineFuel returns the fuel consumed by the given number of engines
+// for a jump. Each engine consumes 40 units of fuel per light year jumped.
+func HyperEngineFuel(quantity int, distance float64) float64 {
+	return 40 * distance * float64(quantity)
+}
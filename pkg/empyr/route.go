@@ -0,0 +1,153 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package empyr
+
+import (
+	"fmt"
+	"math"
+)
+
+// RouteKind selects the cost used when planning a route.
+type RouteKind int
+
+const (
+	// FewestJumps plans the route with the fewest jumps (and so the fewest turns).
+	// Ties are broken by the fuel needed.
+	FewestJumps RouteKind = iota
+	// LeastFuel plans the route that needs the least fuel.
+	// Ties are broken by the number of jumps.
+	LeastFuel
+)
+
+// RouteLeg is a single jump in a route.
+type RouteLeg struct {
+	From     Location
+	To       Location
+	Distance float64 // light years
+	Fuel     float64 // fuel needed for the jump
+}
+
+// Route is a series of jumps between systems.
+type Route struct {
+	Legs     []RouteLeg
+	Distance float64 // total light years jumped
+	Fuel     float64 // total fuel needed
+}
+
+// PlanRoute returns a series of jumps from the ship's current location to the
+// destination. Every leg must end at one of the given systems and must be
+// within the range of the ship's jump drives.
+//
+// The jump rules are the same as Jump. The fuel for each leg is rounded up,
+// as it is when the jump is made. The mass of the ship is assumed not to
+// change during the trip, so the estimate of fuel is slightly high.
+//
+// If the route needs more fuel than the ship carries, the route is returned
+// along with ErrInsufficientFuel.
+func (s Ship) PlanRoute(to Location, systems []Location, kind RouteKind) (Route, error) {
+	drives := s.getJumpDrives()
+	needed := s.JumpDrivesNeeded()
+	if drives.Quantity == 0 || needed > drives.Quantity {
+		return Route{}, fmt.Errorf("jump-drive: %w", ErrMassExceedsCapacity)
+	}
+	maxDistance := HyperEngineRange(drives.TechLevel)
+
+	// nodes are the systems plus the starting location (if it isn't a system)
+	from := s.Location.Current
+	nodes := []Location{from}
+	for _, system := range systems {
+		if system != from {
+			nodes = append(nodes, system)
+		}
+	}
+	target := -1
+	for n, node := range nodes {
+		if node == to {
+			target = n
+			break
+		}
+	}
+	if target == -1 {
+		return Route{}, ErrNoRoute
+	} else if target == 0 {
+		return Route{}, nil
+	}
+
+	// dijkstra's algorithm; the cluster is small enough that a linear scan
+	// for the next node is fine.
+	type cost_t struct {
+		jumps int
+		fuel  float64
+	}
+	less := func(a, b cost_t) bool {
+		if kind == LeastFuel {
+			if a.fuel != b.fuel {
+				return a.fuel < b.fuel
+			}
+			return a.jumps < b.jumps
+		}
+		if a.jumps != b.jumps {
+			return a.jumps < b.jumps
+		}
+		return a.fuel < b.fuel
+	}
+	costs := make([]cost_t, len(nodes))
+	reached := make([]bool, len(nodes))
+	done := make([]bool, len(nodes))
+	prev := make([]int, len(nodes))
+	for n := range nodes {
+		prev[n] = -1
+	}
+	reached[0] = true
+	for {
+		current := -1
+		for n := range nodes {
+			if reached[n] && !done[n] && (current == -1 || less(costs[n], costs[current])) {
+				current = n
+			}
+		}
+		if current == -1 || current == target {
+			break
+		}
+		done[current] = true
+		for n, node := range nodes {
+			if done[n] {
+				continue
+			}
+			distance := nodes[current].DistanceFrom(node)
+			if distance > maxDistance {
+				continue
+			}
+			cost := cost_t{jumps: costs[current].jumps + 1, fuel: costs[current].fuel + legFuel(needed, distance)}
+			if !reached[n] || less(cost, costs[n]) {
+				reached[n], costs[n], prev[n] = true, cost, current
+			}
+		}
+	}
+	if !reached[target] {
+		return Route{}, ErrNoRoute
+	}
+
+	// walk the path backwards to build the legs
+	var route Route
+	for n := target; prev[n] != -1; n = prev[n] {
+		distance := nodes[prev[n]].DistanceFrom(nodes[n])
+		route.Legs = append([]RouteLeg{{
+			From:     nodes[prev[n]],
+			To:       nodes[n],
+			Distance: distance,
+			Fuel:     legFuel(needed, distance),
+		}}, route.Legs...)
+		route.Distance += distance
+		route.Fuel += legFuel(needed, distance)
+	}
+	if route.Fuel > s.Fuel {
+		return route, fmt.Errorf("jump-drive: %w", ErrInsufficientFuel)
+	}
+	return route, nil
+}
+
+// legFuel returns the fuel needed for a single jump, rounded up.
+func legFuel(drives int, distance float64) float64 {
+	return math.Ceil(HyperEngineFuel(drives, distance))
+}
@@ -4,6 +4,7 @@ package empyr
 
 import (
 	"fmt"
+	"math"
 )
 
 type Ship struct {
@@ -24,34 +25,47 @@ type Ship struct {
 	}
 }
 
-// estimateJumpCost does
+// estimateJumpCost returns the fuel needed for the ship to jump to a new location.
+//
+// The rules are the same as the unit table in the engine: each jump drive can
+// lift 1,045 x TL tonnes, the range of a jump is 3 x √TL light years, and every
+// drive needed to lift the ship consumes 40 units of fuel per light year.
 func (s Ship) estimateJumpCost(to Location) (fuelConsumed float64, err error) {
 	distance := s.Location.Current.DistanceFrom(to)
 
 	// get current drive setup
 	drives := s.getJumpDrives()
 
-	// fuel needed is 40 units per light year jumped per jump drive
-	fuelConsumed = 40 * distance * float64(drives.Quantity)
-	if fuelConsumed > s.Fuel {
-		return 0, fmt.Errorf("jump-drive: %w", ErrInsufficientFuel)
-	}
-
-	// each jump drive can move at most TL * 1000 metric tonnes
-	maxMass := drives.TechLevel * 1_000 * drives.Quantity
-	if s.Mass > maxMass {
+	// the ship must have enough drives to lift its mass
+	needed := s.JumpDrivesNeeded()
+	if drives.Quantity == 0 || needed > drives.Quantity {
 		return 0, fmt.Errorf("jump-drive: %w", ErrMassExceedsCapacity)
 	}
 
-	// maximum distance per jump is 1 light year per tech level of the jump drive
-	maxDistance := float64(drives.TechLevel)
-	if distance > maxDistance {
+	// maximum distance per jump depends on the tech level of the jump drive
+	if distance > HyperEngineRange(drives.TechLevel) {
 		return 0, fmt.Errorf("jump-drive: %w", ErrDistanceExceedsCapacity)
 	}
 
+	// only the drives needed for the jump consume fuel
+	fuelConsumed = HyperEngineFuel(needed, distance)
+	if fuelConsumed > s.Fuel {
+		return 0, fmt.Errorf("jump-drive: %w", ErrInsufficientFuel)
+	}
+
 	return fuelConsumed, nil
 }
 
+// JumpDrivesNeeded returns the number of jump drives needed to lift the ship.
+// It uses the combined tech level of the installed drives.
+func (s Ship) JumpDrivesNeeded() int {
+	drives := s.getJumpDrives()
+	if drives.TechLevel == 0 {
+		return 0
+	}
+	return int(math.Ceil(float64(s.Mass) / HyperEngineLift(drives.TechLevel)))
+}
+
 // getJumpDrives returns the current jump drive configuration.
 // The game penalizes the player for mixing the tech level of drives.
 // The combined tech level is the lowest of all the installed drives.
@@ -19,6 +19,7 @@ const (
 	ErrOpen                = cerr.Error("open")
 	ErrPragmaReturnedNil   = cerr.Error("pragma returned nil")
 	ErrReadOnly            = cerr.Error("read only")
+	ErrSetupTurn           = cerr.Error("setup turn")
 	ErrUnknown             = cerr.Error("unknown")
 	ErrUnsupported         = cerr.Error("unsupported")
 	ErrWriteOnly           = cerr.Error("write only")
@@ -0,0 +1,23 @@
+--  Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+-- databases created before ownership was tracked have no sc_owner table.
+-- the founding empire owns every ship and colony from the turn it was first
+-- located (or turn 0 if it has no location).
+create table if not exists sc_owner
+(
+    sc_id     integer not null,
+    effdt     integer not null,
+    enddt     integer not null,
+    empire_id integer not null,
+    primary key (sc_id, effdt),
+    constraint fk_sc_id foreign key (sc_id) references scs (id),
+    constraint fk_empire_id foreign key (empire_id) references empire (id)
+);
+
+insert into sc_owner (sc_id, effdt, enddt, empire_id)
+select scs.id,
+       coalesce((select min(sc_location.effdt) from sc_location where sc_location.sc_id = scs.id), 0),
+       99999,
+       scs.empire_id
+from scs
+where not exists (select 1 from sc_owner where sc_owner.sc_id = scs.id);
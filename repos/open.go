@@ -90,6 +90,43 @@ func Create(path string) error {
 	return nil
 }
 
+// Migrate runs the migration scripts that haven't been run against an
+// existing store, in version order.
+func Migrate(path string) error {
+	scripts, err := loadMigrations()
+	if err != nil {
+		return err
+	}
+	s, err := Open(path, context.Background())
+	if err != nil {
+		return err
+	}
+	defer s.Close()
+
+	for _, script := range scripts {
+		var n int
+		err := s.DB.QueryRow("select count(*) from meta_migrations where version = ?", script.version).Scan(&n)
+		if err != nil {
+			log.Printf("store: migrate: %v\n", err)
+			return err
+		} else if n != 0 {
+			continue
+		}
+		log.Printf("store: migrate %d: %q\n", script.version, script.comment)
+		if _, err := s.DB.Exec(script.script); err != nil {
+			log.Printf("store: migrate %d: %v\n", script.version, err)
+			return errors.Join(ErrCreateSchema, err)
+		}
+		_, err = s.DB.Exec("insert into meta_migrations(version, comment, script) values(?, ?, ?)", script.version, script.comment, script.path)
+		if err != nil {
+			log.Printf("store: migrate: %v\n", err)
+			return err
+		}
+	}
+
+	return nil
+}
+
 // Open opens an existing store.
 // It returns an error if the path is invalid or the store does not exist.
 // Caller must call Close() when done.
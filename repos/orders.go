@@ -3,6 +3,8 @@
 package repos
 
 import (
+	"github.com/playbymail/empyr/internal/domains"
+	"github.com/playbymail/empyr/repos/sqlite"
 	"log"
 )
 
@@ -20,23 +22,336 @@ func (s *Store) ResetTurnResults(gameCode string) error {
 		return err
 	}
 	log.Printf("game %q: turn: %d\n", gameCode, turnNo)
+	// turn 0 is the setup turn. it has no results, and the rows on turn 0
+	// are the ships and colonies as they were set up, so it can't be reset.
+	if turnNo < 1 {
+		return ErrSetupTurn
+	}
 	// reset turn results so that we can re-run the turn from scratch
 	// 1. delete all reports
 	log.Printf("game %q: turn: %d: purged reports\n", gameCode, turnNo)
 	// 2. reset probe results
+	err = q.DeleteSCProbeStarOrbitResultsByTurn(s.Context, turnNo)
+	if err != nil {
+		log.Printf("game %q: turn: %d: probes: err %v\n", gameCode, turnNo, err)
+		return err
+	}
 	err = q.DeleteSCProbeStarResultsByTurn(s.Context, turnNo)
 	if err != nil {
 		log.Printf("game %q: turn: %d: probes: err %v\n", gameCode, turnNo, err)
 		return err
 	}
+	err = q.DeleteSCProbeResultsByTurn(s.Context, turnNo)
+	if err != nil {
+		log.Printf("game %q: turn: %d: probes: err %v\n", gameCode, turnNo, err)
+		return err
+	}
 	log.Printf("game %q: turn: %d: reset probe results\n", gameCode, turnNo)
 	// 3. reset survey results
+	err = q.DeleteSCSurveyDepositResultsByTurn(s.Context, turnNo)
+	if err != nil {
+		log.Printf("game %q: turn: %d: surveys: err %v\n", gameCode, turnNo, err)
+		return err
+	}
 	err = q.DeleteSCSurveyOrbitResultsByTurn(s.Context, turnNo)
 	if err != nil {
 		log.Printf("game %q: turn: %d: surveys: err %v\n", gameCode, turnNo, err)
 		return err
 	}
+	err = q.DeleteSCSurveyResultsByTurn(s.Context, turnNo)
+	if err != nil {
+		log.Printf("game %q: turn: %d: surveys: err %v\n", gameCode, turnNo, err)
+		return err
+	}
 	log.Printf("game %q: turn: %d: reset survey results\n", gameCode, turnNo)
+	// 4. reset move results. the locations and fuel are rolled back in step 21.
+	err = q.DeleteSCMoveResultsByTurn(s.Context, turnNo)
+	if err != nil {
+		log.Printf("game %q: turn: %d: moves: err %v\n", gameCode, turnNo, err)
+		return err
+	}
+	log.Printf("game %q: turn: %d: reset move results\n", gameCode, turnNo)
+	// 5. reset jump results. the locations and fuel are rolled back in step 21.
+	err = q.DeleteSCJumpResultsByTurn(s.Context, turnNo)
+	if err != nil {
+		log.Printf("game %q: turn: %d: jumps: err %v\n", gameCode, turnNo, err)
+		return err
+	}
+	log.Printf("game %q: turn: %d: reset jump results\n", gameCode, turnNo)
+	// 6. reset knowledge. delete the observations made this turn, then
+	//    re-open the observations that they replaced.
+	err = q.DeleteEmpireStarKnowledgeByTurn(s.Context, turnNo)
+	if err == nil {
+		err = q.UpdateEmpireStarKnowledgeEndDtByTurn(s.Context, sqlite.UpdateEmpireStarKnowledgeEndDtByTurnParams{MaxEnddt: domains.MaxGameTurnNo, Effdt: turnNo})
+	}
+	if err == nil {
+		err = q.DeleteEmpireOrbitKnowledgeByTurn(s.Context, turnNo)
+	}
+	if err == nil {
+		err = q.UpdateEmpireOrbitKnowledgeEndDtByTurn(s.Context, sqlite.UpdateEmpireOrbitKnowledgeEndDtByTurnParams{MaxEnddt: domains.MaxGameTurnNo, Effdt: turnNo})
+	}
+	if err == nil {
+		err = q.DeleteEmpireDepositKnowledgeByTurn(s.Context, turnNo)
+	}
+	if err == nil {
+		err = q.UpdateEmpireDepositKnowledgeEndDtByTurn(s.Context, sqlite.UpdateEmpireDepositKnowledgeEndDtByTurnParams{MaxEnddt: domains.MaxGameTurnNo, Effdt: turnNo})
+	}
+	if err == nil {
+		err = q.DeleteEmpireSCKnowledgeByTurn(s.Context, turnNo)
+	}
+	if err == nil {
+		err = q.UpdateEmpireSCKnowledgeEndDtByTurn(s.Context, sqlite.UpdateEmpireSCKnowledgeEndDtByTurnParams{MaxEnddt: domains.MaxGameTurnNo, Effdt: turnNo})
+	}
+	if err != nil {
+		log.Printf("game %q: turn: %d: knowledge: err %v\n", gameCode, turnNo, err)
+		return err
+	}
+	log.Printf("game %q: turn: %d: reset knowledge\n", gameCode, turnNo)
+	// 7. reset combat results
+	err = q.DeleteSCCombatLossesByTurn(s.Context, turnNo)
+	if err != nil {
+		log.Printf("game %q: turn: %d: combat: err %v\n", gameCode, turnNo, err)
+		return err
+	}
+	err = q.DeleteSCCombatResultsByTurn(s.Context, turnNo)
+	if err != nil {
+		log.Printf("game %q: turn: %d: combat: err %v\n", gameCode, turnNo, err)
+		return err
+	}
+	log.Printf("game %q: turn: %d: reset combat results\n", gameCode, turnNo)
+	// 8. reset owners. delete the captures made this turn, then re-open
+	//    the owners that they replaced. the owners on turn 0 are from setup.
+	if turnNo > 0 {
+		err = q.DeleteSCOwnersByTurn(s.Context, turnNo)
+		if err == nil {
+			err = q.UpdateSCOwnerEndDtByTurn(s.Context, sqlite.UpdateSCOwnerEndDtByTurnParams{MaxEnddt: domains.MaxGameTurnNo, Effdt: turnNo})
+		}
+		if err != nil {
+			log.Printf("game %q: turn: %d: owners: err %v\n", gameCode, turnNo, err)
+			return err
+		}
+	}
+	log.Printf("game %q: turn: %d: reset owners\n", gameCode, turnNo)
+	// 9. reset espionage results
+	err = q.DeleteSCEspionageResultsByTurn(s.Context, turnNo)
+	if err != nil {
+		log.Printf("game %q: turn: %d: espionage: err %v\n", gameCode, turnNo, err)
+		return err
+	}
+	log.Printf("game %q: turn: %d: reset espionage results\n", gameCode, turnNo)
+	// 10. reset rights. delete the rights granted this turn, then re-open
+	//     the rights revoked this turn.
+	err = q.DeleteEmpirePermissionResultsByTurn(s.Context, turnNo)
+	if err == nil {
+		err = q.DeleteEmpirePermissionsByTurn(s.Context, turnNo)
+	}
+	if err == nil {
+		err = q.UpdateEmpirePermissionEndDtByTurn(s.Context, sqlite.UpdateEmpirePermissionEndDtByTurnParams{MaxEnddt: domains.MaxGameTurnNo, Effdt: turnNo})
+	}
+	if err != nil {
+		log.Printf("game %q: turn: %d: rights: err %v\n", gameCode, turnNo, err)
+		return err
+	}
+	log.Printf("game %q: turn: %d: reset rights\n", gameCode, turnNo)
+	// 11. reset market results and prices
+	err = q.DeleteSCMarketResultsByTurn(s.Context, turnNo)
+	if err == nil {
+		err = q.DeleteMarketPricesByTurn(s.Context, turnNo)
+	}
+	if err != nil {
+		log.Printf("game %q: turn: %d: market: err %v\n", gameCode, turnNo, err)
+		return err
+	}
+	log.Printf("game %q: turn: %d: reset market results\n", gameCode, turnNo)
+	// 12. reset news. announcements posted by the GM are kept.
+	err = q.DeleteEmpireNewsResultsByTurn(s.Context, turnNo)
+	if err == nil {
+		err = q.DeleteEmpireNewsItemsByTurn(s.Context, turnNo)
+	}
+	if err != nil {
+		log.Printf("game %q: turn: %d: news: err %v\n", gameCode, turnNo, err)
+		return err
+	}
+	log.Printf("game %q: turn: %d: reset news\n", gameCode, turnNo)
+	// 13. reset names. delete the names given this turn, then re-open the
+	//     names that they replaced. the names on turn 0 are from setup.
+	err = q.DeleteEmpireNameResultsByTurn(s.Context, turnNo)
+	if err == nil && turnNo > 0 {
+		err = q.DeleteEmpireNamesByTurn(s.Context, turnNo)
+		if err == nil {
+			err = q.UpdateEmpireNameEndDtByTurn(s.Context, sqlite.UpdateEmpireNameEndDtByTurnParams{MaxEnddt: domains.MaxGameTurnNo, Effdt: turnNo})
+		}
+		if err == nil {
+			err = q.DeleteEmpireSystemNamesByTurn(s.Context, turnNo)
+		}
+		if err == nil {
+			err = q.UpdateEmpireSystemNameEndDtByTurn(s.Context, sqlite.UpdateEmpireSystemNameEndDtByTurnParams{MaxEnddt: domains.MaxGameTurnNo, Effdt: turnNo})
+		}
+		if err == nil {
+			err = q.DeleteEmpireStarNamesByTurn(s.Context, turnNo)
+		}
+		if err == nil {
+			err = q.UpdateEmpireStarNameEndDtByTurn(s.Context, sqlite.UpdateEmpireStarNameEndDtByTurnParams{MaxEnddt: domains.MaxGameTurnNo, Effdt: turnNo})
+		}
+		if err == nil {
+			err = q.DeleteSCNamesByTurn(s.Context, turnNo)
+		}
+		if err == nil {
+			err = q.UpdateSCNameEndDtByTurn(s.Context, sqlite.UpdateSCNameEndDtByTurnParams{MaxEnddt: domains.MaxGameTurnNo, Effdt: turnNo})
+		}
+	}
+	if err != nil {
+		log.Printf("game %q: turn: %d: names: err %v\n", gameCode, turnNo, err)
+		return err
+	}
+	log.Printf("game %q: turn: %d: reset names\n", gameCode, turnNo)
+	// 14. reset setups. delete the ships and colonies founded this turn,
+	//     then the results that list them. the units and people taken from
+	//     the parents are given back in step 21.
+	err = q.DeleteFoundedSCInventoryByTurn(s.Context, turnNo)
+	if err == nil {
+		err = q.DeleteFoundedSCLocationsByTurn(s.Context, turnNo)
+	}
+	if err == nil {
+		err = q.DeleteFoundedSCNamesByTurn(s.Context, turnNo)
+	}
+	if err == nil {
+		err = q.DeleteFoundedSCOwnersByTurn(s.Context, turnNo)
+	}
+	if err == nil {
+		err = q.DeleteFoundedSCPopulationByTurn(s.Context, turnNo)
+	}
+	if err == nil {
+		err = q.DeleteFoundedSCRatesByTurn(s.Context, turnNo)
+	}
+	if err == nil {
+		err = q.DeleteFoundedSCsByTurn(s.Context, turnNo)
+	}
+	if err == nil {
+		err = q.DeleteSCSetupResultsByTurn(s.Context, turnNo)
+	}
+	if err != nil {
+		log.Printf("game %q: turn: %d: setups: err %v\n", gameCode, turnNo, err)
+		return err
+	}
+	log.Printf("game %q: turn: %d: reset setups\n", gameCode, turnNo)
+	// 15. reset assemblies. roll back the units added to or removed from
+	//     groups this turn, then delete the groups created this turn with
+	//     their numbers, deposits, tooling, and production. the units taken
+	//     from storage are given back in step 21.
+	err = q.DeleteSCGroupUnitsByTurn(s.Context, turnNo)
+	if err == nil {
+		err = q.UpdateSCGroupUnitEndDtByTurn(s.Context, sqlite.UpdateSCGroupUnitEndDtByTurnParams{MaxEnddt: domains.MaxGameTurnNo, Effdt: turnNo})
+	}
+	if err == nil {
+		err = q.DeleteSCGroupDepositsByTurn(s.Context, turnNo)
+	}
+	if err == nil {
+		err = q.DeleteSCGroupNosByTurn(s.Context, turnNo)
+	}
+	if err == nil {
+		err = q.DeleteNewSCGroupToolingByTurn(s.Context, turnNo)
+	}
+	if err == nil {
+		err = q.DeleteSCGroupProductionByTurn(s.Context, turnNo)
+	}
+	if err == nil {
+		err = q.DeleteSCGroupsByTurn(s.Context, turnNo)
+	}
+	if err == nil {
+		err = q.DeleteSCAssemblyResultsByTurn(s.Context, turnNo)
+	}
+	if err != nil {
+		log.Printf("game %q: turn: %d: assemblies: err %v\n", gameCode, turnNo, err)
+		return err
+	}
+	log.Printf("game %q: turn: %d: reset assemblies\n", gameCode, turnNo)
+	// 16. reset research. delete the research produced by the lab groups,
+	//     restore the tech levels raised by the research orders, and delete
+	//     the results of the orders. the research spent is rolled back in
+	//     step 21.
+	err = q.DeleteLabProductionByTurn(s.Context, turnNo)
+	if err == nil {
+		err = q.UpdateSCTechLevelsByTurn(s.Context, turnNo)
+	}
+	if err == nil {
+		err = q.DeleteSCResearchResultsByTurn(s.Context, turnNo)
+	}
+	if err != nil {
+		log.Printf("game %q: turn: %d: research: err %v\n", gameCode, turnNo, err)
+		return err
+	}
+	log.Printf("game %q: turn: %d: reset research\n", gameCode, turnNo)
+	// 17. reset retools. delete the tooling created this turn and re-open
+	//     the tooling that it replaced.
+	err = q.DeleteSCGroupToolingByTurn(s.Context, turnNo)
+	if err == nil {
+		err = q.UpdateSCGroupToolingEndDtByTurn(s.Context, sqlite.UpdateSCGroupToolingEndDtByTurnParams{MaxEnddt: domains.MaxGameTurnNo, Effdt: turnNo})
+	}
+	if err == nil {
+		err = q.DeleteSCRetoolResultsByTurn(s.Context, turnNo)
+	}
+	if err != nil {
+		log.Printf("game %q: turn: %d: retools: err %v\n", gameCode, turnNo, err)
+		return err
+	}
+	log.Printf("game %q: turn: %d: reset retools\n", gameCode, turnNo)
+	// 18. reset recycles. delete the work in progress left on the turn, so
+	//     the earlier entries are current again. the units removed from
+	//     groups are rolled back in step 15 and the materials in step 21.
+	err = q.DeleteSCGroupUnitProductionWIPByTurn(s.Context, turnNo)
+	if err == nil {
+		err = q.DeleteSCGroupUnitProductionByTurn(s.Context, turnNo)
+	}
+	if err == nil {
+		err = q.DeleteSCRecycleResultsByTurn(s.Context, turnNo)
+	}
+	if err != nil {
+		log.Printf("game %q: turn: %d: recycles: err %v\n", gameCode, turnNo, err)
+		return err
+	}
+	log.Printf("game %q: turn: %d: reset recycles\n", gameCode, turnNo)
+	// 19. reset transfers. the cargo delivered and the fuel used by the
+	//     transports are rolled back in step 21, so orders queued on earlier
+	//     turns are picked up again with the quantity they had left.
+	err = q.DeleteSCTransferResultsByTurn(s.Context, turnNo)
+	if err != nil {
+		log.Printf("game %q: turn: %d: transfers: err %v\n", gameCode, turnNo, err)
+		return err
+	}
+	log.Printf("game %q: turn: %d: reset transfers\n", gameCode, turnNo)
+	// 20. reset drafts. the people moved and the CNGD used are rolled back
+	//     in step 21, so trainees graduate again on the rerun.
+	err = q.DeleteSCDraftResultsByTurn(s.Context, turnNo)
+	if err != nil {
+		log.Printf("game %q: turn: %d: drafts: err %v\n", gameCode, turnNo, err)
+		return err
+	}
+	log.Printf("game %q: turn: %d: reset drafts\n", gameCode, turnNo)
+	// 21. roll back the ships and colonies. delete the location, inventory,
+	//     and population entries created this turn, then re-open the entries
+	//     that they replaced.
+	err = q.DeleteSCLocationsByTurn(s.Context, turnNo)
+	if err == nil {
+		err = q.UpdateSCLocationEndDtByTurn(s.Context, sqlite.UpdateSCLocationEndDtByTurnParams{MaxEnddt: domains.MaxGameTurnNo, Effdt: turnNo})
+	}
+	if err == nil {
+		err = q.DeleteSCInventoryByTurn(s.Context, turnNo)
+	}
+	if err == nil {
+		err = q.UpdateSCInventoryEndDtByTurn(s.Context, sqlite.UpdateSCInventoryEndDtByTurnParams{MaxEnddt: domains.MaxGameTurnNo, Effdt: turnNo})
+	}
+	if err == nil {
+		err = q.DeleteSCPopulationByTurn(s.Context, turnNo)
+	}
+	if err == nil {
+		err = q.UpdateSCPopulationEndDtByTurn(s.Context, sqlite.UpdateSCPopulationEndDtByTurnParams{MaxEnddt: domains.MaxGameTurnNo, Effdt: turnNo})
+	}
+	if err != nil {
+		log.Printf("game %q: turn: %d: rollback: err %v\n", gameCode, turnNo, err)
+		return err
+	}
+	log.Printf("game %q: turn: %d: rolled back ships and colonies\n", gameCode, turnNo)
 	// commit the transaction
 	return tx.Commit()
 }
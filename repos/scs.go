@@ -6,12 +6,98 @@ import (
 	"github.com/playbymail/empyr/repos/sqlite"
 )
 
-func (s *Store) CreateSCProbeOrder(scID, turnNo, techLevel int64, kind string, targetID int64) (int64, error) {
-	parms := sqlite.CreateSCProbeOrderParams{ScID: scID, Kind: kind, TargetID: targetID}
+func (s *Store) CreateSCProbeOrder(scID, turnNo int64, kind string, targetID int64) (int64, error) {
+	parms := sqlite.CreateSCProbeOrderParams{ScID: scID, Effdt: turnNo, Kind: kind, TargetID: targetID}
 	return s.Queries.CreateSCProbeOrder(s.Context, parms)
 }
 
-func (s *Store) CreateSCSurveyOrder(scID, targetID int64) (int64, error) {
-	parms := sqlite.CreateSCSurveyOrderParams{ScID: scID, TargetID: targetID}
+func (s *Store) CreateSCSurveyOrder(scID, turnNo, orbitID int64) (int64, error) {
+	parms := sqlite.CreateSCSurveyOrderParams{ScID: scID, Effdt: turnNo, TargetID: orbitID, Kind: "orbit"}
 	return s.Queries.CreateSCSurveyOrder(s.Context, parms)
 }
+
+func (s *Store) CreateSCMoveOrder(scID, turnNo, orbitID int64, isOnSurface bool) (int64, error) {
+	parms := sqlite.CreateSCMoveOrderParams{ScID: scID, Effdt: turnNo, OrbitID: orbitID}
+	if isOnSurface {
+		parms.IsOnSurface = 1
+	}
+	return s.Queries.CreateSCMoveOrder(s.Context, parms)
+}
+
+func (s *Store) CreateSCJumpOrder(scID, turnNo, orbitID int64) (int64, error) {
+	parms := sqlite.CreateSCJumpOrderParams{ScID: scID, Effdt: turnNo, OrbitID: orbitID}
+	return s.Queries.CreateSCJumpOrder(s.Context, parms)
+}
+
+func (s *Store) CreateSCCombatOrder(scID, turnNo int64, kind string, targetID, supportID, pctCommitted int64, raidUnitCd string) (int64, error) {
+	parms := sqlite.CreateSCCombatOrderParams{ScID: scID, Effdt: turnNo, Kind: kind, TargetID: targetID, SupportID: supportID, PctCommitted: pctCommitted, RaidUnitCd: raidUnitCd}
+	return s.Queries.CreateSCCombatOrder(s.Context, parms)
+}
+
+func (s *Store) CreateSCEspionageOrder(scID, turnNo int64, kind string, targetID, qty int64) (int64, error) {
+	parms := sqlite.CreateSCEspionageOrderParams{ScID: scID, Effdt: turnNo, Kind: kind, TargetID: targetID, Qty: qty}
+	return s.Queries.CreateSCEspionageOrder(s.Context, parms)
+}
+
+func (s *Store) CreateSCMarketOrder(scID, turnNo int64, kind, unitCd string, techLevel, qty int64, price float64) (int64, error) {
+	parms := sqlite.CreateSCMarketOrderParams{ScID: scID, Effdt: turnNo, Kind: kind, UnitCd: unitCd, TechLevel: techLevel, Qty: qty, Price: price}
+	return s.Queries.CreateSCMarketOrder(s.Context, parms)
+}
+
+func (s *Store) CreateEmpirePermissionOrder(empireID, turnNo int64, action string, orbitID int64, kind string, granteeID int64) (int64, error) {
+	parms := sqlite.CreateEmpirePermissionOrderParams{EmpireID: empireID, Effdt: turnNo, Action: action, OrbitID: orbitID, Kind: kind, GranteeID: granteeID}
+	return s.Queries.CreateEmpirePermissionOrder(s.Context, parms)
+}
+
+func (s *Store) CreateSCSetupOrder(scID, turnNo int64, kind string, orbitID int64) (int64, error) {
+	parms := sqlite.CreateSCSetupOrderParams{ScID: scID, Effdt: turnNo, Kind: kind, OrbitID: orbitID}
+	return s.Queries.CreateSCSetupOrder(s.Context, parms)
+}
+
+func (s *Store) CreateSCSetupItem(setupID, lineNo int64, unitCd string, techLevel, qty int64) error {
+	parms := sqlite.CreateSCSetupItemParams{SetupID: setupID, LineNo: lineNo, UnitCd: unitCd, TechLevel: techLevel, Qty: qty}
+	return s.Queries.CreateSCSetupItem(s.Context, parms)
+}
+
+func (s *Store) CreateEmpireNameOrder(empireID, turnNo int64, kind string, targetID int64, name string) (int64, error) {
+	parms := sqlite.CreateEmpireNameOrderParams{EmpireID: empireID, Effdt: turnNo, Kind: kind, TargetID: targetID, Name: name}
+	return s.Queries.CreateEmpireNameOrder(s.Context, parms)
+}
+
+func (s *Store) CreateEmpireNewsOrder(empireID, turnNo, systemID, orbitNo int64, article, signature string) (int64, error) {
+	parms := sqlite.CreateEmpireNewsOrderParams{EmpireID: empireID, Effdt: turnNo, SystemID: systemID, OrbitNo: orbitNo, Article: article, Signature: signature}
+	return s.Queries.CreateEmpireNewsOrder(s.Context, parms)
+}
+
+func (s *Store) CreateSCAssemblyOrder(scID, turnNo int64, kind, unitCd string, techLevel, qty, groupNo, depositNo int64, itemCd string, itemTechLevel int64) (int64, error) {
+	parms := sqlite.CreateSCAssemblyOrderParams{ScID: scID, Effdt: turnNo, Kind: kind, UnitCd: unitCd, TechLevel: techLevel, Qty: qty, GroupNo: groupNo, DepositNo: depositNo, ItemCd: itemCd, ItemTechLevel: itemTechLevel}
+	return s.Queries.CreateSCAssemblyOrder(s.Context, parms)
+}
+
+func (s *Store) CreateSCRecycleOrder(scID, turnNo int64, kind, groupKind string, groupNo int64, unitCd string, techLevel, qty int64) (int64, error) {
+	parms := sqlite.CreateSCRecycleOrderParams{ScID: scID, Effdt: turnNo, Kind: kind, GroupKind: groupKind, GroupNo: groupNo, UnitCd: unitCd, TechLevel: techLevel, Qty: qty}
+	return s.Queries.CreateSCRecycleOrder(s.Context, parms)
+}
+
+func (s *Store) CreateSCResearchOrder(scID, turnNo int64) (int64, error) {
+	parms := sqlite.CreateSCResearchOrderParams{ScID: scID, Effdt: turnNo}
+	return s.Queries.CreateSCResearchOrder(s.Context, parms)
+}
+
+func (s *Store) CreateSCRetoolOrder(scID, turnNo, groupNo int64, itemCd string, itemTechLevel int64) (int64, error) {
+	parms := sqlite.CreateSCRetoolOrderParams{ScID: scID, Effdt: turnNo, GroupNo: groupNo, ItemCd: itemCd, ItemTechLevel: itemTechLevel}
+	return s.Queries.CreateSCRetoolOrder(s.Context, parms)
+}
+
+func (s *Store) CreateSCTransferOrder(scID, turnNo, targetID int64, unitCd string, techLevel, qty int64) (int64, error) {
+	parms := sqlite.CreateSCTransferOrderParams{ScID: scID, Effdt: turnNo, TargetID: targetID, UnitCd: unitCd, TechLevel: techLevel, Qty: qty}
+	return s.Queries.CreateSCTransferOrder(s.Context, parms)
+}
+
+func (s *Store) CreateSCDraftOrder(scID, turnNo int64, kind, populationCd string, qty int64) (int64, error) {
+	if populationCd == "CONS" { // the order parser uses CONS for construction workers
+		populationCd = "CNW"
+	}
+	parms := sqlite.CreateSCDraftOrderParams{ScID: scID, Effdt: turnNo, Kind: kind, PopulationCd: populationCd, Qty: qty}
+	return s.Queries.CreateSCDraftOrder(s.Context, parms)
+}
@@ -0,0 +1,60 @@
+-- CreateSCAssemblyOrder creates a new assemble or store order.
+--
+-- name: CreateSCAssemblyOrder :one
+insert into sc_assembly_order (sc_id, effdt, kind, unit_cd, tech_level, qty, group_no, deposit_no, item_cd,
+                               item_tech_level)
+values (:sc_id, :effdt, :kind, :unit_cd, :tech_level, :qty, :group_no, :deposit_no, :item_cd, :item_tech_level)
+returning id;
+
+-- CreateSCAssemblyResult creates the result of an assemble or store order.
+--
+-- name: CreateSCAssemblyResult :exec
+insert into sc_assembly_result (assembly_id, effdt, empire_id, qty, cnw_used, status, reason)
+values (:assembly_id, :effdt, :empire_id, :qty, :cnw_used, :status, :reason);
+
+-- DeleteSCAssemblyResultsByTurn deletes the assembly results for a turn.
+--
+-- name: DeleteSCAssemblyResultsByTurn :exec
+delete
+from sc_assembly_result
+where effdt = :effdt;
+
+-- ReadAllAssemblyOrdersByTurn returns the assemble and store orders for a
+-- turn, in the order they were given.
+--
+-- name: ReadAllAssemblyOrdersByTurn :many
+select id as assembly_id,
+       sc_id,
+       kind,
+       unit_cd,
+       tech_level,
+       qty,
+       group_no,
+       deposit_no,
+       item_cd,
+       item_tech_level
+from sc_assembly_order
+where effdt = :turn_no
+order by id;
+
+-- ReadAllAssemblyResultsByEmpire returns the results of the assemble and
+-- store orders given by an empire's ships and colonies on a turn.
+--
+-- name: ReadAllAssemblyResultsByEmpire :many
+select sc_assembly_order.id as assembly_id,
+       sc_assembly_order.sc_id,
+       sc_assembly_order.kind,
+       sc_assembly_order.unit_cd,
+       sc_assembly_order.tech_level,
+       sc_assembly_order.qty as ordered_qty,
+       sc_assembly_order.group_no,
+       sc_assembly_result.qty,
+       sc_assembly_result.cnw_used,
+       sc_assembly_result.status,
+       sc_assembly_result.reason
+from sc_assembly_order,
+     sc_assembly_result
+where sc_assembly_result.empire_id = :empire_id
+  and sc_assembly_result.effdt = :effdt
+  and sc_assembly_order.id = sc_assembly_result.assembly_id
+order by sc_assembly_order.id;
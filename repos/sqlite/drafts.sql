@@ -0,0 +1,76 @@
+-- CreateSCDraftOrder creates a new draft or discharge order.
+--
+-- name: CreateSCDraftOrder :one
+insert into sc_draft_order (sc_id, effdt, kind, population_cd, qty)
+values (:sc_id, :effdt, :kind, :population_cd, :qty)
+returning id;
+
+-- CreateSCDraftResult creates the result of a draft or discharge order.
+--
+-- name: CreateSCDraftResult :exec
+insert into sc_draft_result (draft_id, effdt, empire_id, qty, cngd_used, status, reason)
+values (:draft_id, :effdt, :empire_id, :qty, :cngd_used, :status, :reason);
+
+-- DeleteSCDraftResultsByTurn deletes the draft results for a turn.
+--
+-- name: DeleteSCDraftResultsByTurn :exec
+delete
+from sc_draft_result
+where effdt = :effdt;
+
+-- ReadAllDraftOrdersByTurn returns the draft and discharge orders for a
+-- turn, in the order they were given.
+--
+-- name: ReadAllDraftOrdersByTurn :many
+select id as draft_id,
+       sc_id,
+       kind,
+       population_cd,
+       qty
+from sc_draft_order
+where effdt = :turn_no
+order by id;
+
+-- ReadAllDraftResultsByEmpire returns the results of the draft and
+-- discharge orders given by an empire's ships and colonies on a turn,
+-- including the trainees who finished their training on the turn.
+--
+-- name: ReadAllDraftResultsByEmpire :many
+select sc_draft_order.id as draft_id,
+       sc_draft_order.sc_id,
+       sc_draft_order.kind,
+       sc_draft_order.population_cd,
+       sc_draft_order.qty as ordered_qty,
+       sc_draft_result.qty,
+       sc_draft_result.cngd_used,
+       sc_draft_result.status,
+       sc_draft_result.reason
+from sc_draft_order,
+     sc_draft_result
+where sc_draft_result.empire_id = :empire_id
+  and sc_draft_result.effdt = :effdt
+  and sc_draft_order.id = sc_draft_result.draft_id
+order by sc_draft_order.id;
+
+-- ReadAllTraineesByTurn returns the trainees from draft orders that
+-- succeeded before a turn and haven't finished their training, in the
+-- order they were drafted.
+--
+-- name: ReadAllTraineesByTurn :many
+select sc_draft_order.id as draft_id,
+       sc_draft_order.sc_id,
+       sc_draft_order.population_cd,
+       sc_draft_result.effdt as draft_dt,
+       sc_draft_result.qty
+from sc_draft_order,
+     sc_draft_result
+where sc_draft_order.kind = 'draft'
+  and sc_draft_result.draft_id = sc_draft_order.id
+  and sc_draft_result.status = 'succeeded'
+  and sc_draft_result.effdt < :turn_no
+  and not exists (select 1
+                  from sc_draft_result graduated
+                  where graduated.draft_id = sc_draft_order.id
+                    and graduated.status = 'graduated'
+                    and graduated.effdt < :turn_no)
+order by sc_draft_order.id;
@@ -133,6 +133,141 @@ where empire_id = :empire_id
 select name, effdt, enddt
 from empire_name
 where empire_id = :empire_id
-  and (empire_name.effdt <= :as_of_dt and :as_of_dt <= empire_name.enddt);
+  and (empire_name.effdt <= :as_of_dt and :as_of_dt < empire_name.enddt);
 
 
+-- CreateEmpirePermissionOrder creates a new grant or revoke order.
+--
+-- name: CreateEmpirePermissionOrder :one
+insert into empire_permission_order (empire_id, effdt, action, orbit_id, kind, grantee_id)
+values (:empire_id, :effdt, :action, :orbit_id, :kind, :grantee_id)
+returning id;
+
+-- CreateEmpirePermissionResult creates the result of a grant or revoke order.
+--
+-- name: CreateEmpirePermissionResult :exec
+insert into empire_permission_result (permission_id, effdt, status, reason)
+values (:permission_id, :effdt, :status, :reason);
+
+-- DeleteEmpirePermissionResultsByTurn deletes the grant and revoke results for a turn.
+--
+-- name: DeleteEmpirePermissionResultsByTurn :exec
+delete
+from empire_permission_result
+where effdt = :effdt;
+
+-- ReadAllPermissionOrdersByTurn returns the grant and revoke orders for a turn,
+-- in the order they were given.
+--
+-- name: ReadAllPermissionOrdersByTurn :many
+select id as permission_id,
+       empire_id,
+       action,
+       orbit_id,
+       kind,
+       grantee_id
+from empire_permission_order
+where effdt = :turn_no
+order by id;
+
+-- ReadAllPermissionResultsByEmpire returns the results of the grant and
+-- revoke orders given by an empire on a turn.
+--
+-- name: ReadAllPermissionResultsByEmpire :many
+select empire_permission_order.id as permission_id,
+       empire_permission_order.action,
+       empire_permission_order.kind,
+       empire_permission_order.grantee_id,
+       stars.star_name,
+       orbits.orbit_no,
+       empire_permission_result.status,
+       empire_permission_result.reason
+from empire_permission_order,
+     empire_permission_result,
+     orbits,
+     stars
+where empire_permission_order.empire_id = :empire_id
+  and empire_permission_order.effdt = :effdt
+  and empire_permission_result.permission_id = empire_permission_order.id
+  and empire_permission_result.effdt = empire_permission_order.effdt
+  and orbits.id = empire_permission_order.orbit_id
+  and stars.id = orbits.star_id
+order by empire_permission_order.id;
+
+-- CreateEmpirePermission creates a new right granted by an empire.
+--
+-- name: CreateEmpirePermission :exec
+insert into empire_permission (empire_id, orbit_id, kind, grantee_id, effdt, enddt)
+values (:empire_id, :orbit_id, :kind, :grantee_id, :effdt, :enddt);
+
+-- ReadEmpirePermission returns the effective date of a right granted by
+-- an empire as of the given date.
+--
+-- name: ReadEmpirePermission :one
+select effdt
+from empire_permission
+where empire_id = :empire_id
+  and orbit_id = :orbit_id
+  and kind = :kind
+  and grantee_id = :grantee_id
+  and (effdt <= :as_of_dt and :as_of_dt < enddt);
+
+-- UpdateEmpirePermissionEndDt updates the end date for a right granted by an empire.
+--
+-- name: UpdateEmpirePermissionEndDt :exec
+update empire_permission
+set enddt = :enddt
+where empire_id = :empire_id
+  and orbit_id = :orbit_id
+  and kind = :kind
+  and grantee_id = :grantee_id
+  and effdt = :effdt;
+
+-- DeleteEmpirePermissionsByTurn deletes the rights granted on a turn.
+--
+-- name: DeleteEmpirePermissionsByTurn :exec
+delete
+from empire_permission
+where effdt = :effdt;
+
+-- UpdateEmpirePermissionEndDtByTurn re-opens the rights revoked on a turn.
+--
+-- name: UpdateEmpirePermissionEndDtByTurn :exec
+update empire_permission
+set enddt = :max_enddt
+where enddt = :effdt;
+
+-- ReadAllPermissionsByEmpire returns the rights granted by or to an empire
+-- as of the given date.
+--
+-- name: ReadAllPermissionsByEmpire :many
+select empire_permission.empire_id,
+       empire_permission.grantee_id,
+       empire_permission.kind,
+       stars.star_name,
+       orbits.orbit_no,
+       empire_permission.effdt
+from empire_permission,
+     orbits,
+     stars
+where (empire_permission.empire_id = :empire_id or empire_permission.grantee_id = :empire_id)
+  and (empire_permission.effdt <= :as_of_dt and :as_of_dt < empire_permission.enddt)
+  and orbits.id = empire_permission.orbit_id
+  and stars.id = orbits.star_id
+order by stars.star_name, orbits.orbit_no, empire_permission.kind, empire_permission.empire_id, empire_permission.grantee_id;
+
+-- ReadOrbitColonyOwners returns the empires that own a colony in an orbit
+-- as of the given date.
+--
+-- name: ReadOrbitColonyOwners :many
+select distinct sc_owner.empire_id
+from sc_location,
+     scs,
+     sc_owner
+where sc_location.orbit_id = :orbit_id
+  and (sc_location.effdt <= :as_of_dt and :as_of_dt < sc_location.enddt)
+  and scs.id = sc_location.sc_id
+  and scs.sc_cd in ('COPN', 'CENC', 'CORB')
+  and sc_owner.sc_id = scs.id
+  and (sc_owner.effdt <= :as_of_dt and :as_of_dt < sc_owner.enddt)
+order by sc_owner.empire_id;
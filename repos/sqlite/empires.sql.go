@@ -84,6 +84,86 @@ func (q *Queries) CreateEmpireName(ctx context.Context, arg CreateEmpireNamePara
 	return err
 }
 
+const createEmpirePermission = `-- name: CreateEmpirePermission :exec
+insert into empire_permission (empire_id, orbit_id, kind, grantee_id, effdt, enddt)
+values (?1, ?2, ?3, ?4, ?5, ?6)
+`
+
+type CreateEmpirePermissionParams struct {
+	EmpireID  int64
+	OrbitID   int64
+	Kind      string
+	GranteeID int64
+	Effdt     int64
+	Enddt     int64
+}
+
+// CreateEmpirePermission creates a new right granted by an empire.
+func (q *Queries) CreateEmpirePermission(ctx context.Context, arg CreateEmpirePermissionParams) error {
+	_, err := q.db.ExecContext(ctx, createEmpirePermission,
+		arg.EmpireID,
+		arg.OrbitID,
+		arg.Kind,
+		arg.GranteeID,
+		arg.Effdt,
+		arg.Enddt,
+	)
+	return err
+}
+
+const createEmpirePermissionOrder = `-- name: CreateEmpirePermissionOrder :one
+insert into empire_permission_order (empire_id, effdt, action, orbit_id, kind, grantee_id)
+values (?1, ?2, ?3, ?4, ?5, ?6)
+returning id
+`
+
+type CreateEmpirePermissionOrderParams struct {
+	EmpireID  int64
+	Effdt     int64
+	Action    string
+	OrbitID   int64
+	Kind      string
+	GranteeID int64
+}
+
+// CreateEmpirePermissionOrder creates a new grant or revoke order.
+func (q *Queries) CreateEmpirePermissionOrder(ctx context.Context, arg CreateEmpirePermissionOrderParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, createEmpirePermissionOrder,
+		arg.EmpireID,
+		arg.Effdt,
+		arg.Action,
+		arg.OrbitID,
+		arg.Kind,
+		arg.GranteeID,
+	)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
+const createEmpirePermissionResult = `-- name: CreateEmpirePermissionResult :exec
+insert into empire_permission_result (permission_id, effdt, status, reason)
+values (?1, ?2, ?3, ?4)
+`
+
+type CreateEmpirePermissionResultParams struct {
+	PermissionID int64
+	Effdt        int64
+	Status       string
+	Reason       string
+}
+
+// CreateEmpirePermissionResult creates the result of a grant or revoke order.
+func (q *Queries) CreateEmpirePermissionResult(ctx context.Context, arg CreateEmpirePermissionResultParams) error {
+	_, err := q.db.ExecContext(ctx, createEmpirePermissionResult,
+		arg.PermissionID,
+		arg.Effdt,
+		arg.Status,
+		arg.Reason,
+	)
+	return err
+}
+
 const createEmpirePlayer = `-- name: CreateEmpirePlayer :exec
 insert into empire_player (empire_id, effdt, enddt, username, email)
 values (?1, ?2, ?3, ?4, ?5)
@@ -159,6 +239,30 @@ func (q *Queries) CreateEmpireWithID(ctx context.Context, arg CreateEmpireWithID
 	return err
 }
 
+const deleteEmpirePermissionResultsByTurn = `-- name: DeleteEmpirePermissionResultsByTurn :exec
+delete
+from empire_permission_result
+where effdt = ?1
+`
+
+// DeleteEmpirePermissionResultsByTurn deletes the grant and revoke results for a turn.
+func (q *Queries) DeleteEmpirePermissionResultsByTurn(ctx context.Context, effdt int64) error {
+	_, err := q.db.ExecContext(ctx, deleteEmpirePermissionResultsByTurn, effdt)
+	return err
+}
+
+const deleteEmpirePermissionsByTurn = `-- name: DeleteEmpirePermissionsByTurn :exec
+delete
+from empire_permission
+where effdt = ?1
+`
+
+// DeleteEmpirePermissionsByTurn deletes the rights granted on a turn.
+func (q *Queries) DeleteEmpirePermissionsByTurn(ctx context.Context, effdt int64) error {
+	_, err := q.db.ExecContext(ctx, deleteEmpirePermissionsByTurn, effdt)
+	return err
+}
+
 const expireEmpireName = `-- name: ExpireEmpireName :exec
 update empire_name
 set enddt = ?1
@@ -237,6 +341,194 @@ func (q *Queries) ReadActiveEmpires(ctx context.Context) ([]int64, error) {
 	return items, nil
 }
 
+const readAllPermissionOrdersByTurn = `-- name: ReadAllPermissionOrdersByTurn :many
+select id as permission_id,
+       empire_id,
+       action,
+       orbit_id,
+       kind,
+       grantee_id
+from empire_permission_order
+where effdt = ?1
+order by id
+`
+
+type ReadAllPermissionOrdersByTurnRow struct {
+	PermissionID int64
+	EmpireID     int64
+	Action       string
+	OrbitID      int64
+	Kind         string
+	GranteeID    int64
+}
+
+// ReadAllPermissionOrdersByTurn returns the grant and revoke orders for a turn,
+// in the order they were given.
+func (q *Queries) ReadAllPermissionOrdersByTurn(ctx context.Context, turnNo int64) ([]ReadAllPermissionOrdersByTurnRow, error) {
+	rows, err := q.db.QueryContext(ctx, readAllPermissionOrdersByTurn, turnNo)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ReadAllPermissionOrdersByTurnRow
+	for rows.Next() {
+		var i ReadAllPermissionOrdersByTurnRow
+		if err := rows.Scan(
+			&i.PermissionID,
+			&i.EmpireID,
+			&i.Action,
+			&i.OrbitID,
+			&i.Kind,
+			&i.GranteeID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const readAllPermissionResultsByEmpire = `-- name: ReadAllPermissionResultsByEmpire :many
+select empire_permission_order.id as permission_id,
+       empire_permission_order.action,
+       empire_permission_order.kind,
+       empire_permission_order.grantee_id,
+       stars.star_name,
+       orbits.orbit_no,
+       empire_permission_result.status,
+       empire_permission_result.reason
+from empire_permission_order,
+     empire_permission_result,
+     orbits,
+     stars
+where empire_permission_order.empire_id = ?1
+  and empire_permission_order.effdt = ?2
+  and empire_permission_result.permission_id = empire_permission_order.id
+  and empire_permission_result.effdt = empire_permission_order.effdt
+  and orbits.id = empire_permission_order.orbit_id
+  and stars.id = orbits.star_id
+order by empire_permission_order.id
+`
+
+type ReadAllPermissionResultsByEmpireParams struct {
+	EmpireID int64
+	Effdt    int64
+}
+
+type ReadAllPermissionResultsByEmpireRow struct {
+	PermissionID int64
+	Action       string
+	Kind         string
+	GranteeID    int64
+	StarName     string
+	OrbitNo      int64
+	Status       string
+	Reason       string
+}
+
+// ReadAllPermissionResultsByEmpire returns the results of the grant and
+// revoke orders given by an empire on a turn.
+func (q *Queries) ReadAllPermissionResultsByEmpire(ctx context.Context, arg ReadAllPermissionResultsByEmpireParams) ([]ReadAllPermissionResultsByEmpireRow, error) {
+	rows, err := q.db.QueryContext(ctx, readAllPermissionResultsByEmpire, arg.EmpireID, arg.Effdt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ReadAllPermissionResultsByEmpireRow
+	for rows.Next() {
+		var i ReadAllPermissionResultsByEmpireRow
+		if err := rows.Scan(
+			&i.PermissionID,
+			&i.Action,
+			&i.Kind,
+			&i.GranteeID,
+			&i.StarName,
+			&i.OrbitNo,
+			&i.Status,
+			&i.Reason,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const readAllPermissionsByEmpire = `-- name: ReadAllPermissionsByEmpire :many
+select empire_permission.empire_id,
+       empire_permission.grantee_id,
+       empire_permission.kind,
+       stars.star_name,
+       orbits.orbit_no,
+       empire_permission.effdt
+from empire_permission,
+     orbits,
+     stars
+where (empire_permission.empire_id = ?1 or empire_permission.grantee_id = ?1)
+  and (empire_permission.effdt <= ?2 and ?2 < empire_permission.enddt)
+  and orbits.id = empire_permission.orbit_id
+  and stars.id = orbits.star_id
+order by stars.star_name, orbits.orbit_no, empire_permission.kind, empire_permission.empire_id, empire_permission.grantee_id
+`
+
+type ReadAllPermissionsByEmpireParams struct {
+	EmpireID int64
+	AsOfDt   int64
+}
+
+type ReadAllPermissionsByEmpireRow struct {
+	EmpireID  int64
+	GranteeID int64
+	Kind      string
+	StarName  string
+	OrbitNo   int64
+	Effdt     int64
+}
+
+// ReadAllPermissionsByEmpire returns the rights granted by or to an empire
+// as of the given date.
+func (q *Queries) ReadAllPermissionsByEmpire(ctx context.Context, arg ReadAllPermissionsByEmpireParams) ([]ReadAllPermissionsByEmpireRow, error) {
+	rows, err := q.db.QueryContext(ctx, readAllPermissionsByEmpire, arg.EmpireID, arg.AsOfDt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ReadAllPermissionsByEmpireRow
+	for rows.Next() {
+		var i ReadAllPermissionsByEmpireRow
+		if err := rows.Scan(
+			&i.EmpireID,
+			&i.GranteeID,
+			&i.Kind,
+			&i.StarName,
+			&i.OrbitNo,
+			&i.Effdt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const readEmpireByID = `-- name: ReadEmpireByID :one
 select games.code          as game_code,
        games.name          as game_name,
@@ -320,7 +612,7 @@ const readEmpireName = `-- name: ReadEmpireName :one
 select name, effdt, enddt
 from empire_name
 where empire_id = ?1
-  and (empire_name.effdt <= ?2 and ?2 <= empire_name.enddt)
+  and (empire_name.effdt <= ?2 and ?2 < empire_name.enddt)
 `
 
 type ReadEmpireNameParams struct {
@@ -342,6 +634,39 @@ func (q *Queries) ReadEmpireName(ctx context.Context, arg ReadEmpireNameParams)
 	return i, err
 }
 
+const readEmpirePermission = `-- name: ReadEmpirePermission :one
+select effdt
+from empire_permission
+where empire_id = ?1
+  and orbit_id = ?2
+  and kind = ?3
+  and grantee_id = ?4
+  and (effdt <= ?5 and ?5 < enddt)
+`
+
+type ReadEmpirePermissionParams struct {
+	EmpireID  int64
+	OrbitID   int64
+	Kind      string
+	GranteeID int64
+	AsOfDt    int64
+}
+
+// ReadEmpirePermission returns the effective date of a right granted by
+// an empire as of the given date.
+func (q *Queries) ReadEmpirePermission(ctx context.Context, arg ReadEmpirePermissionParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, readEmpirePermission,
+		arg.EmpireID,
+		arg.OrbitID,
+		arg.Kind,
+		arg.GranteeID,
+		arg.AsOfDt,
+	)
+	var effdt int64
+	err := row.Scan(&effdt)
+	return effdt, err
+}
+
 const readNextEmpireNumber = `-- name: ReadNextEmpireNumber :one
 select min(id) + 0 as empire_id
 from empire
@@ -356,6 +681,99 @@ func (q *Queries) ReadNextEmpireNumber(ctx context.Context) (int64, error) {
 	return empire_id, err
 }
 
+const readOrbitColonyOwners = `-- name: ReadOrbitColonyOwners :many
+select distinct sc_owner.empire_id
+from sc_location,
+     scs,
+     sc_owner
+where sc_location.orbit_id = ?1
+  and (sc_location.effdt <= ?2 and ?2 < sc_location.enddt)
+  and scs.id = sc_location.sc_id
+  and scs.sc_cd in ('COPN', 'CENC', 'CORB')
+  and sc_owner.sc_id = scs.id
+  and (sc_owner.effdt <= ?2 and ?2 < sc_owner.enddt)
+order by sc_owner.empire_id
+`
+
+type ReadOrbitColonyOwnersParams struct {
+	OrbitID int64
+	AsOfDt  int64
+}
+
+// ReadOrbitColonyOwners returns the empires that own a colony in an orbit
+// as of the given date.
+func (q *Queries) ReadOrbitColonyOwners(ctx context.Context, arg ReadOrbitColonyOwnersParams) ([]int64, error) {
+	rows, err := q.db.QueryContext(ctx, readOrbitColonyOwners, arg.OrbitID, arg.AsOfDt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int64
+	for rows.Next() {
+		var empire_id int64
+		if err := rows.Scan(&empire_id); err != nil {
+			return nil, err
+		}
+		items = append(items, empire_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateEmpirePermissionEndDt = `-- name: UpdateEmpirePermissionEndDt :exec
+update empire_permission
+set enddt = ?1
+where empire_id = ?2
+  and orbit_id = ?3
+  and kind = ?4
+  and grantee_id = ?5
+  and effdt = ?6
+`
+
+type UpdateEmpirePermissionEndDtParams struct {
+	Enddt     int64
+	EmpireID  int64
+	OrbitID   int64
+	Kind      string
+	GranteeID int64
+	Effdt     int64
+}
+
+// UpdateEmpirePermissionEndDt updates the end date for a right granted by an empire.
+func (q *Queries) UpdateEmpirePermissionEndDt(ctx context.Context, arg UpdateEmpirePermissionEndDtParams) error {
+	_, err := q.db.ExecContext(ctx, updateEmpirePermissionEndDt,
+		arg.Enddt,
+		arg.EmpireID,
+		arg.OrbitID,
+		arg.Kind,
+		arg.GranteeID,
+		arg.Effdt,
+	)
+	return err
+}
+
+const updateEmpirePermissionEndDtByTurn = `-- name: UpdateEmpirePermissionEndDtByTurn :exec
+update empire_permission
+set enddt = ?1
+where enddt = ?2
+`
+
+type UpdateEmpirePermissionEndDtByTurnParams struct {
+	MaxEnddt int64
+	Effdt    int64
+}
+
+// UpdateEmpirePermissionEndDtByTurn re-opens the rights revoked on a turn.
+func (q *Queries) UpdateEmpirePermissionEndDtByTurn(ctx context.Context, arg UpdateEmpirePermissionEndDtByTurnParams) error {
+	_, err := q.db.ExecContext(ctx, updateEmpirePermissionEndDtByTurn, arg.MaxEnddt, arg.Effdt)
+	return err
+}
+
 const updateEmpirePlayerEndDt = `-- name: UpdateEmpirePlayerEndDt :exec
 update empire_player
 set enddt = ?1
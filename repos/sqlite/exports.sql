@@ -42,10 +42,13 @@ select scs.id,
        sc_probe_star_result.effdt as as_of_dt,
        sc_probe_star_result.location,
        sc_probe_star_result.nbr_of_orbits
-from scs,
+from sc_owner,
+     scs,
      sc_probe_order,
      sc_probe_star_result
-where scs.empire_id = :empire_id
+where sc_owner.empire_id = :empire_id
+  and (sc_owner.effdt <= :as_of_dt and :as_of_dt < sc_owner.enddt)
+  and scs.id = sc_owner.sc_id
   and sc_probe_order.sc_id = scs.id
   and sc_probe_star_result.probe_id = sc_probe_order.id
   and sc_probe_star_result.effdt = :as_of_dt
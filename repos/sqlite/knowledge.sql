@@ -0,0 +1,240 @@
+--  Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+-- CreateEmpireDepositKnowledge records what an empire observed about a deposit.
+-- If the empire observed it earlier in the same turn, the row is replaced.
+--
+-- name: CreateEmpireDepositKnowledge :exec
+insert into empire_deposit_knowledge (empire_id, deposit_id, effdt, enddt,
+                                      orbit_id, deposit_no, deposit_kind, deposit_qty, yield_pct)
+values (:empire_id, :deposit_id, :effdt, :enddt,
+        :orbit_id, :deposit_no, :deposit_kind, :deposit_qty, :yield_pct)
+on conflict (empire_id, deposit_id, effdt) do update
+    set enddt = excluded.enddt,
+        orbit_id = excluded.orbit_id,
+        deposit_no = excluded.deposit_no,
+        deposit_kind = excluded.deposit_kind,
+        deposit_qty = excluded.deposit_qty,
+        yield_pct = excluded.yield_pct;
+
+-- CreateEmpireOrbitKnowledge records what an empire observed about an orbit.
+-- If the empire observed it earlier in the same turn, the row is replaced.
+--
+-- name: CreateEmpireOrbitKnowledge :exec
+insert into empire_orbit_knowledge (empire_id, orbit_id, effdt, enddt,
+                                    orbit_kind, fuel_est, gold_est, mets_est, nmts_est)
+values (:empire_id, :orbit_id, :effdt, :enddt,
+        :orbit_kind, :fuel_est, :gold_est, :mets_est, :nmts_est)
+on conflict (empire_id, orbit_id, effdt) do update
+    set enddt = excluded.enddt,
+        orbit_kind = excluded.orbit_kind,
+        fuel_est = excluded.fuel_est,
+        gold_est = excluded.gold_est,
+        mets_est = excluded.mets_est,
+        nmts_est = excluded.nmts_est;
+
+-- CreateEmpireSCKnowledge records what an empire observed about a foreign ship or colony.
+-- If the empire observed it earlier in the same turn, the row is replaced.
+--
+-- name: CreateEmpireSCKnowledge :exec
+insert into empire_sc_knowledge (empire_id, sc_id, effdt, enddt,
+                                 owner_id, sc_cd, orbit_id, is_on_surface)
+values (:empire_id, :sc_id, :effdt, :enddt,
+        :owner_id, :sc_cd, :orbit_id, :is_on_surface)
+on conflict (empire_id, sc_id, effdt) do update
+    set enddt = excluded.enddt,
+        owner_id = excluded.owner_id,
+        sc_cd = excluded.sc_cd,
+        orbit_id = excluded.orbit_id,
+        is_on_surface = excluded.is_on_surface;
+
+-- CreateEmpireStarKnowledge records what an empire observed about a star.
+-- If the empire observed it earlier in the same turn, the row is replaced.
+--
+-- name: CreateEmpireStarKnowledge :exec
+insert into empire_star_knowledge (empire_id, star_id, effdt, enddt,
+                                   system_id, nbr_of_orbits)
+values (:empire_id, :star_id, :effdt, :enddt,
+        :system_id, :nbr_of_orbits)
+on conflict (empire_id, star_id, effdt) do update
+    set enddt = excluded.enddt,
+        system_id = excluded.system_id,
+        nbr_of_orbits = excluded.nbr_of_orbits;
+
+-- DeleteEmpireDepositKnowledgeByTurn deletes the deposit knowledge created on a given turn.
+--
+-- name: DeleteEmpireDepositKnowledgeByTurn :exec
+delete
+from empire_deposit_knowledge
+where effdt = :effdt;
+
+-- DeleteEmpireOrbitKnowledgeByTurn deletes the orbit knowledge created on a given turn.
+--
+-- name: DeleteEmpireOrbitKnowledgeByTurn :exec
+delete
+from empire_orbit_knowledge
+where effdt = :effdt;
+
+-- DeleteEmpireSCKnowledgeByTurn deletes the foreign ship or colony knowledge created on a given turn.
+--
+-- name: DeleteEmpireSCKnowledgeByTurn :exec
+delete
+from empire_sc_knowledge
+where effdt = :effdt;
+
+-- DeleteEmpireStarKnowledgeByTurn deletes the star knowledge created on a given turn.
+--
+-- name: DeleteEmpireStarKnowledgeByTurn :exec
+delete
+from empire_star_knowledge
+where effdt = :effdt;
+
+-- ReadAllEmpireDepositKnowledge returns the deposits that an empire knows about
+-- as of a given turn, with the turn each was last observed.
+--
+-- name: ReadAllEmpireDepositKnowledge :many
+select empire_deposit_knowledge.orbit_id,
+       empire_deposit_knowledge.deposit_no,
+       empire_deposit_knowledge.deposit_kind,
+       empire_deposit_knowledge.deposit_qty,
+       empire_deposit_knowledge.yield_pct,
+       empire_deposit_knowledge.effdt as observed_dt
+from empire_deposit_knowledge
+where empire_deposit_knowledge.empire_id = :empire_id
+  and (empire_deposit_knowledge.effdt <= :as_of_dt and :as_of_dt < empire_deposit_knowledge.enddt)
+order by empire_deposit_knowledge.orbit_id, empire_deposit_knowledge.deposit_no;
+
+-- ReadAllEmpireOrbitKnowledge returns the orbits that an empire knows about
+-- as of a given turn, with the turn each was last observed.
+--
+-- name: ReadAllEmpireOrbitKnowledge :many
+select orbits.star_id,
+       empire_orbit_knowledge.orbit_id,
+       orbits.orbit_no,
+       empire_orbit_knowledge.orbit_kind,
+       empire_orbit_knowledge.fuel_est,
+       empire_orbit_knowledge.gold_est,
+       empire_orbit_knowledge.mets_est,
+       empire_orbit_knowledge.nmts_est,
+       empire_orbit_knowledge.effdt as observed_dt
+from empire_orbit_knowledge,
+     orbits
+where empire_orbit_knowledge.empire_id = :empire_id
+  and (empire_orbit_knowledge.effdt <= :as_of_dt and :as_of_dt < empire_orbit_knowledge.enddt)
+  and orbits.id = empire_orbit_knowledge.orbit_id
+order by orbits.star_id, orbits.orbit_no;
+
+-- ReadAllEmpireSCKnowledge returns the foreign ships and colonies that an
+-- empire knows about as of a given turn, with the turn each was last observed.
+--
+-- name: ReadAllEmpireSCKnowledge :many
+select empire_sc_knowledge.sc_id,
+       empire_sc_knowledge.owner_id,
+       empire_sc_knowledge.sc_cd,
+       orbits.star_id,
+       orbits.orbit_no,
+       empire_sc_knowledge.is_on_surface,
+       empire_sc_knowledge.effdt as observed_dt
+from empire_sc_knowledge,
+     orbits
+where empire_sc_knowledge.empire_id = :empire_id
+  and (empire_sc_knowledge.effdt <= :as_of_dt and :as_of_dt < empire_sc_knowledge.enddt)
+  and orbits.id = empire_sc_knowledge.orbit_id
+order by orbits.star_id, orbits.orbit_no, empire_sc_knowledge.sc_id;
+
+-- ReadAllEmpireStarKnowledge returns the stars (and their systems) that an
+-- empire knows about as of a given turn, with the turn each was last observed.
+--
+-- name: ReadAllEmpireStarKnowledge :many
+select systems.id                  as system_id,
+       systems.system_name,
+       systems.x,
+       systems.y,
+       systems.z,
+       stars.id                    as star_id,
+       stars.star_name,
+       empire_star_knowledge.nbr_of_orbits,
+       empire_star_knowledge.effdt as observed_dt
+from empire_star_knowledge,
+     stars,
+     systems
+where empire_star_knowledge.empire_id = :empire_id
+  and (empire_star_knowledge.effdt <= :as_of_dt and :as_of_dt < empire_star_knowledge.enddt)
+  and stars.id = empire_star_knowledge.star_id
+  and systems.id = empire_star_knowledge.system_id
+order by systems.id, stars.sequence;
+
+-- UpdateEmpireDepositKnowledgeEndDt end-dates the current deposit knowledge of an empire
+-- when a new observation is recorded.
+--
+-- name: UpdateEmpireDepositKnowledgeEndDt :exec
+update empire_deposit_knowledge
+set enddt = :effdt
+where empire_id = :empire_id
+  and deposit_id = :deposit_id
+  and effdt < :effdt
+  and enddt > :effdt;
+
+-- UpdateEmpireOrbitKnowledgeEndDt end-dates the current orbit knowledge of an empire
+-- when a new observation is recorded.
+--
+-- name: UpdateEmpireOrbitKnowledgeEndDt :exec
+update empire_orbit_knowledge
+set enddt = :effdt
+where empire_id = :empire_id
+  and orbit_id = :orbit_id
+  and effdt < :effdt
+  and enddt > :effdt;
+
+-- UpdateEmpireSCKnowledgeEndDt end-dates the current foreign ship or colony knowledge of an empire
+-- when a new observation is recorded.
+--
+-- name: UpdateEmpireSCKnowledgeEndDt :exec
+update empire_sc_knowledge
+set enddt = :effdt
+where empire_id = :empire_id
+  and sc_id = :sc_id
+  and effdt < :effdt
+  and enddt > :effdt;
+
+-- UpdateEmpireStarKnowledgeEndDt end-dates the current star knowledge of an empire
+-- when a new observation is recorded.
+--
+-- name: UpdateEmpireStarKnowledgeEndDt :exec
+update empire_star_knowledge
+set enddt = :effdt
+where empire_id = :empire_id
+  and star_id = :star_id
+  and effdt < :effdt
+  and enddt > :effdt;
+
+-- UpdateEmpireDepositKnowledgeEndDtByTurn re-opens the deposit knowledge that was end-dated
+-- on a given turn. It is used to undo the observations made on the turn.
+--
+-- name: UpdateEmpireDepositKnowledgeEndDtByTurn :exec
+update empire_deposit_knowledge
+set enddt = :max_enddt
+where enddt = :effdt;
+
+-- UpdateEmpireOrbitKnowledgeEndDtByTurn re-opens the orbit knowledge that was end-dated
+-- on a given turn. It is used to undo the observations made on the turn.
+--
+-- name: UpdateEmpireOrbitKnowledgeEndDtByTurn :exec
+update empire_orbit_knowledge
+set enddt = :max_enddt
+where enddt = :effdt;
+
+-- UpdateEmpireSCKnowledgeEndDtByTurn re-opens the foreign ship or colony knowledge that was end-dated
+-- on a given turn. It is used to undo the observations made on the turn.
+--
+-- name: UpdateEmpireSCKnowledgeEndDtByTurn :exec
+update empire_sc_knowledge
+set enddt = :max_enddt
+where enddt = :effdt;
+
+-- UpdateEmpireStarKnowledgeEndDtByTurn re-opens the star knowledge that was end-dated
+-- on a given turn. It is used to undo the observations made on the turn.
+--
+-- name: UpdateEmpireStarKnowledgeEndDtByTurn :exec
+update empire_star_knowledge
+set enddt = :max_enddt
+where enddt = :effdt;
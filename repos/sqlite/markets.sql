@@ -0,0 +1,99 @@
+-- CreateSCMarketOrder creates a new buy or sell order.
+--
+-- name: CreateSCMarketOrder :one
+insert into sc_market_order (sc_id, effdt, kind, unit_cd, tech_level, qty, price)
+values (:sc_id, :effdt, :kind, :unit_cd, :tech_level, :qty, :price)
+returning id;
+
+-- CreateSCMarketResult creates the result of a buy or sell order.
+--
+-- name: CreateSCMarketResult :exec
+insert into sc_market_result (market_id, effdt, empire_id, filled_qty, gold_qty, status, reason)
+values (:market_id, :effdt, :empire_id, :filled_qty, :gold_qty, :status, :reason);
+
+-- DeleteSCMarketResultsByTurn deletes the buy and sell results for a turn.
+--
+-- name: DeleteSCMarketResultsByTurn :exec
+delete
+from sc_market_result
+where effdt = :effdt;
+
+-- ReadAllMarketOrdersByTurn returns the buy and sell orders for a turn,
+-- with the owner and location of the ship or colony that gave them.
+--
+-- name: ReadAllMarketOrdersByTurn :many
+select sc_market_order.id as market_id,
+       sc_market_order.sc_id,
+       sc_owner.empire_id,
+       sc_location.orbit_id,
+       sc_market_order.kind,
+       sc_market_order.unit_cd,
+       sc_market_order.tech_level,
+       sc_market_order.qty,
+       sc_market_order.price
+from sc_market_order,
+     sc_owner,
+     sc_location
+where sc_market_order.effdt = :turn_no
+  and sc_owner.sc_id = sc_market_order.sc_id
+  and (sc_owner.effdt <= :turn_no and :turn_no < sc_owner.enddt)
+  and sc_location.sc_id = sc_market_order.sc_id
+  and (sc_location.effdt <= :turn_no and :turn_no < sc_location.enddt)
+order by sc_market_order.id;
+
+-- ReadAllMarketResultsByEmpire returns the results of the buy and sell
+-- orders given by an empire's ships and colonies on a turn.
+--
+-- name: ReadAllMarketResultsByEmpire :many
+select sc_market_order.id as market_id,
+       sc_market_order.sc_id,
+       sc_market_order.kind,
+       sc_market_order.unit_cd,
+       sc_market_order.tech_level,
+       sc_market_order.qty,
+       sc_market_order.price,
+       sc_market_result.filled_qty,
+       sc_market_result.gold_qty,
+       sc_market_result.status,
+       sc_market_result.reason
+from sc_market_result,
+     sc_market_order
+where sc_market_result.empire_id = :empire_id
+  and sc_market_result.effdt = :effdt
+  and sc_market_order.id = sc_market_result.market_id
+order by sc_market_order.sc_id, sc_market_order.id;
+
+-- CreateMarketPrice creates the published price of a product in an orbit
+-- for a turn.
+--
+-- name: CreateMarketPrice :exec
+insert into market_price (effdt, orbit_id, unit_cd, tech_level, traded_qty, low_price, high_price, avg_price, best_bid, best_ask)
+values (:effdt, :orbit_id, :unit_cd, :tech_level, :traded_qty, :low_price, :high_price, :avg_price, :best_bid, :best_ask);
+
+-- DeleteMarketPricesByTurn deletes the published prices for a turn.
+--
+-- name: DeleteMarketPricesByTurn :exec
+delete
+from market_price
+where effdt = :effdt;
+
+-- ReadMarketPrices returns the published prices in every orbit for a turn.
+--
+-- name: ReadMarketPrices :many
+select stars.star_name,
+       orbits.orbit_no,
+       market_price.unit_cd,
+       market_price.tech_level,
+       market_price.traded_qty,
+       market_price.low_price,
+       market_price.high_price,
+       market_price.avg_price,
+       market_price.best_bid,
+       market_price.best_ask
+from market_price,
+     orbits,
+     stars
+where market_price.effdt = :effdt
+  and orbits.id = market_price.orbit_id
+  and stars.id = orbits.star_id
+order by stars.star_name, orbits.orbit_no, market_price.unit_cd, market_price.tech_level;
@@ -60,6 +60,18 @@ type Empire struct {
 	IsActive     int64
 }
 
+type EmpireDepositKnowledge struct {
+	EmpireID    int64
+	DepositID   int64
+	Effdt       int64
+	Enddt       int64
+	OrbitID     int64
+	DepositNo   int64
+	DepositKind string
+	DepositQty  int64
+	YieldPct    int64
+}
+
 type EmpireName struct {
 	EmpireID int64
 	Effdt    int64
@@ -67,6 +79,77 @@ type EmpireName struct {
 	Name     string
 }
 
+type EmpireNameOrder struct {
+	ID       int64
+	EmpireID int64
+	Effdt    int64
+	Kind     string
+	TargetID int64
+	Name     string
+}
+
+type EmpireNameResult struct {
+	NameID int64
+	Effdt  int64
+	Status string
+	Reason string
+}
+
+type EmpireNewsOrder struct {
+	ID        int64
+	EmpireID  int64
+	Effdt     int64
+	SystemID  int64
+	OrbitNo   int64
+	Article   string
+	Signature string
+}
+
+type EmpireNewsResult struct {
+	NewsID int64
+	Effdt  int64
+	Status string
+	Reason string
+}
+
+type EmpireOrbitKnowledge struct {
+	EmpireID  int64
+	OrbitID   int64
+	Effdt     int64
+	Enddt     int64
+	OrbitKind string
+	FuelEst   int64
+	GoldEst   int64
+	MetsEst   int64
+	NmtsEst   int64
+}
+
+type EmpirePermission struct {
+	EmpireID  int64
+	OrbitID   int64
+	Kind      string
+	GranteeID int64
+	Effdt     int64
+	Enddt     int64
+}
+
+type EmpirePermissionOrder struct {
+	ID        int64
+	EmpireID  int64
+	Effdt     int64
+	Action    string
+	OrbitID   int64
+	Kind      string
+	GranteeID int64
+}
+
+type EmpirePermissionResult struct {
+	PermissionID int64
+	Effdt        int64
+	Status       string
+	Reason       string
+}
+
 type EmpirePlayer struct {
 	EmpireID int64
 	Effdt    int64
@@ -75,6 +158,26 @@ type EmpirePlayer struct {
 	Email    string
 }
 
+type EmpireScKnowledge struct {
+	EmpireID    int64
+	ScID        int64
+	Effdt       int64
+	Enddt       int64
+	OwnerID     int64
+	ScCd        string
+	OrbitID     int64
+	IsOnSurface int64
+}
+
+type EmpireStarKnowledge struct {
+	EmpireID    int64
+	StarID      int64
+	Effdt       int64
+	Enddt       int64
+	SystemID    int64
+	NbrOfOrbits int64
+}
+
 type EmpireStarName struct {
 	EmpireID int64
 	StarID   int64
@@ -103,6 +206,19 @@ type Games struct {
 	UpdatedAt    time.Time
 }
 
+type MarketPrice struct {
+	Effdt     int64
+	OrbitID   int64
+	UnitCd    string
+	TechLevel int64
+	TradedQty int64
+	LowPrice  float64
+	HighPrice float64
+	AvgPrice  float64
+	BestBid   float64
+	BestAsk   float64
+}
+
 type MetaMigrations struct {
 	Version   int64
 	Comment   string
@@ -110,6 +226,16 @@ type MetaMigrations struct {
 	CreatedAt time.Time
 }
 
+type News struct {
+	ID        int64
+	Effdt     int64
+	EmpireID  sql.NullInt64
+	SystemID  sql.NullInt64
+	OrbitNo   int64
+	Article   string
+	Signature string
+}
+
 type OrbitCodes struct {
 	Code string
 	Name string
@@ -132,11 +258,108 @@ type PopulationCodes struct {
 	SortOrder   int64
 }
 
+type ScAssemblyOrder struct {
+	ID            int64
+	ScID          int64
+	Effdt         int64
+	Kind          string
+	UnitCd        string
+	TechLevel     int64
+	Qty           int64
+	GroupNo       int64
+	DepositNo     int64
+	ItemCd        string
+	ItemTechLevel int64
+}
+
+type ScAssemblyResult struct {
+	AssemblyID int64
+	Effdt      int64
+	EmpireID   int64
+	Qty        int64
+	CnwUsed    int64
+	Status     string
+	Reason     string
+}
+
 type ScCodes struct {
+	Code       string
+	Name       string
+	IsShip     int64
+	IsSurface  int64
+	TypeFactor int64
+}
+
+type ScCombatLoss struct {
+	ScID      int64
+	Effdt     int64
+	EmpireID  int64
 	Code      string
-	Name      string
-	IsShip    int64
-	IsSurface int64
+	TechLevel int64
+	Qty       int64
+}
+
+type ScCombatOrder struct {
+	ID           int64
+	ScID         int64
+	Effdt        int64
+	Kind         string
+	TargetID     int64
+	SupportID    int64
+	PctCommitted int64
+	RaidUnitCd   string
+}
+
+type ScCombatResult struct {
+	CombatID       int64
+	Effdt          int64
+	EmpireID       int64
+	TargetEmpireID int64
+	Rounds         int64
+	MassPct        float64
+	Status         string
+	Reason         string
+}
+
+type ScDraftOrder struct {
+	ID           int64
+	ScID         int64
+	Effdt        int64
+	Kind         string
+	PopulationCd string
+	Qty          int64
+}
+
+type ScDraftResult struct {
+	DraftID  int64
+	Effdt    int64
+	EmpireID int64
+	Qty      int64
+	CngdUsed int64
+	Status   string
+	Reason   string
+}
+
+type ScEspionageOrder struct {
+	ID       int64
+	ScID     int64
+	Effdt    int64
+	Kind     string
+	TargetID int64
+	Qty      int64
+}
+
+type ScEspionageResult struct {
+	EspionageID    int64
+	Effdt          int64
+	EmpireID       int64
+	TargetEmpireID int64
+	AgentsLost     int64
+	AgentsThrough  int64
+	CounterLost    int64
+	EffectQty      int64
+	Status         string
+	Reason         string
 }
 
 type ScGroup struct {
@@ -162,16 +385,17 @@ type ScGroupNo struct {
 }
 
 type ScGroupProductionSummary struct {
-	GroupID      int64
-	ProductionDt int64
-	FuelConsumed int64
-	GoldConsumed int64
-	MetsConsumed int64
-	NmtsConsumed int64
-	ProConsumed  int64
-	UskConsumed  int64
-	AutConsumed  int64
-	QtyProduced  int64
+	GroupID       int64
+	ProductionDt  int64
+	FuelConsumed  int64
+	PowerConsumed int64
+	GoldConsumed  int64
+	MetsConsumed  int64
+	NmtsConsumed  int64
+	ProConsumed   int64
+	UskConsumed   int64
+	AutConsumed   int64
+	QtyProduced   int64
 }
 
 type ScGroupProductionWipSummary struct {
@@ -200,17 +424,18 @@ type ScGroupUnit struct {
 }
 
 type ScGroupUnitProduction struct {
-	GroupID      int64
-	TechLevel    int64
-	ProductionDt int64
-	FuelConsumed int64
-	GoldConsumed int64
-	MetsConsumed int64
-	NmtsConsumed int64
-	ProConsumed  int64
-	UskConsumed  int64
-	AutConsumed  int64
-	QtyProduced  int64
+	GroupID       int64
+	TechLevel     int64
+	ProductionDt  int64
+	FuelConsumed  int64
+	PowerConsumed int64
+	GoldConsumed  int64
+	MetsConsumed  int64
+	NmtsConsumed  int64
+	ProConsumed   int64
+	UskConsumed   int64
+	AutConsumed   int64
+	QtyProduced   int64
 }
 
 type ScGroupUnitProductionWip struct {
@@ -235,6 +460,26 @@ type ScInventory struct {
 	IsStored      int64
 }
 
+type ScJumpOrder struct {
+	ID      int64
+	ScID    int64
+	Effdt   int64
+	OrbitID int64
+}
+
+type ScJumpResult struct {
+	JumpID      int64
+	Effdt       int64
+	FromOrbitID int64
+	ToOrbitID   int64
+	Distance    float64
+	Mass        float64
+	EnginesUsed int64
+	FuelUsed    int64
+	Status      string
+	Reason      string
+}
+
 type ScLocation struct {
 	ScID        int64
 	Effdt       int64
@@ -243,6 +488,27 @@ type ScLocation struct {
 	IsOnSurface int64
 }
 
+type ScMarketOrder struct {
+	ID        int64
+	ScID      int64
+	Effdt     int64
+	Kind      string
+	UnitCd    string
+	TechLevel int64
+	Qty       int64
+	Price     float64
+}
+
+type ScMarketResult struct {
+	MarketID  int64
+	Effdt     int64
+	EmpireID  int64
+	FilledQty int64
+	GoldQty   int64
+	Status    string
+	Reason    string
+}
+
 type ScMiningSummary struct {
 	ScID         int64
 	ProductionDt int64
@@ -252,6 +518,28 @@ type ScMiningSummary struct {
 	NmtsProduced int64
 }
 
+type ScMoveOrder struct {
+	ID          int64
+	ScID        int64
+	Effdt       int64
+	OrbitID     int64
+	IsOnSurface int64
+}
+
+type ScMoveResult struct {
+	MoveID          int64
+	Effdt           int64
+	FromOrbitID     int64
+	FromIsOnSurface int64
+	ToOrbitID       int64
+	ToIsOnSurface   int64
+	Mass            float64
+	Thrust          float64
+	FuelUsed        int64
+	Status          string
+	Reason          string
+}
+
 type ScName struct {
 	ScID  int64
 	Effdt int64
@@ -259,6 +547,13 @@ type ScName struct {
 	Name  string
 }
 
+type ScOwner struct {
+	ScID     int64
+	Effdt    int64
+	Enddt    int64
+	EmpireID int64
+}
+
 type ScPopulation struct {
 	ScID         int64
 	PopulationCd string
@@ -277,6 +572,17 @@ type ScProbeOrder struct {
 	Kind     string
 }
 
+type ScProbeResult struct {
+	ProbeID       int64
+	Effdt         int64
+	UnitCd        string
+	UnitTechLevel int64
+	UnitsUsed     int64
+	FuelUsed      int64
+	Status        string
+	Reason        string
+}
+
 type ScProbeStarOrbitResult struct {
 	ProbeID   int64
 	Effdt     int64
@@ -307,6 +613,98 @@ type ScRates struct {
 	DeathRate float64
 }
 
+type ScRecycleOrder struct {
+	ID        int64
+	ScID      int64
+	Effdt     int64
+	Kind      string
+	GroupKind string
+	GroupNo   int64
+	UnitCd    string
+	TechLevel int64
+	Qty       int64
+}
+
+type ScRecycleResult struct {
+	RecycleID    int64
+	Effdt        int64
+	EmpireID     int64
+	Qty          int64
+	MetsReturned int64
+	NmtsReturned int64
+	Status       string
+	Reason       string
+}
+
+type ScResearchOrder struct {
+	ID    int64
+	ScID  int64
+	Effdt int64
+}
+
+type ScResearchResult struct {
+	ResearchID    int64
+	Effdt         int64
+	EmpireID      int64
+	FromTechLevel int64
+	ToTechLevel   int64
+	RschUsed      int64
+	Status        string
+	Reason        string
+}
+
+type ScRetoolOrder struct {
+	ID            int64
+	ScID          int64
+	Effdt         int64
+	GroupNo       int64
+	ItemCd        string
+	ItemTechLevel int64
+}
+
+type ScRetoolResult struct {
+	RetoolID int64
+	Effdt    int64
+	EmpireID int64
+	Status   string
+	Reason   string
+}
+
+type ScSetupItem struct {
+	SetupID   int64
+	LineNo    int64
+	UnitCd    string
+	TechLevel int64
+	Qty       int64
+}
+
+type ScSetupOrder struct {
+	ID      int64
+	ScID    int64
+	Effdt   int64
+	Kind    string
+	OrbitID int64
+}
+
+type ScSetupResult struct {
+	SetupID  int64
+	Effdt    int64
+	EmpireID int64
+	NewScID  int64
+	ScCd     string
+	Status   string
+	Reason   string
+}
+
+type ScSurveyDepositResult struct {
+	SurveyID    int64
+	Effdt       int64
+	DepositNo   int64
+	DepositKind string
+	DepositQty  int64
+	YieldPct    int64
+}
+
 type ScSurveyOrbitResult struct {
 	SurveyID       int64
 	Effdt          int64
@@ -326,6 +724,34 @@ type ScSurveyOrder struct {
 	Kind     string
 }
 
+type ScSurveyResult struct {
+	SurveyID int64
+	Effdt    int64
+	Status   string
+	Reason   string
+}
+
+type ScTransferOrder struct {
+	ID        int64
+	ScID      int64
+	Effdt     int64
+	TargetID  int64
+	UnitCd    string
+	TechLevel int64
+	Qty       int64
+}
+
+type ScTransferResult struct {
+	TransferID int64
+	Effdt      int64
+	EmpireID   int64
+	Qty        int64
+	Mass       float64
+	FuelUsed   int64
+	Status     string
+	Reason     string
+}
+
 type Scs struct {
 	ID          int64
 	EmpireID    int64
@@ -0,0 +1,260 @@
+-- CreateEmpireNameOrder creates a new name order.
+--
+-- name: CreateEmpireNameOrder :one
+insert into empire_name_order (empire_id, effdt, kind, target_id, name)
+values (:empire_id, :effdt, :kind, :target_id, :name)
+returning id;
+
+-- CreateEmpireNameResult creates the result of a name order.
+--
+-- name: CreateEmpireNameResult :exec
+insert into empire_name_result (name_id, effdt, status, reason)
+values (:name_id, :effdt, :status, :reason);
+
+-- DeleteEmpireNameResultsByTurn deletes the name results for a turn.
+--
+-- name: DeleteEmpireNameResultsByTurn :exec
+delete
+from empire_name_result
+where effdt = :effdt;
+
+-- ReadAllNameOrdersByTurn returns the name orders for a turn, in the order
+-- they were given.
+--
+-- name: ReadAllNameOrdersByTurn :many
+select id as name_id,
+       empire_id,
+       kind,
+       target_id,
+       name
+from empire_name_order
+where effdt = :turn_no
+order by id;
+
+-- ReadAllNameResultsByEmpire returns the results of the name orders given
+-- by an empire on a turn. coordinates is the system or star that was named,
+-- or an empty string if the order named the empire or a ship or colony.
+--
+-- name: ReadAllNameResultsByEmpire :many
+select empire_name_order.id as name_id,
+       empire_name_order.kind,
+       empire_name_order.target_id,
+       coalesce(systems.system_name, stars.star_name, '') as coordinates,
+       empire_name_order.name,
+       empire_name_result.status,
+       empire_name_result.reason
+from empire_name_order,
+     empire_name_result
+         left join systems on empire_name_order.kind = 'system' and systems.id = empire_name_order.target_id
+         left join stars on empire_name_order.kind = 'star' and stars.id = empire_name_order.target_id
+where empire_name_order.empire_id = :empire_id
+  and empire_name_order.effdt = :effdt
+  and empire_name_result.name_id = empire_name_order.id
+  and empire_name_result.effdt = empire_name_order.effdt
+order by empire_name_order.id;
+
+-- ReadEmpireKnowsSystem returns the number of ways an empire knows about a
+-- system as of the given date. An empire knows about a system if it has
+-- observed one of its stars or has a ship or colony in the system.
+--
+-- name: ReadEmpireKnowsSystem :one
+select count(*)
+from systems
+where systems.id = :system_id
+  and (exists (select 1
+               from empire_star_knowledge
+               where empire_star_knowledge.empire_id = :empire_id
+                 and empire_star_knowledge.system_id = systems.id
+                 and (empire_star_knowledge.effdt <= :as_of_dt and :as_of_dt < empire_star_knowledge.enddt))
+    or exists (select 1
+               from sc_owner,
+                    sc_location,
+                    orbits
+               where sc_owner.empire_id = :empire_id
+                 and (sc_owner.effdt <= :as_of_dt and :as_of_dt < sc_owner.enddt)
+                 and sc_location.sc_id = sc_owner.sc_id
+                 and (sc_location.effdt <= :as_of_dt and :as_of_dt < sc_location.enddt)
+                 and orbits.id = sc_location.orbit_id
+                 and orbits.system_id = systems.id));
+
+-- ReadEmpireKnowsStar returns the number of ways an empire knows about a
+-- star as of the given date. An empire knows about a star if it has
+-- observed it or has a ship or colony in one of its orbits.
+--
+-- name: ReadEmpireKnowsStar :one
+select count(*)
+from stars
+where stars.id = :star_id
+  and (exists (select 1
+               from empire_star_knowledge
+               where empire_star_knowledge.empire_id = :empire_id
+                 and empire_star_knowledge.star_id = stars.id
+                 and (empire_star_knowledge.effdt <= :as_of_dt and :as_of_dt < empire_star_knowledge.enddt))
+    or exists (select 1
+               from sc_owner,
+                    sc_location,
+                    orbits
+               where sc_owner.empire_id = :empire_id
+                 and (sc_owner.effdt <= :as_of_dt and :as_of_dt < sc_owner.enddt)
+                 and sc_location.sc_id = sc_owner.sc_id
+                 and (sc_location.effdt <= :as_of_dt and :as_of_dt < sc_location.enddt)
+                 and orbits.id = sc_location.orbit_id
+                 and orbits.star_id = stars.id));
+
+-- DeleteEmpireNamesByTurn deletes the empire names given on a turn.
+--
+-- name: DeleteEmpireNamesByTurn :exec
+delete
+from empire_name
+where effdt = :effdt;
+
+-- UpdateEmpireNameEndDtByTurn re-opens the empire names that were replaced
+-- on a turn.
+--
+-- name: UpdateEmpireNameEndDtByTurn :exec
+update empire_name
+set enddt = :max_enddt
+where enddt = :effdt;
+
+-- CorrectEmpireSystemName updates the name an empire gave a system.
+--
+-- name: CorrectEmpireSystemName :exec
+update empire_system_name
+set name = :name
+where empire_id = :empire_id
+  and system_id = :system_id
+  and effdt = :effdt;
+
+-- ReadEmpireSystemName returns the name an empire gave a system as of the
+-- given date.
+--
+-- name: ReadEmpireSystemName :one
+select name, effdt, enddt
+from empire_system_name
+where empire_id = :empire_id
+  and system_id = :system_id
+  and (effdt <= :as_of_dt and :as_of_dt < enddt);
+
+-- ReadAllEmpireSystemNames returns the names an empire gave systems as of
+-- the given date.
+--
+-- name: ReadAllEmpireSystemNames :many
+select system_id, name
+from empire_system_name
+where empire_id = :empire_id
+  and (effdt <= :as_of_dt and :as_of_dt < enddt)
+order by system_id;
+
+-- UpdateEmpireSystemNameEndDt updates the end date for a system name.
+--
+-- name: UpdateEmpireSystemNameEndDt :exec
+update empire_system_name
+set enddt = :enddt
+where empire_id = :empire_id
+  and system_id = :system_id
+  and effdt = :effdt;
+
+-- DeleteEmpireSystemNamesByTurn deletes the system names given on a turn.
+--
+-- name: DeleteEmpireSystemNamesByTurn :exec
+delete
+from empire_system_name
+where effdt = :effdt;
+
+-- UpdateEmpireSystemNameEndDtByTurn re-opens the system names that were
+-- replaced on a turn.
+--
+-- name: UpdateEmpireSystemNameEndDtByTurn :exec
+update empire_system_name
+set enddt = :max_enddt
+where enddt = :effdt;
+
+-- CreateEmpireStarName creates a new empire star name record.
+--
+-- name: CreateEmpireStarName :exec
+insert into empire_star_name (empire_id, star_id, effdt, enddt, name)
+values (:empire_id, :star_id, :effdt, :enddt, :name);
+
+-- CorrectEmpireStarName updates the name an empire gave a star.
+--
+-- name: CorrectEmpireStarName :exec
+update empire_star_name
+set name = :name
+where empire_id = :empire_id
+  and star_id = :star_id
+  and effdt = :effdt;
+
+-- ReadEmpireStarName returns the name an empire gave a star as of the
+-- given date.
+--
+-- name: ReadEmpireStarName :one
+select name, effdt, enddt
+from empire_star_name
+where empire_id = :empire_id
+  and star_id = :star_id
+  and (effdt <= :as_of_dt and :as_of_dt < enddt);
+
+-- ReadAllEmpireStarNames returns the names an empire gave stars as of the
+-- given date.
+--
+-- name: ReadAllEmpireStarNames :many
+select star_id, name
+from empire_star_name
+where empire_id = :empire_id
+  and (effdt <= :as_of_dt and :as_of_dt < enddt)
+order by star_id;
+
+-- UpdateEmpireStarNameEndDt updates the end date for a star name.
+--
+-- name: UpdateEmpireStarNameEndDt :exec
+update empire_star_name
+set enddt = :enddt
+where empire_id = :empire_id
+  and star_id = :star_id
+  and effdt = :effdt;
+
+-- DeleteEmpireStarNamesByTurn deletes the star names given on a turn.
+--
+-- name: DeleteEmpireStarNamesByTurn :exec
+delete
+from empire_star_name
+where effdt = :effdt;
+
+-- UpdateEmpireStarNameEndDtByTurn re-opens the star names that were
+-- replaced on a turn.
+--
+-- name: UpdateEmpireStarNameEndDtByTurn :exec
+update empire_star_name
+set enddt = :max_enddt
+where enddt = :effdt;
+
+-- CorrectSCName updates the name of a ship or colony.
+--
+-- name: CorrectSCName :exec
+update sc_name
+set name = :name
+where sc_id = :sc_id
+  and effdt = :effdt;
+
+-- ReadSCName returns the name of a ship or colony as of the given date.
+--
+-- name: ReadSCName :one
+select name, effdt, enddt
+from sc_name
+where sc_id = :sc_id
+  and (effdt <= :as_of_dt and :as_of_dt < enddt);
+
+-- DeleteSCNamesByTurn deletes the ship and colony names given on a turn.
+--
+-- name: DeleteSCNamesByTurn :exec
+delete
+from sc_name
+where effdt = :effdt;
+
+-- UpdateSCNameEndDtByTurn re-opens the ship and colony names that were
+-- replaced on a turn.
+--
+-- name: UpdateSCNameEndDtByTurn :exec
+update sc_name
+set enddt = :max_enddt
+where enddt = :effdt;
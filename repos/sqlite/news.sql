@@ -0,0 +1,113 @@
+-- CreateEmpireNewsOrder creates a new news order.
+--
+-- name: CreateEmpireNewsOrder :one
+insert into empire_news_order (empire_id, effdt, system_id, orbit_no, article, signature)
+values (:empire_id, :effdt, :system_id, :orbit_no, :article, :signature)
+returning id;
+
+-- CreateEmpireNewsResult creates the result of a news order.
+--
+-- name: CreateEmpireNewsResult :exec
+insert into empire_news_result (news_id, effdt, status, reason)
+values (:news_id, :effdt, :status, :reason);
+
+-- DeleteEmpireNewsResultsByTurn deletes the news results for a turn.
+--
+-- name: DeleteEmpireNewsResultsByTurn :exec
+delete
+from empire_news_result
+where effdt = :effdt;
+
+-- ReadAllNewsOrdersByTurn returns the news orders for a turn, in the order
+-- they were given.
+--
+-- name: ReadAllNewsOrdersByTurn :many
+select id as news_id,
+       empire_id,
+       system_id,
+       orbit_no,
+       article,
+       signature
+from empire_news_order
+where effdt = :turn_no
+order by id;
+
+-- ReadAllNewsResultsByEmpire returns the results of the news orders given
+-- by an empire on a turn.
+--
+-- name: ReadAllNewsResultsByEmpire :many
+select empire_news_order.id as news_id,
+       systems.system_name,
+       empire_news_order.orbit_no,
+       empire_news_result.status,
+       empire_news_result.reason
+from empire_news_order,
+     empire_news_result,
+     systems
+where empire_news_order.empire_id = :empire_id
+  and empire_news_order.effdt = :effdt
+  and empire_news_result.news_id = empire_news_order.id
+  and empire_news_result.effdt = empire_news_order.effdt
+  and systems.id = empire_news_order.system_id
+order by empire_news_order.id;
+
+-- CreateNewsItem publishes an item in the Galactic News.
+--
+-- name: CreateNewsItem :exec
+insert into news (effdt, empire_id, system_id, orbit_no, article, signature)
+values (:effdt, :empire_id, :system_id, :orbit_no, :article, :signature);
+
+-- DeleteEmpireNewsItemsByTurn deletes the items published by empires on a
+-- turn. Announcements posted by the GM are kept.
+--
+-- name: DeleteEmpireNewsItemsByTurn :exec
+delete
+from news
+where effdt = :effdt
+  and empire_id is not null;
+
+-- ReadAllNewsByEmpire returns the items published on a turn that an empire
+-- can observe. These are the announcements sent to every empire and the
+-- items posted in systems within range of a system where the empire has a
+-- ship or colony. range_sq is the square of the range.
+--
+-- name: ReadAllNewsByEmpire :many
+select news.id as news_id,
+       coalesce(systems.system_name, '') as system_name,
+       news.orbit_no,
+       news.article,
+       news.signature
+from news
+         left join systems on systems.id = news.system_id
+where news.effdt = :effdt
+  and (news.system_id is null
+    or exists (select 1
+               from sc_owner,
+                    sc_location,
+                    orbits,
+                    systems as observer
+               where sc_owner.empire_id = :empire_id
+                 and (sc_owner.effdt <= :effdt and :effdt < sc_owner.enddt)
+                 and sc_location.sc_id = sc_owner.sc_id
+                 and (sc_location.effdt <= :effdt and :effdt < sc_location.enddt)
+                 and orbits.id = sc_location.orbit_id
+                 and observer.id = orbits.system_id
+                 and (observer.x - systems.x) * (observer.x - systems.x)
+                         + (observer.y - systems.y) * (observer.y - systems.y)
+                         + (observer.z - systems.z) * (observer.z - systems.z) <= :range_sq))
+order by news.system_id is not null, systems.system_name, news.orbit_no, news.id;
+
+-- ReadEmpireSCCountInSystem returns the number of ships and colonies that
+-- an empire has in a system as of the given date.
+--
+-- name: ReadEmpireSCCountInSystem :one
+select count(*)
+from sc_owner,
+     sc_location,
+     orbits
+where sc_owner.empire_id = :empire_id
+  and (sc_owner.effdt <= :as_of_dt and :as_of_dt < sc_owner.enddt)
+  and sc_location.sc_id = sc_owner.sc_id
+  and (sc_location.effdt <= :as_of_dt and :as_of_dt < sc_location.enddt)
+  and orbits.id = sc_location.orbit_id
+  and orbits.system_id = :system_id;
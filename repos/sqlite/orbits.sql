@@ -5,6 +5,75 @@ insert into orbits (system_id, star_id, orbit_no, kind, habitability, nbr_of_dep
 values (:system_id, :star_id, :orbit_no, :kind, :habitability, :nbr_of_deposits)
 returning id;
 
+-- ReadAllOrbitEstimatesByStar returns the kind of every orbit around a star
+-- along with the estimated (log10) quantity of each resource on the planet.
+--
+-- name: ReadAllOrbitEstimatesByStar :many
+select orbits.id        as orbit_id,
+       orbits.orbit_no,
+       orbits.kind      as orbit_kind,
+       deposits_summary.fuel_est_qty,
+       deposits_summary.gold_est_qty,
+       deposits_summary.mets_est_qty,
+       deposits_summary.nmts_est_qty
+from orbits,
+     deposits_summary
+where orbits.star_id = :star_id
+  and deposits_summary.orbit_id = orbits.id
+  and (deposits_summary.effdt <= :as_of_dt and :as_of_dt < deposits_summary.enddt)
+order by orbits.orbit_no;
+
+-- ReadOrbitByCoordinates returns the orbit with the given number around
+-- the star at the given coordinates.
+--
+-- name: ReadOrbitByCoordinates :one
+select orbits.id
+from systems,
+     stars,
+     orbits
+where systems.x = :x
+  and systems.y = :y
+  and systems.z = :z
+  and stars.system_id = systems.id
+  and stars.sequence = :sequence
+  and orbits.star_id = stars.id
+  and orbits.orbit_no = :orbit_no;
+
+-- ReadOrbitByStarOrbitNo returns the orbit with the given number around a star.
+--
+-- name: ReadOrbitByStarOrbitNo :one
+select id
+from orbits
+where star_id = :star_id
+  and orbit_no = :orbit_no;
+
+-- ReadOrbitFarmlandInUse returns the number of assembled farms on the surface of an orbit.
+--
+-- name: ReadOrbitFarmlandInUse :one
+select cast(coalesce(sum(sc_inventory.qty), 0) as integer) as farmland_in_use
+from sc_location,
+     sc_inventory
+where sc_location.orbit_id = :orbit_id
+  and sc_location.is_on_surface = 1
+  and (sc_location.effdt <= :as_of_dt and :as_of_dt < sc_location.enddt)
+  and sc_inventory.sc_id = sc_location.sc_id
+  and sc_inventory.unit_cd = 'FRM'
+  and sc_inventory.is_assembled = 1
+  and (sc_inventory.effdt <= :as_of_dt and :as_of_dt < sc_inventory.enddt);
+
+-- ReadOrbitPopulation returns the total population of the ships and colonies
+-- on the surface of an orbit.
+--
+-- name: ReadOrbitPopulation :one
+select cast(coalesce(sum(sc_population.qty), 0) as integer) as population
+from sc_location,
+     sc_population
+where sc_location.orbit_id = :orbit_id
+  and sc_location.is_on_surface = 1
+  and (sc_location.effdt <= :as_of_dt and :as_of_dt < sc_location.enddt)
+  and sc_population.sc_id = sc_location.sc_id
+  and (sc_population.effdt <= :as_of_dt and :as_of_dt < sc_population.enddt);
+
 -- ReadOrbitStar returns the star for a given orbit.
 --
 -- name: ReadOrbitStar :one
@@ -12,7 +81,8 @@ select systems.id as system_id,
        systems.system_name,
        stars.id   as star_id,
        stars.star_name,
-       orbits.orbit_no
+       orbits.orbit_no,
+       orbits.habitability
 from orbits,
      stars,
      systems
@@ -39,12 +39,185 @@ func (q *Queries) CreateOrbit(ctx context.Context, arg CreateOrbitParams) (int64
 	return id, err
 }
 
+const readAllOrbitEstimatesByStar = `-- name: ReadAllOrbitEstimatesByStar :many
+select orbits.id        as orbit_id,
+       orbits.orbit_no,
+       orbits.kind      as orbit_kind,
+       deposits_summary.fuel_est_qty,
+       deposits_summary.gold_est_qty,
+       deposits_summary.mets_est_qty,
+       deposits_summary.nmts_est_qty
+from orbits,
+     deposits_summary
+where orbits.star_id = ?1
+  and deposits_summary.orbit_id = orbits.id
+  and (deposits_summary.effdt <= ?2 and ?2 < deposits_summary.enddt)
+order by orbits.orbit_no
+`
+
+type ReadAllOrbitEstimatesByStarParams struct {
+	StarID int64
+	AsOfDt int64
+}
+
+type ReadAllOrbitEstimatesByStarRow struct {
+	OrbitID    int64
+	OrbitNo    int64
+	OrbitKind  string
+	FuelEstQty int64
+	GoldEstQty int64
+	MetsEstQty int64
+	NmtsEstQty int64
+}
+
+// ReadAllOrbitEstimatesByStar returns the kind of every orbit around a star
+// along with the estimated (log10) quantity of each resource on the planet.
+func (q *Queries) ReadAllOrbitEstimatesByStar(ctx context.Context, arg ReadAllOrbitEstimatesByStarParams) ([]ReadAllOrbitEstimatesByStarRow, error) {
+	rows, err := q.db.QueryContext(ctx, readAllOrbitEstimatesByStar, arg.StarID, arg.AsOfDt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ReadAllOrbitEstimatesByStarRow
+	for rows.Next() {
+		var i ReadAllOrbitEstimatesByStarRow
+		if err := rows.Scan(
+			&i.OrbitID,
+			&i.OrbitNo,
+			&i.OrbitKind,
+			&i.FuelEstQty,
+			&i.GoldEstQty,
+			&i.MetsEstQty,
+			&i.NmtsEstQty,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const readOrbitByCoordinates = `-- name: ReadOrbitByCoordinates :one
+select orbits.id
+from systems,
+     stars,
+     orbits
+where systems.x = ?1
+  and systems.y = ?2
+  and systems.z = ?3
+  and stars.system_id = systems.id
+  and stars.sequence = ?4
+  and orbits.star_id = stars.id
+  and orbits.orbit_no = ?5
+`
+
+type ReadOrbitByCoordinatesParams struct {
+	X        int64
+	Y        int64
+	Z        int64
+	Sequence string
+	OrbitNo  int64
+}
+
+// ReadOrbitByCoordinates returns the orbit with the given number around
+// the star at the given coordinates.
+func (q *Queries) ReadOrbitByCoordinates(ctx context.Context, arg ReadOrbitByCoordinatesParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, readOrbitByCoordinates,
+		arg.X,
+		arg.Y,
+		arg.Z,
+		arg.Sequence,
+		arg.OrbitNo,
+	)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
+const readOrbitByStarOrbitNo = `-- name: ReadOrbitByStarOrbitNo :one
+select id
+from orbits
+where star_id = ?1
+  and orbit_no = ?2
+`
+
+type ReadOrbitByStarOrbitNoParams struct {
+	StarID  int64
+	OrbitNo int64
+}
+
+// ReadOrbitByStarOrbitNo returns the orbit with the given number around a star.
+func (q *Queries) ReadOrbitByStarOrbitNo(ctx context.Context, arg ReadOrbitByStarOrbitNoParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, readOrbitByStarOrbitNo, arg.StarID, arg.OrbitNo)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
+const readOrbitFarmlandInUse = `-- name: ReadOrbitFarmlandInUse :one
+select cast(coalesce(sum(sc_inventory.qty), 0) as integer) as farmland_in_use
+from sc_location,
+     sc_inventory
+where sc_location.orbit_id = ?1
+  and sc_location.is_on_surface = 1
+  and (sc_location.effdt <= ?2 and ?2 < sc_location.enddt)
+  and sc_inventory.sc_id = sc_location.sc_id
+  and sc_inventory.unit_cd = 'FRM'
+  and sc_inventory.is_assembled = 1
+  and (sc_inventory.effdt <= ?2 and ?2 < sc_inventory.enddt)
+`
+
+type ReadOrbitFarmlandInUseParams struct {
+	OrbitID int64
+	AsOfDt  int64
+}
+
+// ReadOrbitFarmlandInUse returns the number of assembled farms on the surface of an orbit.
+func (q *Queries) ReadOrbitFarmlandInUse(ctx context.Context, arg ReadOrbitFarmlandInUseParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, readOrbitFarmlandInUse, arg.OrbitID, arg.AsOfDt)
+	var farmland_in_use int64
+	err := row.Scan(&farmland_in_use)
+	return farmland_in_use, err
+}
+
+const readOrbitPopulation = `-- name: ReadOrbitPopulation :one
+select cast(coalesce(sum(sc_population.qty), 0) as integer) as population
+from sc_location,
+     sc_population
+where sc_location.orbit_id = ?1
+  and sc_location.is_on_surface = 1
+  and (sc_location.effdt <= ?2 and ?2 < sc_location.enddt)
+  and sc_population.sc_id = sc_location.sc_id
+  and (sc_population.effdt <= ?2 and ?2 < sc_population.enddt)
+`
+
+type ReadOrbitPopulationParams struct {
+	OrbitID int64
+	AsOfDt  int64
+}
+
+// ReadOrbitPopulation returns the total population of the ships and colonies
+// on the surface of an orbit.
+func (q *Queries) ReadOrbitPopulation(ctx context.Context, arg ReadOrbitPopulationParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, readOrbitPopulation, arg.OrbitID, arg.AsOfDt)
+	var population int64
+	err := row.Scan(&population)
+	return population, err
+}
+
 const readOrbitStar = `-- name: ReadOrbitStar :one
 select systems.id as system_id,
        systems.system_name,
        stars.id   as star_id,
        stars.star_name,
-       orbits.orbit_no
+       orbits.orbit_no,
+       orbits.habitability
 from orbits,
      stars,
      systems
@@ -54,11 +227,12 @@ where orbits.id = ?1
 `
 
 type ReadOrbitStarRow struct {
-	SystemID   int64
-	SystemName string
-	StarID     int64
-	StarName   string
-	OrbitNo    int64
+	SystemID     int64
+	SystemName   string
+	StarID       int64
+	StarName     string
+	OrbitNo      int64
+	Habitability int64
 }
 
 // ReadOrbitStar returns the star for a given orbit.
@@ -71,6 +245,7 @@ func (q *Queries) ReadOrbitStar(ctx context.Context, orbitID int64) (ReadOrbitSt
 		&i.StarID,
 		&i.StarName,
 		&i.OrbitNo,
+		&i.Habitability,
 	)
 	return i, err
 }
@@ -0,0 +1,103 @@
+-- CreateSCRecycleOrder creates a new recycle or scrap order.
+--
+-- name: CreateSCRecycleOrder :one
+insert into sc_recycle_order (sc_id, effdt, kind, group_kind, group_no, unit_cd, tech_level, qty)
+values (:sc_id, :effdt, :kind, :group_kind, :group_no, :unit_cd, :tech_level, :qty)
+returning id;
+
+-- CreateSCRecycleResult creates the result of a recycle or scrap order.
+--
+-- name: CreateSCRecycleResult :exec
+insert into sc_recycle_result (recycle_id, effdt, empire_id, qty, mets_returned, nmts_returned, status, reason)
+values (:recycle_id, :effdt, :empire_id, :qty, :mets_returned, :nmts_returned, :status, :reason);
+
+-- DeleteSCGroupUnitProductionByTurn deletes the group unit production
+-- entries created on a given turn. Delete the work in progress first.
+--
+-- name: DeleteSCGroupUnitProductionByTurn :exec
+delete
+from sc_group_unit_production
+where production_dt = :production_dt;
+
+-- DeleteSCGroupUnitProductionWIPByTurn deletes the work in progress entries
+-- created on a given turn.
+--
+-- name: DeleteSCGroupUnitProductionWIPByTurn :exec
+delete
+from sc_group_unit_production_wip
+where production_dt = :production_dt;
+
+-- DeleteSCRecycleResultsByTurn deletes the recycle results for a turn.
+--
+-- name: DeleteSCRecycleResultsByTurn :exec
+delete
+from sc_recycle_result
+where effdt = :effdt;
+
+-- ReadAllRecycleOrdersByTurn returns the recycle and scrap orders for a
+-- turn, in the order they were given.
+--
+-- name: ReadAllRecycleOrdersByTurn :many
+select id as recycle_id,
+       sc_id,
+       kind,
+       group_kind,
+       group_no,
+       unit_cd,
+       tech_level,
+       qty
+from sc_recycle_order
+where effdt = :turn_no
+order by id;
+
+-- ReadAllRecycleResultsByEmpire returns the results of the recycle and
+-- scrap orders given by an empire's ships and colonies on a turn.
+--
+-- name: ReadAllRecycleResultsByEmpire :many
+select sc_recycle_order.id as recycle_id,
+       sc_recycle_order.sc_id,
+       sc_recycle_order.kind,
+       sc_recycle_order.group_kind,
+       sc_recycle_order.group_no,
+       sc_recycle_order.unit_cd,
+       sc_recycle_order.tech_level,
+       sc_recycle_order.qty as ordered_qty,
+       sc_recycle_result.qty,
+       sc_recycle_result.mets_returned,
+       sc_recycle_result.nmts_returned,
+       sc_recycle_result.status,
+       sc_recycle_result.reason
+from sc_recycle_order,
+     sc_recycle_result
+where sc_recycle_result.empire_id = :empire_id
+  and sc_recycle_result.effdt = :effdt
+  and sc_recycle_order.id = sc_recycle_result.recycle_id
+order by sc_recycle_order.id;
+
+-- ReadSCGroupUnitWIP returns the latest work in progress for the units in a
+-- group with a single tech level as of a turn.
+--
+-- name: ReadSCGroupUnitWIP :one
+select production_dt,
+       wip_25pct_qty,
+       wip_50pct_qty,
+       wip_75pct_qty
+from sc_group_unit_production_wip
+where group_id = :group_id
+  and tech_level = :tech_level
+  and production_dt <= :as_of_dt
+order by production_dt desc
+limit 1;
+
+-- UpdateSCGroupUnitWIP updates the work in progress for the units in a
+-- group with a single tech level. Use this only when the entry was created
+-- in the current turn; otherwise, create a new entry for the turn.
+--
+-- name: UpdateSCGroupUnitWIP :exec
+update sc_group_unit_production_wip
+set wip_25pct_qty = :wip_25pct_qty,
+    wip_50pct_qty = :wip_50pct_qty,
+    wip_75pct_qty = :wip_75pct_qty
+where group_id = :group_id
+  and tech_level = :tech_level
+  and production_dt = :production_dt;
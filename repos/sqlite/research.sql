@@ -0,0 +1,125 @@
+-- CreateSCResearchOrder creates a new research order.
+--
+-- name: CreateSCResearchOrder :one
+insert into sc_research_order (sc_id, effdt)
+values (:sc_id, :effdt)
+returning id;
+
+-- CreateSCResearchResult creates the result of a research order.
+--
+-- name: CreateSCResearchResult :exec
+insert into sc_research_result (research_id, effdt, empire_id, from_tech_level, to_tech_level, rsch_used, status,
+                                reason)
+values (:research_id, :effdt, :empire_id, :from_tech_level, :to_tech_level, :rsch_used, :status, :reason);
+
+-- DeleteSCResearchResultsByTurn deletes the research results for a turn.
+--
+-- name: DeleteSCResearchResultsByTurn :exec
+delete
+from sc_research_result
+where effdt = :effdt;
+
+-- DeleteLabProductionByTurn deletes the production summaries of the lab
+-- groups for a turn.
+--
+-- name: DeleteLabProductionByTurn :exec
+delete
+from sc_group_production_summary
+where production_dt = :production_dt
+  and group_id in (select id
+                   from sc_group
+                   where kind = 'lab');
+
+-- ReadAllLabGroupsByTurn returns the lab groups of all ships and colonies
+-- as of a turn, ordered by ship or colony and group number.
+--
+-- name: ReadAllLabGroupsByTurn :many
+select sc_group.sc_id,
+       sc_group.id as group_id,
+       sc_group_no.group_no
+from sc_group,
+     sc_group_no
+where sc_group.kind = 'lab'
+  and (sc_group.effdt <= :as_of_dt and :as_of_dt < sc_group.enddt)
+  and sc_group_no.group_id = sc_group.id
+  and (sc_group_no.effdt <= :as_of_dt and :as_of_dt < sc_group_no.enddt)
+order by sc_group.sc_id, sc_group_no.group_no;
+
+-- ReadAllLabProductionByEmpire returns the research produced by the lab
+-- groups of an empire's ships and colonies on a turn.
+--
+-- name: ReadAllLabProductionByEmpire :many
+select sc_group.sc_id,
+       sc_group_no.group_no,
+       sc_group_production_summary.fuel_consumed,
+       sc_group_production_summary.power_consumed,
+       sc_group_production_summary.pro_consumed,
+       sc_group_production_summary.usk_consumed,
+       sc_group_production_summary.qty_produced
+from sc_owner,
+     sc_group,
+     sc_group_no,
+     sc_group_production_summary
+where sc_owner.empire_id = :empire_id
+  and (sc_owner.effdt <= :as_of_dt and :as_of_dt < sc_owner.enddt)
+  and sc_group.sc_id = sc_owner.sc_id
+  and sc_group.kind = 'lab'
+  and sc_group_no.group_id = sc_group.id
+  and (sc_group_no.effdt <= :as_of_dt and :as_of_dt < sc_group_no.enddt)
+  and sc_group_production_summary.group_id = sc_group.id
+  and sc_group_production_summary.production_dt = :as_of_dt
+order by sc_group.sc_id, sc_group_no.group_no;
+
+-- ReadAllResearchOrdersByTurn returns the research orders for a turn, in the
+-- order they were given.
+--
+-- name: ReadAllResearchOrdersByTurn :many
+select id as research_id,
+       sc_id
+from sc_research_order
+where effdt = :turn_no
+order by id;
+
+-- ReadAllResearchResultsByEmpire returns the results of the research orders
+-- given by an empire's ships and colonies on a turn.
+--
+-- name: ReadAllResearchResultsByEmpire :many
+select sc_research_order.id as research_id,
+       sc_research_order.sc_id,
+       sc_research_result.from_tech_level,
+       sc_research_result.to_tech_level,
+       sc_research_result.rsch_used,
+       sc_research_result.status,
+       sc_research_result.reason
+from sc_research_order,
+     sc_research_result
+where sc_research_result.empire_id = :empire_id
+  and sc_research_result.effdt = :effdt
+  and sc_research_order.id = sc_research_result.research_id
+order by sc_research_order.id;
+
+-- UpdateSCTechLevel updates the tech level of a ship or colony.
+--
+-- name: UpdateSCTechLevel :exec
+update scs
+set sc_tech_level = :sc_tech_level
+where id = :sc_id;
+
+-- UpdateSCTechLevelsByTurn restores the tech level that the ships and
+-- colonies had before their research orders for a given turn.
+--
+-- name: UpdateSCTechLevelsByTurn :exec
+update scs
+set sc_tech_level = (select min(sc_research_result.from_tech_level)
+                     from sc_research_order,
+                          sc_research_result
+                     where sc_research_order.sc_id = scs.id
+                       and sc_research_result.research_id = sc_research_order.id
+                       and sc_research_result.effdt = :effdt
+                       and sc_research_result.status = 'succeeded')
+where id in (select sc_research_order.sc_id
+             from sc_research_order,
+                  sc_research_result
+             where sc_research_result.research_id = sc_research_order.id
+               and sc_research_result.effdt = :effdt
+               and sc_research_result.status = 'succeeded');
@@ -0,0 +1,99 @@
+-- CreateSCRetoolOrder creates a new retool order.
+--
+-- name: CreateSCRetoolOrder :one
+insert into sc_retool_order (sc_id, effdt, group_no, item_cd, item_tech_level)
+values (:sc_id, :effdt, :group_no, :item_cd, :item_tech_level)
+returning id;
+
+-- CreateSCRetoolResult creates the result of a retool order.
+--
+-- name: CreateSCRetoolResult :exec
+insert into sc_retool_result (retool_id, effdt, empire_id, status, reason)
+values (:retool_id, :effdt, :empire_id, :status, :reason);
+
+-- DeleteSCGroupToolingByTurn deletes the tooling entries created on a given
+-- turn.
+--
+-- name: DeleteSCGroupToolingByTurn :exec
+delete
+from sc_group_tooling
+where effdt = :effdt;
+
+-- DeleteSCRetoolResultsByTurn deletes the retool results for a turn.
+--
+-- name: DeleteSCRetoolResultsByTurn :exec
+delete
+from sc_retool_result
+where effdt = :effdt;
+
+-- ReadAllRetoolOrdersByTurn returns the retool orders for a turn, in the
+-- order they were given.
+--
+-- name: ReadAllRetoolOrdersByTurn :many
+select id as retool_id,
+       sc_id,
+       group_no,
+       item_cd,
+       item_tech_level
+from sc_retool_order
+where effdt = :turn_no
+order by id;
+
+-- ReadAllRetoolResultsByEmpire returns the results of the retool orders
+-- given by an empire's ships and colonies on a turn.
+--
+-- name: ReadAllRetoolResultsByEmpire :many
+select sc_retool_order.id as retool_id,
+       sc_retool_order.sc_id,
+       sc_retool_order.group_no,
+       sc_retool_order.item_cd,
+       sc_retool_order.item_tech_level,
+       sc_retool_result.status,
+       sc_retool_result.reason
+from sc_retool_order,
+     sc_retool_result
+where sc_retool_result.empire_id = :empire_id
+  and sc_retool_result.effdt = :effdt
+  and sc_retool_order.id = sc_retool_result.retool_id
+order by sc_retool_order.id;
+
+-- ReadSCGroupToolingByGroup returns the item a factory group is tooled to
+-- manufacture as of a turn.
+--
+-- name: ReadSCGroupToolingByGroup :one
+select effdt,
+       enddt,
+       item_cd,
+       item_tech_level,
+       retooled
+from sc_group_tooling
+where group_id = :group_id
+  and (effdt <= :as_of_dt and :as_of_dt < enddt);
+
+-- UpdateSCGroupToolingEndDt updates the end date of the tooling for a
+-- factory group.
+--
+-- name: UpdateSCGroupToolingEndDt :exec
+update sc_group_tooling
+set enddt = :enddt
+where group_id = :group_id
+  and effdt = :effdt;
+
+-- UpdateSCGroupToolingItem updates the item a factory group is tooled to
+-- manufacture. Use this only when the tooling was created in the current
+-- turn; otherwise, end-date the tooling and create a new one.
+--
+-- name: UpdateSCGroupToolingItem :exec
+update sc_group_tooling
+set item_cd         = :item_cd,
+    item_tech_level = :item_tech_level
+where group_id = :group_id
+  and effdt = :effdt;
+
+-- UpdateSCGroupToolingEndDtByTurn re-opens the tooling entries that were
+-- end-dated on a given turn. It is used to undo the changes made on the turn.
+--
+-- name: UpdateSCGroupToolingEndDtByTurn :exec
+update sc_group_tooling
+set enddt = :max_enddt
+where enddt = :effdt;
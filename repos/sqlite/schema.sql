@@ -61,26 +61,31 @@ values ('UEM', 'Unemployable', 0.0000, 1);
 insert into population_codes (code, name, base_pay_rate, sort_order)
 values ('USK', 'Unskilled', 0.1250, 2);
 
+-- type_factor divides the volume enclosed by structure. CODES.txt says that
+-- structure "Encloses (1 x TL^2) divided by type factor" but doesn't give
+-- the factors, so these are the game's own: open colonies use the planet's
+-- surface and need the least structure, ships need the most.
 create table sc_codes
 (
-    code       text    not null,
-    name       text    not null,
-    is_ship    integer not null check (is_ship in (0, 1)),
-    is_surface integer not null check (is_surface in (0, 1)),
+    code        text    not null,
+    name        text    not null,
+    is_ship     integer not null check (is_ship in (0, 1)),
+    is_surface  integer not null check (is_surface in (0, 1)),
+    type_factor integer not null check (type_factor > 0),
     primary key (code),
     unique (name)
 );
 
-insert into sc_codes (code, name, is_ship, is_surface)
-values ('NONE', 'none', 0, 0);
-insert into sc_codes (code, name, is_ship, is_surface)
-values ('SHIP', 'Ship', 1, 0);
-insert into sc_codes (code, name, is_ship, is_surface)
-values ('COPN', 'Open Surface Colony', 1, 1);
-insert into sc_codes (code, name, is_ship, is_surface)
-values ('CENC', 'Enclosed Surface Colony', 0, 1);
-insert into sc_codes (code, name, is_ship, is_surface)
-values ('CORB', 'Orbital Colony', 0, 0);
+insert into sc_codes (code, name, is_ship, is_surface, type_factor)
+values ('NONE', 'none', 0, 0, 10);
+insert into sc_codes (code, name, is_ship, is_surface, type_factor)
+values ('SHIP', 'Ship', 1, 0, 10);
+insert into sc_codes (code, name, is_ship, is_surface, type_factor)
+values ('COPN', 'Open Surface Colony', 1, 1, 1);
+insert into sc_codes (code, name, is_ship, is_surface, type_factor)
+values ('CENC', 'Enclosed Surface Colony', 0, 1, 2);
+insert into sc_codes (code, name, is_ship, is_surface, type_factor)
+values ('CORB', 'Orbital Colony', 0, 0, 5);
 
 create table unit_codes
 (
@@ -358,6 +363,23 @@ create table scs
     constraint fk_sc_cd foreign key (sc_cd) references sc_codes (code)
 );
 
+-- the owner table stores the empire that controls a ship or colony.
+-- scs.empire_id is the empire that founded the ship or colony; the owner
+-- changes when a colony is captured, so reports and orders must use the
+-- owner as of the turn.
+create table sc_owner
+(
+    sc_id     integer not null,
+    effdt     integer not null,
+    enddt     integer not null,
+    empire_id integer not null,
+    primary key (sc_id, effdt),
+    constraint fk_sc_id foreign key (sc_id) references scs (id),
+    constraint fk_empire_id foreign key (empire_id) references empire (id)
+);
+
+-- the inventory table stores the units held by a ship or colony. operational
+-- units may be both assembled and in storage, so there is an entry for each.
 create table sc_inventory
 (
     sc_id           integer not null,
@@ -370,7 +392,7 @@ create table sc_inventory
     volume          real    not null,
     is_assembled    integer not null default 0 check (is_assembled in (0, 1)),
     is_stored       integer not null default 0 check (is_stored in (0, 1)),
-    primary key (sc_id, unit_cd, unit_tech_level, effdt),
+    primary key (sc_id, unit_cd, unit_tech_level, is_assembled, effdt),
     constraint fk_sc_id foreign key (sc_id) references scs (id),
     constraint fk_unit_cd foreign key (unit_cd) references unit_codes (code)
 );
@@ -440,9 +462,8 @@ create table sc_rates
 -- that table is effective-dated to allow mines to be transferred to new deposits.
 -- you will need to use the as_of_date to look it the current deposit.
 --
--- farms always produce food, so there is no similar table for them.
---
--- labs aren't used yet.
+-- farms always produce food and labs always produce research, so there is no
+-- similar table for them.
 create table sc_group
 (
     id    integer primary key autoincrement,
@@ -531,17 +552,18 @@ create table sc_group_unit
 -- note that the item being produced is not stored in this table.
 create table sc_group_unit_production
 (
-    group_id      integer not null,
-    tech_level    integer not null,
-    production_dt integer not null,
-    fuel_consumed integer not null,
-    gold_consumed integer not null,
-    mets_consumed integer not null,
-    nmts_consumed integer not null,
-    pro_consumed  integer not null,
-    usk_consumed  integer not null,
-    aut_consumed  integer not null,
-    qty_produced  integer not null,
+    group_id       integer not null,
+    tech_level     integer not null,
+    production_dt  integer not null,
+    fuel_consumed  integer not null,
+    power_consumed integer not null,
+    gold_consumed  integer not null,
+    mets_consumed  integer not null,
+    nmts_consumed  integer not null,
+    pro_consumed   integer not null,
+    usk_consumed   integer not null,
+    aut_consumed   integer not null,
+    qty_produced   integer not null,
     primary key (group_id, tech_level, production_dt),
     constraint fk_group_id foreign key (group_id) references sc_group (id)
 );
@@ -569,16 +591,17 @@ create table sc_group_unit_production_wip
 -- note that the item being produced is not stored in this table.
 create table sc_group_production_summary
 (
-    group_id      integer not null,
-    production_dt integer not null,
-    fuel_consumed integer not null,
-    gold_consumed integer not null,
-    mets_consumed integer not null,
-    nmts_consumed integer not null,
-    pro_consumed  integer not null,
-    usk_consumed  integer not null,
-    aut_consumed  integer not null,
-    qty_produced  integer not null,
+    group_id       integer not null,
+    production_dt  integer not null,
+    fuel_consumed  integer not null,
+    power_consumed integer not null,
+    gold_consumed  integer not null,
+    mets_consumed  integer not null,
+    nmts_consumed  integer not null,
+    pro_consumed   integer not null,
+    usk_consumed   integer not null,
+    aut_consumed   integer not null,
+    qty_produced   integer not null,
     primary key (group_id, production_dt),
     constraint fk_group_id foreign key (group_id) references sc_group (id)
 );
@@ -631,7 +654,7 @@ create table sc_probe_star_result
     star_id       integer not null,
     location      text    not null,
     nbr_of_orbits integer not null,
-    primary key (probe_id, effdt),
+    primary key (probe_id, effdt, star_id),
     constraint fk_probe_id foreign key (probe_id) references sc_probe_order (id),
     constraint fk_star_id foreign key (star_id) references stars (id)
 );
@@ -647,7 +670,7 @@ create table sc_probe_star_orbit_result
     gold_est   integer not null,
     mets_est   integer not null,
     nmts_est   integer not null,
-    primary key (probe_id, effdt),
+    primary key (probe_id, effdt, star_id, orbit_no),
     constraint fk_probe_id foreign key (probe_id) references sc_probe_order (id),
     constraint fk_star_id foreign key (star_id) references stars (id)
 );
@@ -678,3 +701,730 @@ create table sc_survey_orbit_result
     constraint fk_orbit_id foreign key (orbit_id) references orbits (id)
 );
 
+-- the survey deposit result table stores the deposits found by a survey.
+-- there is one row for every deposit on the planet in the surveyed orbit.
+create table sc_survey_deposit_result
+(
+    survey_id    integer not null,
+    effdt        integer not null,
+    deposit_no   integer not null,
+    deposit_kind text    not null,
+    deposit_qty  integer not null,
+    yield_pct    integer not null,
+    primary key (survey_id, effdt, deposit_no),
+    constraint fk_survey_id foreign key (survey_id) references sc_survey_order (id)
+);
+
+create table sc_survey_result
+(
+    survey_id integer not null,
+    effdt     integer not null,
+    status    text    not null check (status in ('surveyed', 'failed')),
+    reason    text    not null,
+    primary key (survey_id, effdt),
+    constraint fk_survey_id foreign key (survey_id) references sc_survey_order (id)
+);
+
+
+-- the move order table stores orders to move a ship to a new orbit in the
+-- system it is currently in. when is_on_surface is set, the ship will land
+-- on the planet in that orbit. moving between the surface and the orbit
+-- of the same planet is also a move.
+create table sc_move_order
+(
+    id            integer primary key autoincrement,
+    sc_id         integer not null,
+    effdt         integer not null,
+    orbit_id      integer not null,
+    is_on_surface integer not null default 0 check (is_on_surface in (0, 1)),
+    unique (sc_id, effdt),
+    constraint fk_sc_id foreign key (sc_id) references scs (id),
+    constraint fk_orbit_id foreign key (orbit_id) references orbits (id)
+);
+
+-- the move result table stores the outcome of a move order. failed moves
+-- are recorded, too, so that the reason can be shown on the turn report.
+--
+-- thrust is the total thrust from the assembled space drives and mass is
+-- the total mass of the ship when the move was attempted.
+create table sc_move_result
+(
+    move_id            integer not null,
+    effdt              integer not null,
+    from_orbit_id      integer not null,
+    from_is_on_surface integer not null check (from_is_on_surface in (0, 1)),
+    to_orbit_id        integer not null,
+    to_is_on_surface   integer not null check (to_is_on_surface in (0, 1)),
+    mass               real    not null,
+    thrust             real    not null,
+    fuel_used          integer not null,
+    status             text    not null check (status in ('moved', 'failed')),
+    reason             text    not null,
+    primary key (move_id, effdt),
+    constraint fk_move_id foreign key (move_id) references sc_move_order (id),
+    constraint fk_from_orbit_id foreign key (from_orbit_id) references orbits (id),
+    constraint fk_to_orbit_id foreign key (to_orbit_id) references orbits (id)
+);
+
+-- the jump order table stores orders to jump a ship to an orbit in another
+-- system using its hyper engines. ships always arrive in orbit.
+create table sc_jump_order
+(
+    id       integer primary key autoincrement,
+    sc_id    integer not null,
+    effdt    integer not null,
+    orbit_id integer not null,
+    unique (sc_id, effdt),
+    constraint fk_sc_id foreign key (sc_id) references scs (id),
+    constraint fk_orbit_id foreign key (orbit_id) references orbits (id)
+);
+
+-- the jump result table stores the outcome of a jump order. failed jumps
+-- are recorded, too, so that the reason can be shown on the turn report.
+--
+-- distance is in light years. engines_used is the number of hyper engines
+-- needed to lift the mass of the ship; only those engines consume fuel.
+create table sc_jump_result
+(
+    jump_id       integer not null,
+    effdt         integer not null,
+    from_orbit_id integer not null,
+    to_orbit_id   integer not null,
+    distance      real    not null,
+    mass          real    not null,
+    engines_used  integer not null,
+    fuel_used     integer not null,
+    status        text    not null check (status in ('jumped', 'failed')),
+    reason        text    not null,
+    primary key (jump_id, effdt),
+    constraint fk_jump_id foreign key (jump_id) references sc_jump_order (id),
+    constraint fk_from_orbit_id foreign key (from_orbit_id) references orbits (id),
+    constraint fk_to_orbit_id foreign key (to_orbit_id) references orbits (id)
+);
+
+-- the probe result table stores the outcome of a probe order. failed probes
+-- are recorded, too, so that the reason can be shown on the turn report.
+-- the data gathered by the probe is stored in the star and star orbit
+-- result tables.
+--
+-- unit_cd is the unit used for the probe: assembled sensors (SEN) for targets
+-- in the same system, or a robot probe vehicle (RPV), which is expended.
+create table sc_probe_result
+(
+    probe_id        integer not null,
+    effdt           integer not null,
+    unit_cd         text    not null,
+    unit_tech_level integer not null,
+    units_used      integer not null,
+    fuel_used       integer not null,
+    status          text    not null check (status in ('probed', 'failed')),
+    reason          text    not null,
+    primary key (probe_id, effdt),
+    constraint fk_probe_id foreign key (probe_id) references sc_probe_order (id)
+);
+
+-- the empire knowledge tables store what an empire has learned about the
+-- cluster from its probes and surveys. they are effective-dated; every
+-- observation end-dates the current row and creates a new one, so the
+-- effdt of the current row is the turn the item was last observed.
+--
+-- unlike the probe and survey result tables, these rows are not deleted
+-- when the turn results are reset; only the rows created on the turn are
+-- removed and the rows they replaced are re-opened.
+
+-- empire_star_knowledge stores the stars (and so the systems) that an
+-- empire knows about.
+create table empire_star_knowledge
+(
+    empire_id     integer not null,
+    star_id       integer not null,
+    effdt         integer not null,
+    enddt         integer not null,
+    system_id     integer not null,
+    nbr_of_orbits integer not null,
+    primary key (empire_id, star_id, effdt),
+    constraint fk_empire_id foreign key (empire_id) references empire (id),
+    constraint fk_star_id foreign key (star_id) references stars (id),
+    constraint fk_system_id foreign key (system_id) references systems (id)
+);
+
+-- empire_orbit_knowledge stores the kind of each orbit an empire knows
+-- about and the (log10) estimates of the resources on the planet.
+create table empire_orbit_knowledge
+(
+    empire_id  integer not null,
+    orbit_id   integer not null,
+    effdt      integer not null,
+    enddt      integer not null,
+    orbit_kind text    not null,
+    fuel_est   integer not null,
+    gold_est   integer not null,
+    mets_est   integer not null,
+    nmts_est   integer not null,
+    primary key (empire_id, orbit_id, effdt),
+    constraint fk_empire_id foreign key (empire_id) references empire (id),
+    constraint fk_orbit_id foreign key (orbit_id) references orbits (id)
+);
+
+-- empire_deposit_knowledge stores the deposits found by an empire's surveys.
+create table empire_deposit_knowledge
+(
+    empire_id    integer not null,
+    deposit_id   integer not null,
+    effdt        integer not null,
+    enddt        integer not null,
+    orbit_id     integer not null,
+    deposit_no   integer not null,
+    deposit_kind text    not null,
+    deposit_qty  integer not null,
+    yield_pct    integer not null,
+    primary key (empire_id, deposit_id, effdt),
+    constraint fk_empire_id foreign key (empire_id) references empire (id),
+    constraint fk_deposit_id foreign key (deposit_id) references deposits (id),
+    constraint fk_orbit_id foreign key (orbit_id) references orbits (id)
+);
+
+-- empire_sc_knowledge stores the foreign ships and colonies that an empire
+-- has seen, with the location they were seen at.
+create table empire_sc_knowledge
+(
+    empire_id     integer not null,
+    sc_id         integer not null,
+    effdt         integer not null,
+    enddt         integer not null,
+    owner_id      integer not null,
+    sc_cd         text    not null,
+    orbit_id      integer not null,
+    is_on_surface integer not null check (is_on_surface in (0, 1)),
+    primary key (empire_id, sc_id, effdt),
+    constraint fk_empire_id foreign key (empire_id) references empire (id),
+    constraint fk_sc_id foreign key (sc_id) references scs (id),
+    constraint fk_owner_id foreign key (owner_id) references empire (id),
+    constraint fk_orbit_id foreign key (orbit_id) references orbits (id)
+);
+
+-- the combat order table stores orders to attack a ship or colony, or to
+-- support another ship or colony in a battle. pct_committed is the share
+-- of the soldiers, supplies, and combat units that are committed.
+--
+-- target_id is the ship or colony being attacked. for support-defend, it
+-- is the ship or colony being defended. support_id is the ship or colony
+-- being supported; it is zero for attacks. raid_unit_cd is the unit that
+-- a raid tries to carry off; it is empty for all other orders.
+create table sc_combat_order
+(
+    id            integer primary key autoincrement,
+    sc_id         integer not null,
+    effdt         integer not null,
+    kind          text    not null check (kind in ('bombard', 'invade', 'raid', 'support-attack', 'support-defend')),
+    target_id     integer not null,
+    support_id    integer not null default 0,
+    pct_committed integer not null check (pct_committed between 1 and 100),
+    raid_unit_cd  text    not null default '',
+    unique (sc_id, effdt, kind, target_id),
+    constraint fk_sc_id foreign key (sc_id) references scs (id),
+    constraint fk_target_id foreign key (target_id) references scs (id)
+);
+
+-- the combat result table stores the outcome of an attack or support order.
+-- failed orders are recorded, too, so that the reason can be shown on the
+-- turn report.
+--
+-- status is from the point of view of the side the order was on. mass_pct
+-- is the share of the enemy's mass that was destroyed by weapons fire.
+-- empire_id and target_empire_id are the owners of the ship or colony that
+-- gave the order and of the target when the battle was fought.
+create table sc_combat_result
+(
+    combat_id        integer not null,
+    effdt            integer not null,
+    empire_id        integer not null,
+    target_empire_id integer not null,
+    rounds           integer not null,
+    mass_pct         real    not null,
+    status           text    not null check (status in ('won', 'lost', 'failed')),
+    reason           text    not null,
+    primary key (combat_id, effdt),
+    constraint fk_combat_id foreign key (combat_id) references sc_combat_order (id)
+);
+
+-- the combat loss table stores the units and population lost by a ship or
+-- colony in all the battles it fought on a turn. code is a unit code or,
+-- for casualties, a population code with a tech level of zero. empire_id
+-- is the owner of the ship or colony when the battle was fought.
+create table sc_combat_loss
+(
+    sc_id      integer not null,
+    effdt      integer not null,
+    empire_id  integer not null,
+    code       text    not null,
+    tech_level integer not null,
+    qty        integer not null check (qty >= 0),
+    primary key (sc_id, effdt, code, tech_level),
+    constraint fk_sc_id foreign key (sc_id) references scs (id)
+);
+
+-- the espionage order table stores the missions given to spies (SPY) and
+-- special agents (SAG). qty is the number of agents sent on the mission.
+--
+-- check-rebels and convert-rebels use special agents in the colony that
+-- gives the order. counter-agents uses spies to guard the colony against
+-- foreign agents. incite-rebels, steal-secrets, and suppress-agents send
+-- spies into the target. for missions at home, target_id is sc_id.
+create table sc_espionage_order
+(
+    id        integer primary key autoincrement,
+    sc_id     integer not null,
+    effdt     integer not null,
+    kind      text    not null check (kind in ('check-rebels', 'convert-rebels', 'counter-agents',
+                                               'incite-rebels', 'steal-secrets', 'suppress-agents')),
+    target_id integer not null,
+    qty       integer not null check (qty > 0),
+    unique (sc_id, effdt, kind, target_id),
+    constraint fk_sc_id foreign key (sc_id) references scs (id),
+    constraint fk_target_id foreign key (target_id) references scs (id)
+);
+
+-- the espionage result table stores the outcome of a mission. failed
+-- missions are recorded, too, so that the reason can be shown on the
+-- turn report.
+--
+-- agents_lost is the number of agents caught or killed. agents_through is
+-- the number that got past the target's counter-agents. counter_lost is
+-- the number of the target's counter-agents that were killed. effect_qty
+-- depends on the mission: the estimated number of rebels, the number of
+-- rebels converted or incited, or the number of foreign agents caught.
+-- empire_id and target_empire_id are the owners of the ship or colony that
+-- gave the order and of the target when the mission was run.
+create table sc_espionage_result
+(
+    espionage_id     integer not null,
+    effdt            integer not null,
+    empire_id        integer not null,
+    target_empire_id integer not null,
+    agents_lost      integer not null default 0,
+    agents_through   integer not null default 0,
+    counter_lost     integer not null default 0,
+    effect_qty       integer not null default 0,
+    status           text    not null check (status in ('succeeded', 'failed')),
+    reason           text    not null,
+    primary key (espionage_id, effdt),
+    constraint fk_espionage_id foreign key (espionage_id) references sc_espionage_order (id)
+);
+
+-- the permission table stores the rights that an empire has granted to
+-- another empire in an orbit. colonize allows the grantee to set up a
+-- colony in the orbit. trade allows the grantee to trade with and transfer
+-- to the ships and colonies of the empire in the orbit. a revoked right
+-- is end-dated.
+create table empire_permission
+(
+    empire_id  integer not null,
+    orbit_id   integer not null,
+    kind       text    not null check (kind in ('colonize', 'trade')),
+    grantee_id integer not null,
+    effdt      integer not null,
+    enddt      integer not null,
+    primary key (empire_id, orbit_id, kind, grantee_id, effdt),
+    constraint fk_empire_id foreign key (empire_id) references empire (id),
+    constraint fk_orbit_id foreign key (orbit_id) references orbits (id),
+    constraint fk_grantee_id foreign key (grantee_id) references empire (id)
+);
+
+-- the permission order table stores the grant and revoke orders given by
+-- an empire.
+create table empire_permission_order
+(
+    id         integer primary key autoincrement,
+    empire_id  integer not null,
+    effdt      integer not null,
+    action     text    not null check (action in ('grant', 'revoke')),
+    orbit_id   integer not null,
+    kind       text    not null check (kind in ('colonize', 'trade')),
+    grantee_id integer not null,
+    unique (empire_id, effdt, action, orbit_id, kind, grantee_id),
+    constraint fk_empire_id foreign key (empire_id) references empire (id),
+    constraint fk_orbit_id foreign key (orbit_id) references orbits (id)
+);
+
+-- the permission result table stores the outcome of a grant or revoke
+-- order. failed orders are recorded, too, so that the reason can be shown
+-- on the turn report.
+create table empire_permission_result
+(
+    permission_id integer not null,
+    effdt         integer not null,
+    status        text    not null check (status in ('succeeded', 'failed')),
+    reason        text    not null,
+    primary key (permission_id, effdt),
+    constraint fk_permission_id foreign key (permission_id) references empire_permission_order (id)
+);
+
+-- the market order table stores the buy and sell orders given by ships
+-- and colonies. price is the bid for a buy order or the ask for a sell
+-- order, in GOLD per unit.
+create table sc_market_order
+(
+    id         integer primary key autoincrement,
+    sc_id      integer not null,
+    effdt      integer not null,
+    kind       text    not null check (kind in ('buy', 'sell')),
+    unit_cd    text    not null,
+    tech_level integer not null check (tech_level between 0 and 10),
+    qty        integer not null check (qty > 0),
+    price      real    not null check (price > 0),
+    unique (sc_id, effdt, kind, unit_cd, tech_level),
+    constraint fk_sc_id foreign key (sc_id) references scs (id),
+    constraint fk_unit_cd foreign key (unit_cd) references unit_codes (code)
+);
+
+-- the market result table stores the outcome of a buy or sell order.
+-- filled_qty is the number of units bought or sold and gold_qty is the
+-- GOLD paid or received for them. empire_id is the owner of the ship or
+-- colony when the order was filled. failed orders are recorded, too, so
+-- that the reason can be shown on the turn report.
+create table sc_market_result
+(
+    market_id  integer not null,
+    effdt      integer not null,
+    empire_id  integer not null,
+    filled_qty integer not null default 0,
+    gold_qty   integer not null default 0,
+    status     text    not null check (status in ('succeeded', 'failed')),
+    reason     text    not null,
+    primary key (market_id, effdt),
+    constraint fk_market_id foreign key (market_id) references sc_market_order (id)
+);
+
+-- the market price table stores the prices published after the market
+-- phase of a turn, for every product that had a buy or sell order.
+-- the prices are zero if nothing was traded. best_bid and best_ask are the
+-- highest bid and lowest ask left unfilled, or zero if there were none.
+create table market_price
+(
+    effdt      integer not null,
+    orbit_id   integer not null,
+    unit_cd    text    not null,
+    tech_level integer not null,
+    traded_qty integer not null,
+    low_price  real    not null,
+    high_price real    not null,
+    avg_price  real    not null,
+    best_bid   real    not null,
+    best_ask   real    not null,
+    primary key (effdt, orbit_id, unit_cd, tech_level),
+    constraint fk_orbit_id foreign key (orbit_id) references orbits (id),
+    constraint fk_unit_cd foreign key (unit_cd) references unit_codes (code)
+);
+
+-- the news order table stores the articles that empires send to the
+-- Galactic News. system_id and orbit_no are the location the article is
+-- posted at; orbit_no is 0 if the article is about the whole system.
+create table empire_news_order
+(
+    id        integer primary key autoincrement,
+    empire_id integer not null,
+    effdt     integer not null,
+    system_id integer not null,
+    orbit_no  integer not null check (orbit_no between 0 and 10),
+    article   text    not null,
+    signature text    not null,
+    constraint fk_empire_id foreign key (empire_id) references empire (id),
+    constraint fk_system_id foreign key (system_id) references systems (id)
+);
+
+-- the news result table stores the outcome of a news order. failed orders
+-- are recorded, too, so that the reason can be shown on the turn report.
+create table empire_news_result
+(
+    news_id integer not null,
+    effdt   integer not null,
+    status  text    not null check (status in ('succeeded', 'failed')),
+    reason  text    not null,
+    primary key (news_id, effdt),
+    constraint fk_news_id foreign key (news_id) references empire_news_order (id)
+);
+
+-- the news table stores the items published in the Galactic News.
+-- announcements posted by the GM have no author (empire_id is null) and
+-- are sent to every empire (system_id is null).
+create table news
+(
+    id        integer primary key autoincrement,
+    effdt     integer not null,
+    empire_id integer,
+    system_id integer,
+    orbit_no  integer not null default 0,
+    article   text    not null,
+    signature text    not null,
+    constraint fk_empire_id foreign key (empire_id) references empire (id),
+    constraint fk_system_id foreign key (system_id) references systems (id)
+);
+
+-- the name order table stores the orders that empires give to name their
+-- empire, a system, a star, or one of their ships or colonies. the names
+-- given to systems and stars are private to the empire. target_id is the
+-- id of the empire, system, star, or ship or colony being named.
+create table empire_name_order
+(
+    id        integer primary key autoincrement,
+    empire_id integer not null,
+    effdt     integer not null,
+    kind      text    not null check (kind in ('empire', 'system', 'star', 'sc')),
+    target_id integer not null,
+    name      text    not null,
+    constraint fk_empire_id foreign key (empire_id) references empire (id)
+);
+
+-- the name result table stores the outcome of a name order. failed orders
+-- are recorded, too, so that the reason can be shown on the turn report.
+create table empire_name_result
+(
+    name_id integer not null,
+    effdt   integer not null,
+    status  text    not null check (status in ('succeeded', 'failed')),
+    reason  text    not null,
+    primary key (name_id, effdt),
+    constraint fk_name_id foreign key (name_id) references empire_name_order (id)
+);
+
+-- the setup order table stores the orders that found new ships and colonies.
+-- sc_id is the parent ship or colony that provides the cargo, and orbit_id
+-- is the location of the new ship or colony.
+create table sc_setup_order
+(
+    id       integer primary key autoincrement,
+    sc_id    integer not null,
+    effdt    integer not null,
+    kind     text    not null check (kind in ('colony', 'ship')),
+    orbit_id integer not null,
+    constraint fk_sc_id foreign key (sc_id) references scs (id),
+    constraint fk_orbit_id foreign key (orbit_id) references orbits (id)
+);
+
+-- the setup item table stores the units and population that a setup order
+-- transfers from the parent to the new ship or colony. unit_cd is either a
+-- unit code or a population code.
+create table sc_setup_item
+(
+    setup_id   integer not null,
+    line_no    integer not null,
+    unit_cd    text    not null,
+    tech_level integer not null check (tech_level between 0 and 10),
+    qty        integer not null check (qty > 0),
+    primary key (setup_id, line_no),
+    constraint fk_setup_id foreign key (setup_id) references sc_setup_order (id)
+);
+
+-- the setup result table stores the outcome of a setup order. new_sc_id is
+-- the ship or colony that was founded, or 0 if the order failed.
+create table sc_setup_result
+(
+    setup_id  integer not null,
+    effdt     integer not null,
+    empire_id integer not null,
+    new_sc_id integer not null,
+    sc_cd     text    not null,
+    status    text    not null check (status in ('succeeded', 'failed')),
+    reason    text    not null,
+    primary key (setup_id, effdt),
+    constraint fk_setup_id foreign key (setup_id) references sc_setup_order (id),
+    constraint fk_empire_id foreign key (empire_id) references empire (id)
+);
+
+-- the assembly order table stores the orders that assemble units from
+-- storage or disassemble them and put them back in storage. group_no is
+-- the factory, farm, lab, or mine group that the units are added to or
+-- taken from, or 0 if the units aren't part of a group. deposit_no is the
+-- deposit that a new mine group works, and item_cd and item_tech_level are
+-- the item that a new factory group makes.
+create table sc_assembly_order
+(
+    id              integer primary key autoincrement,
+    sc_id           integer not null,
+    effdt           integer not null,
+    kind            text    not null check (kind in ('assemble', 'store')),
+    unit_cd         text    not null,
+    tech_level      integer not null check (tech_level between 0 and 10),
+    qty             integer not null check (qty > 0),
+    group_no        integer not null check (group_no between 0 and 40),
+    deposit_no      integer not null check (deposit_no between 0 and 35),
+    item_cd         text    not null,
+    item_tech_level integer not null check (item_tech_level between 0 and 10),
+    constraint fk_sc_id foreign key (sc_id) references scs (id),
+    constraint fk_unit_cd foreign key (unit_cd) references unit_codes (code)
+);
+
+-- the assembly result table stores the outcome of an assembly order. qty is
+-- the number of units that were assembled or stored, which may be less than
+-- the number ordered if there weren't enough construction workers.
+create table sc_assembly_result
+(
+    assembly_id integer not null,
+    effdt       integer not null,
+    empire_id   integer not null,
+    qty         integer not null,
+    cnw_used    integer not null,
+    status      text    not null check (status in ('succeeded', 'failed')),
+    reason      text    not null,
+    primary key (assembly_id, effdt),
+    constraint fk_assembly_id foreign key (assembly_id) references sc_assembly_order (id),
+    constraint fk_empire_id foreign key (empire_id) references empire (id)
+);
+
+-- the research order table stores the orders that spend research (RSCH) to
+-- raise the tech level of a ship or colony by one.
+create table sc_research_order
+(
+    id    integer primary key autoincrement,
+    sc_id integer not null,
+    effdt integer not null,
+    constraint fk_sc_id foreign key (sc_id) references scs (id)
+);
+
+-- the research result table stores the outcome of a research order. the
+-- tech levels before and after the order are kept for the turn report.
+create table sc_research_result
+(
+    research_id     integer not null,
+    effdt           integer not null,
+    empire_id       integer not null,
+    from_tech_level integer not null,
+    to_tech_level   integer not null,
+    rsch_used       integer not null,
+    status          text    not null check (status in ('succeeded', 'failed')),
+    reason          text    not null,
+    primary key (research_id, effdt),
+    constraint fk_research_id foreign key (research_id) references sc_research_order (id),
+    constraint fk_empire_id foreign key (empire_id) references empire (id)
+);
+
+-- the retool order table stores the orders that change the item that a
+-- factory group manufactures.
+create table sc_retool_order
+(
+    id              integer primary key autoincrement,
+    sc_id           integer not null,
+    effdt           integer not null,
+    group_no        integer not null check (group_no between 1 and 35),
+    item_cd         text    not null,
+    item_tech_level integer not null check (item_tech_level between 0 and 10),
+    constraint fk_sc_id foreign key (sc_id) references scs (id)
+);
+
+-- the retool result table stores the outcome of a retool order.
+create table sc_retool_result
+(
+    retool_id integer not null,
+    effdt     integer not null,
+    empire_id integer not null,
+    status    text    not null check (status in ('succeeded', 'failed')),
+    reason    text    not null,
+    primary key (retool_id, effdt),
+    constraint fk_retool_id foreign key (retool_id) references sc_retool_order (id),
+    constraint fk_empire_id foreign key (empire_id) references empire (id)
+);
+
+-- the recycle order table stores the orders that break units down. recycled
+-- units return some of the metals and non-metals used to build them; scrapped
+-- units return nothing.
+--
+-- group_kind and group_no are empty and 0 for units taken from storage. units
+-- taken from a factory group are either the factories themselves or items in
+-- the group's work in progress.
+create table sc_recycle_order
+(
+    id         integer primary key autoincrement,
+    sc_id      integer not null,
+    effdt      integer not null,
+    kind       text    not null check (kind in ('recycle', 'scrap')),
+    group_kind text    not null check (group_kind in ('', 'factory', 'mine')),
+    group_no   integer not null check (group_no between 0 and 40),
+    unit_cd    text    not null,
+    tech_level integer not null check (tech_level between 0 and 10),
+    qty        integer not null check (qty > 0),
+    constraint fk_sc_id foreign key (sc_id) references scs (id)
+);
+
+-- the recycle result table stores the outcome of a recycle or scrap order.
+create table sc_recycle_result
+(
+    recycle_id    integer not null,
+    effdt         integer not null,
+    empire_id     integer not null,
+    qty           integer not null,
+    mets_returned integer not null,
+    nmts_returned integer not null,
+    status        text    not null check (status in ('succeeded', 'failed')),
+    reason        text    not null,
+    primary key (recycle_id, effdt),
+    constraint fk_recycle_id foreign key (recycle_id) references sc_recycle_order (id),
+    constraint fk_empire_id foreign key (empire_id) references empire (id)
+);
+
+-- the transfer order table stores the orders that move units or people from
+-- one ship or colony to another at the same location. unit_cd is either a
+-- unit code or a population code.
+create table sc_transfer_order
+(
+    id         integer primary key autoincrement,
+    sc_id      integer not null,
+    effdt      integer not null,
+    target_id  integer not null,
+    unit_cd    text    not null,
+    tech_level integer not null check (tech_level between 0 and 10),
+    qty        integer not null check (qty > 0),
+    constraint fk_sc_id foreign key (sc_id) references scs (id),
+    constraint fk_target_id foreign key (target_id) references scs (id)
+);
+
+-- the transfer result table stores the outcome of a transfer order on each
+-- turn that it is executed. a queued order is executed again on the next
+-- turn with the quantity that hasn't been moved yet.
+create table sc_transfer_result
+(
+    transfer_id integer not null,
+    effdt       integer not null,
+    empire_id   integer not null,
+    qty         integer not null,
+    mass        real    not null,
+    fuel_used   integer not null,
+    status      text    not null check (status in ('succeeded', 'queued', 'failed')),
+    reason      text    not null,
+    primary key (transfer_id, effdt),
+    constraint fk_transfer_id foreign key (transfer_id) references sc_transfer_order (id),
+    constraint fk_empire_id foreign key (empire_id) references empire (id)
+);
+
+-- the draft order table stores the orders that move people between
+-- population groups. a draft order turns unskilled workers into trainees
+-- who join population_cd when their training is done. a discharge order
+-- returns people from population_cd to the unskilled workers.
+create table sc_draft_order
+(
+    id            integer primary key autoincrement,
+    sc_id         integer not null,
+    effdt         integer not null,
+    kind          text    not null check (kind in ('draft', 'discharge')),
+    population_cd text    not null check (population_cd in ('CNW', 'PLC', 'PRO', 'SAG', 'SLD', 'SPY')),
+    qty           integer not null check (qty > 0),
+    constraint fk_sc_id foreign key (sc_id) references scs (id),
+    constraint fk_population_cd foreign key (population_cd) references population_codes (code)
+);
+
+-- the draft result table stores the outcome of a draft or discharge order.
+-- a draft order gets a second result, with a status of 'graduated', on the
+-- turn that its trainees finish their training.
+create table sc_draft_result
+(
+    draft_id  integer not null,
+    effdt     integer not null,
+    empire_id integer not null,
+    qty       integer not null,
+    cngd_used integer not null,
+    status    text    not null check (status in ('succeeded', 'failed', 'graduated')),
+    reason    text    not null,
+    primary key (draft_id, effdt),
+    constraint fk_draft_id foreign key (draft_id) references sc_draft_order (id),
+    constraint fk_empire_id foreign key (empire_id) references empire (id)
+);
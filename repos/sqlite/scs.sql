@@ -25,8 +25,39 @@ set enddt = :enddt
 where sc_id = :sc_id
   and unit_cd = :unit_cd
   and unit_tech_level = :unit_tech_level
+  and is_assembled = :is_assembled
   and effdt = :effdt;
 
+-- UpdateSCInventoryQty updates the quantity, mass, and volume for an inventory entry.
+-- Use this only when the entry was created in the current turn; otherwise,
+-- end-date the entry and create a new one.
+--
+-- name: UpdateSCInventoryQty :exec
+update sc_inventory
+set qty    = :qty,
+    mass   = :mass,
+    volume = :volume
+where sc_id = :sc_id
+  and unit_cd = :unit_cd
+  and unit_tech_level = :unit_tech_level
+  and is_assembled = :is_assembled
+  and effdt = :effdt;
+
+-- DeleteSCInventoryByTurn deletes the inventory entries created on a given turn.
+--
+-- name: DeleteSCInventoryByTurn :exec
+delete
+from sc_inventory
+where effdt = :effdt;
+
+-- UpdateSCInventoryEndDtByTurn re-opens the inventory entries that were
+-- end-dated on a given turn. It is used to undo the changes made on the turn.
+--
+-- name: UpdateSCInventoryEndDtByTurn :exec
+update sc_inventory
+set enddt = :max_enddt
+where enddt = :effdt;
+
 -- CreateSCLocation creates a new colony location entry.
 --
 -- name: CreateSCLocation :exec
@@ -41,6 +72,59 @@ set enddt = :enddt
 where sc_id = :sc_id
   and effdt = :effdt;
 
+-- DeleteSCLocationsByTurn deletes the location entries created on a given turn.
+--
+-- name: DeleteSCLocationsByTurn :exec
+delete
+from sc_location
+where effdt = :effdt;
+
+-- UpdateSCLocationEndDtByTurn re-opens the location entries that were
+-- end-dated on a given turn. It is used to undo the moves and jumps made
+-- on the turn.
+--
+-- name: UpdateSCLocationEndDtByTurn :exec
+update sc_location
+set enddt = :max_enddt
+where enddt = :effdt;
+
+-- CreateSCOwner creates a new owner entry for a ship or colony.
+--
+-- name: CreateSCOwner :exec
+insert into sc_owner (sc_id, effdt, enddt, empire_id)
+values (:sc_id, :effdt, :enddt, :empire_id);
+
+-- UpdateSCOwnerEndDt updates the end date for an owner entry.
+--
+-- name: UpdateSCOwnerEndDt :exec
+update sc_owner
+set enddt = :enddt
+where sc_id = :sc_id
+  and effdt = :effdt;
+
+-- DeleteSCOwnersByTurn deletes the owner entries created on a given turn.
+--
+-- name: DeleteSCOwnersByTurn :exec
+delete
+from sc_owner
+where effdt = :effdt;
+
+-- UpdateSCOwnerEndDtByTurn re-opens the owner entries that were end-dated
+-- on a given turn. It is used to undo the captures made on the turn.
+--
+-- name: UpdateSCOwnerEndDtByTurn :exec
+update sc_owner
+set enddt = :max_enddt
+where enddt = :effdt;
+
+-- ReadSCOwner returns the empire that owns a ship or colony on a given turn.
+--
+-- name: ReadSCOwner :one
+select effdt, empire_id
+from sc_owner
+where sc_id = :sc_id
+  and (effdt <= :as_of_dt and :as_of_dt < enddt);
+
 -- CreateSCName creates a new colony name entry.
 --
 -- name: CreateSCName :exec
@@ -70,11 +154,34 @@ where sc_id = :sc_id
   and population_cd = :population_cd
   and effdt = :effdt;
 
+-- DeleteSCPopulationByTurn deletes the population entries created on a given turn.
+--
+-- name: DeleteSCPopulationByTurn :exec
+delete
+from sc_population
+where effdt = :effdt;
+
+-- UpdateSCPopulationEndDtByTurn re-opens the population entries that were
+-- end-dated on a given turn. It is used to undo the changes made on the turn.
+--
+-- name: UpdateSCPopulationEndDtByTurn :exec
+update sc_population
+set enddt = :max_enddt
+where enddt = :effdt;
+
 -- CreateSCGroup creates a new ship or colony production group.
 --
--- name: CreateSCGroup :exec
+-- name: CreateSCGroup :one
 insert into sc_group (sc_id, kind, effdt, enddt)
-values (:sc_id, :kind, :effdt, :enddt);
+values (:sc_id, :kind, :effdt, :enddt)
+returning id;
+
+-- CreateSCGroupDeposit creates a record of the deposit that a mine group
+-- works.
+--
+-- name: CreateSCGroupDeposit :exec
+insert into sc_group_deposit (group_id, effdt, enddt, deposit_id)
+values (:group_id, :effdt, :enddt, :deposit_id);
 
 -- CreateSCGroupNo creates a new ship or colony production group number.
 -- The number must be between 1 and 35 (or 1 and 40 for deposits). The
@@ -101,16 +208,100 @@ values (:group_id, :effdt, :enddt, :item_cd, :item_tech_level, :retooled);
 insert into sc_group_unit (group_id, tech_level, effdt, enddt, nbr_of_units)
 values (:group_id, :tech_level, :effdt, :enddt, :nbr_of_units);
 
+-- UpdateSCGroupUnitEndDt updates the end date for a set of units in a group.
+--
+-- name: UpdateSCGroupUnitEndDt :exec
+update sc_group_unit
+set enddt = :enddt
+where group_id = :group_id
+  and tech_level = :tech_level
+  and effdt = :effdt;
+
+-- UpdateSCGroupUnitQty updates the number of units in a group. Use this only
+-- when the entry was created in the current turn; otherwise, end-date the
+-- entry and create a new one.
+--
+-- name: UpdateSCGroupUnitQty :exec
+update sc_group_unit
+set nbr_of_units = :nbr_of_units
+where group_id = :group_id
+  and tech_level = :tech_level
+  and effdt = :effdt;
+
+-- DeleteSCGroupUnitsByTurn deletes the group unit entries created on a
+-- given turn.
+--
+-- name: DeleteSCGroupUnitsByTurn :exec
+delete
+from sc_group_unit
+where effdt = :effdt;
+
+-- UpdateSCGroupUnitEndDtByTurn re-opens the group unit entries that were
+-- end-dated on a given turn. It is used to undo the changes made on the turn.
+--
+-- name: UpdateSCGroupUnitEndDtByTurn :exec
+update sc_group_unit
+set enddt = :max_enddt
+where enddt = :effdt;
+
+-- DeleteSCGroupDepositsByTurn deletes the deposits of the mine groups
+-- created on a given turn.
+--
+-- name: DeleteSCGroupDepositsByTurn :exec
+delete
+from sc_group_deposit
+where group_id in (select id
+                   from sc_group
+                   where effdt = :effdt);
+
+-- DeleteSCGroupNosByTurn deletes the numbers of the groups created on a
+-- given turn.
+--
+-- name: DeleteSCGroupNosByTurn :exec
+delete
+from sc_group_no
+where group_id in (select id
+                   from sc_group
+                   where effdt = :effdt);
+
+-- DeleteSCGroupProductionByTurn deletes the production summaries of the
+-- groups created on a given turn.
+--
+-- name: DeleteSCGroupProductionByTurn :exec
+delete
+from sc_group_production_summary
+where group_id in (select id
+                   from sc_group
+                   where effdt = :effdt);
+
+-- DeleteNewSCGroupToolingByTurn deletes the tooling of the factory groups
+-- created on a given turn.
+--
+-- name: DeleteNewSCGroupToolingByTurn :exec
+delete
+from sc_group_tooling
+where group_id in (select id
+                   from sc_group
+                   where effdt = :effdt);
+
+-- DeleteSCGroupsByTurn deletes the groups created on a given turn. Delete
+-- the deposits, numbers, production, tooling, and units first.
+--
+-- name: DeleteSCGroupsByTurn :exec
+delete
+from sc_group
+where effdt = :effdt;
+
 -- CreateSCGroupUnitProduction creates a record of the resources consumed
 -- and created by the units in a single turn.
 --
 -- name: CreateSCGroupUnitProduction :exec
 insert into sc_group_unit_production (group_id, tech_level, production_dt,
-                                      fuel_consumed, gold_consumed, mets_consumed, nmts_consumed,
+                                      fuel_consumed, power_consumed, gold_consumed, mets_consumed, nmts_consumed,
                                       pro_consumed, usk_consumed, aut_consumed,
                                       qty_produced)
 values (:group_id, :tech_level, :production_dt,
-        :fuel_consumed, :gold_consumed, :mets_consumed, :nmts_consumed,
+        :fuel_consumed, :power_consumed, :gold_consumed, :mets_consumed, :nmts_consumed,
         :pro_consumed, :usk_consumed, :aut_consumed,
         :qty_produced);
 
@@ -127,11 +318,11 @@ values (:group_id, :tech_level, :production_dt,
 --
 -- name: CreateSCGroupProductionSummary :exec
 insert into sc_group_production_summary (group_id, production_dt,
-                                         fuel_consumed, gold_consumed, mets_consumed, nmts_consumed,
+                                         fuel_consumed, power_consumed, gold_consumed, mets_consumed, nmts_consumed,
                                          pro_consumed, usk_consumed, aut_consumed,
                                          qty_produced)
 values (:group_id, :production_dt,
-        :fuel_consumed, :gold_consumed, :mets_consumed, :nmts_consumed,
+        :fuel_consumed, :power_consumed, :gold_consumed, :mets_consumed, :nmts_consumed,
         :pro_consumed, :usk_consumed, :aut_consumed,
         :qty_produced);
 
@@ -205,6 +396,77 @@ delete
 from sc_probe_star_orbit_result
 where effdt = :effdt;
 
+-- CreateSCProbeResult adds a new result.
+--
+-- name: CreateSCProbeResult :exec
+insert into sc_probe_result (probe_id, effdt,
+                             unit_cd, unit_tech_level, units_used, fuel_used,
+                             status, reason)
+values (:probe_id, :effdt,
+        :unit_cd, :unit_tech_level, :units_used, :fuel_used,
+        :status, :reason);
+
+-- DeleteSCProbeResultsByTurn deletes the results of all probes for a given turn.
+--
+-- name: DeleteSCProbeResultsByTurn :exec
+delete
+from sc_probe_result
+where effdt = :effdt;
+
+-- CreateSCJumpOrder creates a new ship jump order.
+--
+-- name: CreateSCJumpOrder :one
+insert into sc_jump_order (sc_id, effdt, orbit_id)
+values (:sc_id, :effdt, :orbit_id)
+returning id;
+
+-- CreateSCJumpResult adds a new result.
+--
+-- name: CreateSCJumpResult :exec
+insert into sc_jump_result (jump_id, effdt,
+                            from_orbit_id, to_orbit_id,
+                            distance, mass, engines_used, fuel_used,
+                            status, reason)
+values (:jump_id, :effdt,
+        :from_orbit_id, :to_orbit_id,
+        :distance, :mass, :engines_used, :fuel_used,
+        :status, :reason);
+
+-- DeleteSCJumpResultsByTurn deletes the results of all jumps for a given turn.
+--
+-- name: DeleteSCJumpResultsByTurn :exec
+delete
+from sc_jump_result
+where effdt = :effdt;
+
+-- CreateSCMoveOrder creates a new ship move order.
+--
+-- name: CreateSCMoveOrder :one
+insert into sc_move_order (sc_id, effdt, orbit_id, is_on_surface)
+values (:sc_id, :effdt, :orbit_id, :is_on_surface)
+returning id;
+
+-- CreateSCMoveResult adds a new result.
+--
+-- name: CreateSCMoveResult :exec
+insert into sc_move_result (move_id, effdt,
+                            from_orbit_id, from_is_on_surface,
+                            to_orbit_id, to_is_on_surface,
+                            mass, thrust, fuel_used,
+                            status, reason)
+values (:move_id, :effdt,
+        :from_orbit_id, :from_is_on_surface,
+        :to_orbit_id, :to_is_on_surface,
+        :mass, :thrust, :fuel_used,
+        :status, :reason);
+
+-- DeleteSCMoveResultsByTurn deletes the results of all moves for a given turn.
+--
+-- name: DeleteSCMoveResultsByTurn :exec
+delete
+from sc_move_result
+where effdt = :effdt;
+
 -- CreateSCSurveyOrder creates a new colony survey order.
 --
 -- name: CreateSCSurveyOrder :one
@@ -212,6 +474,19 @@ insert into sc_survey_order (sc_id, effdt, target_id, kind)
 values (:sc_id, :effdt, :target_id, :kind)
 returning id;
 
+-- CreateSCSurveyDepositResult adds a new deposit to the result of a survey.
+--
+-- name: CreateSCSurveyDepositResult :exec
+insert into sc_survey_deposit_result (survey_id, effdt, deposit_no, deposit_kind, deposit_qty, yield_pct)
+values (:survey_id, :effdt, :deposit_no, :deposit_kind, :deposit_qty, :yield_pct);
+
+-- DeleteSCSurveyDepositResultsByTurn deletes the deposits found by all surveys for a given turn.
+--
+-- name: DeleteSCSurveyDepositResultsByTurn :exec
+delete
+from sc_survey_deposit_result
+where effdt = :effdt;
+
 -- CreateSCSurveyOrbitResult adds a new result.
 --
 -- name: CreateSCSurveyOrbitResult :exec
@@ -233,6 +508,87 @@ delete
 from sc_survey_orbit_result
 where effdt = :effdt;
 
+-- CreateSCSurveyResult adds a new result.
+--
+-- name: CreateSCSurveyResult :exec
+insert into sc_survey_result (survey_id, effdt, status, reason)
+values (:survey_id, :effdt, :status, :reason);
+
+-- DeleteSCSurveyResultsByTurn deletes the results of all surveys for a given turn.
+--
+-- name: DeleteSCSurveyResultsByTurn :exec
+delete
+from sc_survey_result
+where effdt = :effdt;
+
+-- CreateSCCombatOrder creates a new attack or support order.
+--
+-- name: CreateSCCombatOrder :one
+insert into sc_combat_order (sc_id, effdt, kind, target_id, support_id, pct_committed, raid_unit_cd)
+values (:sc_id, :effdt, :kind, :target_id, :support_id, :pct_committed, :raid_unit_cd)
+returning id;
+
+-- CreateSCCombatResult adds a new result.
+--
+-- name: CreateSCCombatResult :exec
+insert into sc_combat_result (combat_id, effdt, empire_id, target_empire_id, rounds, mass_pct, status, reason)
+values (:combat_id, :effdt, :empire_id, :target_empire_id, :rounds, :mass_pct, :status, :reason);
+
+-- DeleteSCCombatResultsByTurn deletes the results of all combat orders for a given turn.
+--
+-- name: DeleteSCCombatResultsByTurn :exec
+delete
+from sc_combat_result
+where effdt = :effdt;
+
+-- CreateSCCombatLoss adds to the units or population lost by a ship or colony on a turn.
+--
+-- name: CreateSCCombatLoss :exec
+insert into sc_combat_loss (sc_id, effdt, empire_id, code, tech_level, qty)
+values (:sc_id, :effdt, :empire_id, :code, :tech_level, :qty)
+on conflict (sc_id, effdt, code, tech_level) do update
+    set qty = qty + excluded.qty;
+
+-- DeleteSCCombatLossesByTurn deletes the combat losses for a given turn.
+--
+-- name: DeleteSCCombatLossesByTurn :exec
+delete
+from sc_combat_loss
+where effdt = :effdt;
+
+-- CreateSCEspionageOrder creates a new espionage mission.
+--
+-- name: CreateSCEspionageOrder :one
+insert into sc_espionage_order (sc_id, effdt, kind, target_id, qty)
+values (:sc_id, :effdt, :kind, :target_id, :qty)
+returning id;
+
+-- CreateSCEspionageResult adds a new result.
+--
+-- name: CreateSCEspionageResult :exec
+insert into sc_espionage_result (espionage_id, effdt, empire_id, target_empire_id,
+                                 agents_lost, agents_through, counter_lost, effect_qty, status, reason)
+values (:espionage_id, :effdt, :empire_id, :target_empire_id,
+        :agents_lost, :agents_through, :counter_lost, :effect_qty, :status, :reason);
+
+-- DeleteSCEspionageResultsByTurn deletes the results of all espionage missions for a given turn.
+--
+-- name: DeleteSCEspionageResultsByTurn :exec
+delete
+from sc_espionage_result
+where effdt = :effdt;
+
+-- UpdateSCPopulationQty updates the quantity and rebels of a population entry.
+-- It is used for entries that were created on the current turn.
+--
+-- name: UpdateSCPopulationQty :exec
+update sc_population
+set qty       = :qty,
+    rebel_qty = :rebel_qty
+where sc_id = :sc_id
+  and population_cd = :population_cd
+  and effdt = :effdt;
+
 -- ReadAllColoniesByEmpire returns a list of all colonies for an empire
 -- that were active on a given turn.
 --
@@ -250,7 +606,8 @@ select scs.id        as sc_id,
        sc_rates.birth_rate,
        sc_rates.death_rate,
        sc_rates.sol
-from scs,
+from sc_owner,
+     scs,
      sc_codes,
      sc_name,
      sc_rates,
@@ -258,7 +615,9 @@ from scs,
      orbits,
      stars,
      systems
-where scs.empire_id = :empire_id
+where sc_owner.empire_id = :empire_id
+  and (sc_owner.effdt <= :as_of_dt and :as_of_dt < sc_owner.enddt)
+  and scs.id = sc_owner.sc_id
   and scs.sc_cd in ('COPN', 'CENC', 'CORB')
   and sc_codes.code = scs.sc_cd
   and sc_name.sc_id = scs.id
@@ -272,21 +631,396 @@ where scs.empire_id = :empire_id
   and systems.id = orbits.system_id
 order by scs.id;
 
+-- ReadAllCombatLossesByEmpire returns a list of the units and population lost
+-- in combat by all the ships and colonies in an empire for a given turn.
+-- A colony that was captured is reported to the empire that lost it.
+--
+-- name: ReadAllCombatLossesByEmpire :many
+select sc_combat_loss.sc_id,
+       sc_combat_loss.code,
+       sc_combat_loss.tech_level,
+       sc_combat_loss.qty
+from sc_combat_loss
+where sc_combat_loss.empire_id = :empire_id
+  and sc_combat_loss.effdt = :as_of_dt
+order by sc_combat_loss.sc_id, sc_combat_loss.code, sc_combat_loss.tech_level;
+
+-- ReadAllCombatOrdersByTurn returns a list of attack and support orders issued in a given turn of a game.
+--
+-- name: ReadAllCombatOrdersByTurn :many
+select sc_combat_order.id as combat_id,
+       sc_combat_order.sc_id,
+       sc_owner.empire_id,
+       sc_combat_order.kind,
+       sc_combat_order.target_id,
+       sc_combat_order.support_id,
+       sc_combat_order.pct_committed,
+       sc_combat_order.raid_unit_cd
+from sc_combat_order,
+     sc_owner
+where sc_combat_order.effdt = :as_of_dt
+  and sc_owner.sc_id = sc_combat_order.sc_id
+  and (sc_owner.effdt <= :as_of_dt and :as_of_dt < sc_owner.enddt)
+order by sc_combat_order.id;
+
+-- ReadAllCombatResultsByEmpire returns a list of the results of the battles
+-- that the ships and colonies in an empire fought in on a given turn. The
+-- defender sees the results of the orders that targeted it. Owners are
+-- as of the battle, so the empire that lost a colony sees the invasion.
+--
+-- name: ReadAllCombatResultsByEmpire :many
+select sc_combat_order.id                as combat_id,
+       sc_combat_order.sc_id,
+       sc_combat_result.empire_id        as sc_empire_id,
+       sc_combat_order.kind,
+       sc_combat_order.target_id,
+       sc_combat_result.target_empire_id,
+       sc_combat_order.pct_committed,
+       sc_combat_result.rounds,
+       sc_combat_result.mass_pct,
+       sc_combat_result.status,
+       sc_combat_result.reason
+from sc_combat_order,
+     sc_combat_result
+where sc_combat_result.combat_id = sc_combat_order.id
+  and sc_combat_result.effdt = :as_of_dt
+  and (sc_combat_result.empire_id = :empire_id or sc_combat_result.target_empire_id = :empire_id)
+order by sc_combat_order.target_id, sc_combat_order.id;
+
+-- ReadAllEspionageOrdersByTurn returns a list of espionage missions ordered in a given turn of a game.
+--
+-- name: ReadAllEspionageOrdersByTurn :many
+select sc_espionage_order.id as espionage_id,
+       sc_espionage_order.sc_id,
+       sc_owner.empire_id,
+       sc_espionage_order.kind,
+       sc_espionage_order.target_id,
+       sc_espionage_order.qty
+from sc_espionage_order,
+     sc_owner
+where sc_espionage_order.effdt = :as_of_dt
+  and sc_owner.sc_id = sc_espionage_order.sc_id
+  and (sc_owner.effdt <= :as_of_dt and :as_of_dt < sc_owner.enddt)
+order by sc_espionage_order.id;
+
+-- ReadAllEspionageResultsByEmpire returns a list of the results of the
+-- espionage missions run by an empire or against it on a given turn.
+-- Owners are as of the mission. The caller is responsible for hiding the
+-- details of missions against the empire.
+--
+-- name: ReadAllEspionageResultsByEmpire :many
+select sc_espionage_order.id                as espionage_id,
+       sc_espionage_order.sc_id,
+       sc_espionage_result.empire_id        as sc_empire_id,
+       sc_espionage_order.kind,
+       sc_espionage_order.target_id,
+       sc_espionage_result.target_empire_id,
+       sc_espionage_order.qty,
+       sc_espionage_result.agents_lost,
+       sc_espionage_result.agents_through,
+       sc_espionage_result.counter_lost,
+       sc_espionage_result.effect_qty,
+       sc_espionage_result.status,
+       sc_espionage_result.reason
+from sc_espionage_order,
+     sc_espionage_result
+where sc_espionage_result.espionage_id = sc_espionage_order.id
+  and sc_espionage_result.effdt = :as_of_dt
+  and (sc_espionage_result.empire_id = :empire_id or sc_espionage_result.target_empire_id = :empire_id)
+order by sc_espionage_order.target_id, sc_espionage_order.id;
+
+-- ReadAllJumpOrdersByTurn returns a list of jump orders issued in a given turn of a game.
+--
+-- name: ReadAllJumpOrdersByTurn :many
+select sc_jump_order.id as jump_id,
+       sc_jump_order.sc_id,
+       scs.sc_cd,
+       sc_jump_order.orbit_id,
+       systems.id       as system_id,
+       systems.x,
+       systems.y,
+       systems.z
+from sc_jump_order,
+     scs,
+     orbits,
+     systems
+where sc_jump_order.effdt = :as_of_dt
+  and scs.id = sc_jump_order.sc_id
+  and orbits.id = sc_jump_order.orbit_id
+  and systems.id = orbits.system_id
+order by sc_jump_order.sc_id, sc_jump_order.id;
+
+-- ReadAllJumpResultsByEmpire returns a list of the jump results for all the
+-- ships in an empire for a given turn.
+--
+-- name: ReadAllJumpResultsByEmpire :many
+select sc_jump_order.sc_id,
+       from_star.star_name as from_star_name,
+       from_orbit.orbit_no as from_orbit_no,
+       to_star.star_name   as to_star_name,
+       to_orbit.orbit_no   as to_orbit_no,
+       sc_jump_result.distance,
+       sc_jump_result.mass,
+       sc_jump_result.engines_used,
+       sc_jump_result.fuel_used,
+       sc_jump_result.status,
+       sc_jump_result.reason
+from sc_owner,
+     scs,
+     sc_jump_order,
+     sc_jump_result,
+     orbits as from_orbit,
+     stars as from_star,
+     orbits as to_orbit,
+     stars as to_star
+where sc_owner.empire_id = :empire_id
+  and (sc_owner.effdt <= :as_of_dt and :as_of_dt < sc_owner.enddt)
+  and scs.id = sc_owner.sc_id
+  and sc_jump_order.sc_id = scs.id
+  and sc_jump_result.jump_id = sc_jump_order.id
+  and sc_jump_result.effdt = :as_of_dt
+  and from_orbit.id = sc_jump_result.from_orbit_id
+  and from_star.id = from_orbit.star_id
+  and to_orbit.id = sc_jump_result.to_orbit_id
+  and to_star.id = to_orbit.star_id
+order by sc_jump_order.sc_id, sc_jump_order.id;
+
+-- ReadAllMoveOrdersByTurn returns a list of move orders issued in a given turn of a game.
+--
+-- name: ReadAllMoveOrdersByTurn :many
+select sc_move_order.id as move_id,
+       sc_move_order.sc_id,
+       scs.sc_cd,
+       sc_move_order.orbit_id,
+       orbits.system_id,
+       orbits.kind      as orbit_kind,
+       sc_move_order.is_on_surface
+from sc_move_order,
+     scs,
+     orbits
+where sc_move_order.effdt = :as_of_dt
+  and scs.id = sc_move_order.sc_id
+  and orbits.id = sc_move_order.orbit_id
+order by sc_move_order.sc_id, sc_move_order.id;
+
+-- ReadAllMoveResultsByEmpire returns a list of the move results for all the
+-- ships in an empire for a given turn.
+--
+-- name: ReadAllMoveResultsByEmpire :many
+select sc_move_order.sc_id,
+       from_star.star_name as from_star_name,
+       from_orbit.orbit_no as from_orbit_no,
+       sc_move_result.from_is_on_surface,
+       to_star.star_name   as to_star_name,
+       to_orbit.orbit_no   as to_orbit_no,
+       sc_move_result.to_is_on_surface,
+       sc_move_result.mass,
+       sc_move_result.thrust,
+       sc_move_result.fuel_used,
+       sc_move_result.status,
+       sc_move_result.reason
+from sc_owner,
+     scs,
+     sc_move_order,
+     sc_move_result,
+     orbits as from_orbit,
+     stars as from_star,
+     orbits as to_orbit,
+     stars as to_star
+where sc_owner.empire_id = :empire_id
+  and (sc_owner.effdt <= :as_of_dt and :as_of_dt < sc_owner.enddt)
+  and scs.id = sc_owner.sc_id
+  and sc_move_order.sc_id = scs.id
+  and sc_move_result.move_id = sc_move_order.id
+  and sc_move_result.effdt = :as_of_dt
+  and from_orbit.id = sc_move_result.from_orbit_id
+  and from_star.id = from_orbit.star_id
+  and to_orbit.id = sc_move_result.to_orbit_id
+  and to_star.id = to_orbit.star_id
+order by sc_move_order.sc_id, sc_move_order.id;
+
+-- ReadAllProbeOrdersByTurn returns a list of probe orders issued in a given turn of a game.
+--
+-- name: ReadAllProbeOrdersByTurn :many
+select sc_probe_order.id as probe_id,
+       sc_probe_order.sc_id,
+       sc_owner.empire_id,
+       scs.sc_cd,
+       sc_probe_order.target_id,
+       sc_probe_order.kind
+from sc_probe_order,
+     scs,
+     sc_owner
+where sc_probe_order.effdt = :as_of_dt
+  and scs.id = sc_probe_order.sc_id
+  and sc_owner.sc_id = scs.id
+  and (sc_owner.effdt <= :as_of_dt and :as_of_dt < sc_owner.enddt)
+order by sc_probe_order.sc_id, sc_probe_order.id;
+
+-- ReadAllSCsByOrbit returns a list of the ships and colonies in an orbit on a given turn.
+--
+-- name: ReadAllSCsByOrbit :many
+select scs.id as sc_id,
+       sc_owner.empire_id,
+       scs.sc_cd,
+       sc_location.is_on_surface
+from sc_location,
+     scs,
+     sc_owner
+where sc_location.orbit_id = :orbit_id
+  and (sc_location.effdt <= :as_of_dt and :as_of_dt < sc_location.enddt)
+  and scs.id = sc_location.sc_id
+  and sc_owner.sc_id = scs.id
+  and (sc_owner.effdt <= :as_of_dt and :as_of_dt < sc_owner.enddt)
+order by scs.id;
+
+-- ReadAllShipsByEmpire returns a list of all ships for an empire
+-- that were active on a given turn.
+--
+-- name: ReadAllShipsByEmpire :many
+select scs.id     as sc_id,
+       systems.id as system_id,
+       systems.system_name,
+       stars.id   as star_id,
+       stars.star_name,
+       orbits.orbit_no,
+       sc_location.is_on_surface,
+       scs.sc_tech_level,
+       sc_name.name
+from sc_owner,
+     scs,
+     sc_name,
+     sc_location,
+     orbits,
+     stars,
+     systems
+where sc_owner.empire_id = :empire_id
+  and (sc_owner.effdt <= :as_of_dt and :as_of_dt < sc_owner.enddt)
+  and scs.id = sc_owner.sc_id
+  and scs.sc_cd = 'SHIP'
+  and sc_name.sc_id = scs.id
+  and (sc_name.effdt <= :as_of_dt and :as_of_dt < sc_name.enddt)
+  and sc_location.sc_id = scs.id
+  and (sc_location.effdt <= :as_of_dt and :as_of_dt < sc_location.enddt)
+  and orbits.id = sc_location.orbit_id
+  and stars.id = orbits.star_id
+  and systems.id = orbits.system_id
+order by scs.id;
+
+-- ReadAllSurveyDepositResultsByEmpire returns a list of the deposits found
+-- by all the surveys of an empire for a given turn.
+--
+-- name: ReadAllSurveyDepositResultsByEmpire :many
+select sc_survey_deposit_result.survey_id,
+       sc_survey_deposit_result.deposit_no,
+       sc_survey_deposit_result.deposit_kind,
+       sc_survey_deposit_result.deposit_qty,
+       sc_survey_deposit_result.yield_pct
+from sc_owner,
+     scs,
+     sc_survey_order,
+     sc_survey_deposit_result
+where sc_owner.empire_id = :empire_id
+  and (sc_owner.effdt <= :as_of_dt and :as_of_dt < sc_owner.enddt)
+  and scs.id = sc_owner.sc_id
+  and sc_survey_order.sc_id = scs.id
+  and sc_survey_deposit_result.survey_id = sc_survey_order.id
+  and sc_survey_deposit_result.effdt = :as_of_dt
+order by sc_survey_deposit_result.survey_id, sc_survey_deposit_result.deposit_no;
+
+-- ReadAllSurveyOrbitResultsByEmpire returns a list of the results of all
+-- the surveys of an empire for a given turn.
+--
+-- name: ReadAllSurveyOrbitResultsByEmpire :many
+select sc_survey_orbit_result.survey_id,
+       sc_survey_order.sc_id,
+       orbits.star_id,
+       sc_survey_orbit_result.orbit_id,
+       sc_survey_orbit_result.location,
+       sc_survey_orbit_result.orbit_no,
+       sc_survey_orbit_result.habitability_no,
+       sc_survey_orbit_result.farmland_in_use,
+       sc_survey_orbit_result.population
+from sc_owner,
+     scs,
+     sc_survey_order,
+     sc_survey_orbit_result,
+     orbits
+where sc_owner.empire_id = :empire_id
+  and (sc_owner.effdt <= :as_of_dt and :as_of_dt < sc_owner.enddt)
+  and scs.id = sc_owner.sc_id
+  and sc_survey_order.sc_id = scs.id
+  and sc_survey_orbit_result.survey_id = sc_survey_order.id
+  and sc_survey_orbit_result.effdt = :as_of_dt
+  and orbits.id = sc_survey_orbit_result.orbit_id
+order by sc_survey_orbit_result.survey_id;
+
+-- ReadAllFailedSurveysByEmpire returns a list of the surveys of an empire
+-- that failed in a given turn.
+--
+-- name: ReadAllFailedSurveysByEmpire :many
+select sc_survey_result.survey_id,
+       sc_survey_order.sc_id,
+       sc_survey_result.reason
+from sc_owner,
+     scs,
+     sc_survey_order,
+     sc_survey_result
+where sc_owner.empire_id = :empire_id
+  and (sc_owner.effdt <= :as_of_dt and :as_of_dt < sc_owner.enddt)
+  and scs.id = sc_owner.sc_id
+  and sc_survey_order.sc_id = scs.id
+  and sc_survey_result.survey_id = sc_survey_order.id
+  and sc_survey_result.effdt = :as_of_dt
+  and sc_survey_result.status = 'failed'
+order by sc_survey_result.survey_id;
+
 -- ReadAllSurveyOrdersByTurn returns a list of survey orders issued in a given turn of a game.
 --
 -- name: ReadAllSurveyOrdersByTurn :many
-select empire.id as empire_id,
+select empire.id          as empire_id,
+       sc_survey_order.id as survey_id,
        sc_survey_order.sc_id,
        sc_survey_order.effdt,
-       sc_survey_order.target_id
+       sc_survey_order.target_id,
+       sc_survey_order.kind
 from sc_survey_order,
-     scs,
+     sc_owner,
      empire
 where sc_survey_order.effdt = :as_of_dt
-  and scs.id = sc_survey_order.sc_id
-  and empire.id = scs.empire_id
+  and sc_owner.sc_id = sc_survey_order.sc_id
+  and (sc_owner.effdt <= :as_of_dt and :as_of_dt < sc_owner.enddt)
+  and empire.id = sc_owner.empire_id
 order by empire.id, sc_survey_order.sc_id, sc_survey_order.effdt, sc_survey_order.target_id;
 
+-- ReadSC returns the code and tech level of a ship or colony.
+--
+-- name: ReadSC :one
+select empire_id,
+       sc_cd,
+       sc_tech_level
+from scs
+where id = :sc_id;
+
+-- ReadSCTypeFactor returns the type factor for a kind of ship or colony.
+--
+-- name: ReadSCTypeFactor :one
+select type_factor
+from sc_codes
+where code = :sc_cd;
+
+-- ReadSCRates returns the rates for a colony on a given turn.
+--
+-- name: ReadSCRates :one
+select effdt,
+       rations,
+       sol,
+       birth_rate,
+       death_rate
+from sc_rates
+where sc_id = :sc_id
+  and (effdt <= :as_of_dt and :as_of_dt < enddt);
+
 -- ReadSCPopulation returns a list of the population for a given colony.
 --
 -- name: ReadSCPopulation :many
@@ -304,6 +1038,25 @@ where scs.id = :sc_id
   and population_codes.code = sc_population.population_cd
 order by population_codes.sort_order;
 
+-- ReadPopulationBasePayRate returns the base pay rate for a population code.
+--
+-- name: ReadPopulationBasePayRate :one
+select base_pay_rate
+from population_codes
+where code = :code;
+
+-- ReadSCPopulationCode returns the population entry for a single population code.
+--
+-- name: ReadSCPopulationCode :one
+select sc_population.effdt,
+       sc_population.qty,
+       sc_population.pay_rate,
+       sc_population.rebel_qty
+from sc_population
+where sc_population.sc_id = :sc_id
+  and sc_population.population_cd = :population_cd
+  and (sc_population.effdt <= :as_of_dt and :as_of_dt < sc_population.enddt);
+
 -- ReadSCInventory returns a list of the inventory for a given colony.
 --
 -- name: ReadSCInventory :many
@@ -322,6 +1075,44 @@ where sc_inventory.sc_id = :sc_id
   and unit_codes.code = sc_inventory.unit_cd
 order by sc_inventory.unit_cd, sc_inventory.unit_tech_level, sc_inventory.qty;
 
+-- ReadSCInventoryUnit returns the inventory entry for a single unit and tech level
+-- that is either assembled or in storage.
+--
+-- name: ReadSCInventoryUnit :one
+select sc_inventory.effdt,
+       sc_inventory.qty,
+       sc_inventory.mass,
+       sc_inventory.volume,
+       sc_inventory.is_assembled,
+       sc_inventory.is_stored
+from sc_inventory
+where sc_inventory.sc_id = :sc_id
+  and sc_inventory.unit_cd = :unit_cd
+  and sc_inventory.unit_tech_level = :unit_tech_level
+  and sc_inventory.is_assembled = :is_assembled
+  and (sc_inventory.effdt <= :as_of_dt and :as_of_dt < sc_inventory.enddt);
+
+-- ReadSCLocation returns the location of a ship or colony on a given turn.
+--
+-- name: ReadSCLocation :one
+select sc_location.effdt,
+       sc_location.orbit_id,
+       sc_location.is_on_surface,
+       orbits.system_id,
+       orbits.star_id,
+       orbits.orbit_no,
+       orbits.kind as orbit_kind,
+       systems.x,
+       systems.y,
+       systems.z
+from sc_location,
+     orbits,
+     systems
+where sc_location.sc_id = :sc_id
+  and (sc_location.effdt <= :as_of_dt and :as_of_dt < sc_location.enddt)
+  and orbits.id = sc_location.orbit_id
+  and systems.id = orbits.system_id;
+
 -- ReadSCGroups returns a list of the groups for a given ship or colony.
 --
 -- name: ReadSCGroups :many
@@ -338,11 +1129,27 @@ where scs.id = :sc_id
   and (sc_group_no.effdt <= :as_of_dt and :as_of_dt < sc_group_no.enddt)
 order by sc_group_no.group_no;
 
+-- ReadSCGroupsByGroupNo returns all the groups of a ship or colony in the
+-- order that resources are assigned to them.
+--
+-- name: ReadSCGroupsByGroupNo :many
+select sc_group.id as group_id,
+       sc_group.kind,
+       sc_group_no.group_no
+from sc_group,
+     sc_group_no
+where sc_group.sc_id = :sc_id
+  and (sc_group.effdt <= :as_of_dt and :as_of_dt < sc_group.enddt)
+  and sc_group_no.group_id = sc_group.id
+  and (sc_group_no.effdt <= :as_of_dt and :as_of_dt < sc_group_no.enddt)
+order by sc_group_no.group_no, sc_group.kind;
+
 -- ReadSCGroupTooling returns a list of the factory groups for a given colony.
 --
 -- name: ReadSCGroupTooling :many
 select sc_group.id as group_id,
        sc_group_no.group_no,
+       sc_group_tooling.effdt,
        sc_group_tooling.item_cd,
        sc_group_tooling.item_tech_level,
        sc_group_tooling.retooled
@@ -374,6 +1181,51 @@ where sc_group.sc_id = :sc_id
   and (sc_group_unit.effdt <= :as_of_dt and :as_of_dt < sc_group_unit.enddt)
 order by sc_group_unit.tech_level, nbr_of_units;
 
+-- ReadSCGroupUnitsByGroup returns a list of the units in a single group.
+--
+-- name: ReadSCGroupUnitsByGroup :many
+select tech_level,
+       nbr_of_units
+from sc_group_unit
+where group_id = :group_id
+  and (effdt <= :as_of_dt and :as_of_dt < enddt)
+order by tech_level;
+
+-- ReadSCGroupUnit returns the units in a group with a single tech level.
+--
+-- name: ReadSCGroupUnit :one
+select effdt,
+       enddt,
+       nbr_of_units
+from sc_group_unit
+where group_id = :group_id
+  and tech_level = :tech_level
+  and (effdt <= :as_of_dt and :as_of_dt < enddt);
+
+-- ReadSCGroupUnitWIPByGroup returns the work in progress for the units in
+-- a single group at the end of a given turn.
+--
+-- name: ReadSCGroupUnitWIPByGroup :many
+select tech_level,
+       wip_25pct_qty,
+       wip_50pct_qty,
+       wip_75pct_qty
+from sc_group_unit_production_wip
+where group_id = :group_id
+  and production_dt = :production_dt
+order by tech_level;
+
+-- ReadSCPowerConsumed returns the power used by all the groups of a ship
+-- or colony on a turn.
+--
+-- name: ReadSCPowerConsumed :one
+select cast(coalesce(sum(sc_group_production_summary.power_consumed), 0) as integer) as power_consumed
+from sc_group,
+     sc_group_production_summary
+where sc_group.sc_id = :sc_id
+  and sc_group_production_summary.group_id = sc_group.id
+  and sc_group_production_summary.production_dt = :production_dt;
+
 -- ReadSCProbeOrders returns a list of probe orders issued by a colony on a given turn.
 --
 -- name: ReadSCProbeOrders :exec
@@ -29,9 +29,167 @@ func (q *Queries) CreateSC(ctx context.Context, arg CreateSCParams) (int64, erro
 	return id, err
 }
 
-const createSCGroup = `-- name: CreateSCGroup :exec
+const createSCCombatLoss = `-- name: CreateSCCombatLoss :exec
+insert into sc_combat_loss (sc_id, effdt, empire_id, code, tech_level, qty)
+values (?1, ?2, ?3, ?4, ?5, ?6)
+on conflict (sc_id, effdt, code, tech_level) do update
+    set qty = qty + excluded.qty
+`
+
+type CreateSCCombatLossParams struct {
+	ScID      int64
+	Effdt     int64
+	EmpireID  int64
+	Code      string
+	TechLevel int64
+	Qty       int64
+}
+
+// CreateSCCombatLoss adds to the units or population lost by a ship or colony on a turn.
+func (q *Queries) CreateSCCombatLoss(ctx context.Context, arg CreateSCCombatLossParams) error {
+	_, err := q.db.ExecContext(ctx, createSCCombatLoss,
+		arg.ScID,
+		arg.Effdt,
+		arg.EmpireID,
+		arg.Code,
+		arg.TechLevel,
+		arg.Qty,
+	)
+	return err
+}
+
+const createSCCombatOrder = `-- name: CreateSCCombatOrder :one
+insert into sc_combat_order (sc_id, effdt, kind, target_id, support_id, pct_committed, raid_unit_cd)
+values (?1, ?2, ?3, ?4, ?5, ?6, ?7)
+returning id
+`
+
+type CreateSCCombatOrderParams struct {
+	ScID         int64
+	Effdt        int64
+	Kind         string
+	TargetID     int64
+	SupportID    int64
+	PctCommitted int64
+	RaidUnitCd   string
+}
+
+// CreateSCCombatOrder creates a new attack or support order.
+func (q *Queries) CreateSCCombatOrder(ctx context.Context, arg CreateSCCombatOrderParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, createSCCombatOrder,
+		arg.ScID,
+		arg.Effdt,
+		arg.Kind,
+		arg.TargetID,
+		arg.SupportID,
+		arg.PctCommitted,
+		arg.RaidUnitCd,
+	)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
+const createSCCombatResult = `-- name: CreateSCCombatResult :exec
+insert into sc_combat_result (combat_id, effdt, empire_id, target_empire_id, rounds, mass_pct, status, reason)
+values (?1, ?2, ?3, ?4, ?5, ?6, ?7, ?8)
+`
+
+type CreateSCCombatResultParams struct {
+	CombatID       int64
+	Effdt          int64
+	EmpireID       int64
+	TargetEmpireID int64
+	Rounds         int64
+	MassPct        float64
+	Status         string
+	Reason         string
+}
+
+// CreateSCCombatResult adds a new result.
+func (q *Queries) CreateSCCombatResult(ctx context.Context, arg CreateSCCombatResultParams) error {
+	_, err := q.db.ExecContext(ctx, createSCCombatResult,
+		arg.CombatID,
+		arg.Effdt,
+		arg.EmpireID,
+		arg.TargetEmpireID,
+		arg.Rounds,
+		arg.MassPct,
+		arg.Status,
+		arg.Reason,
+	)
+	return err
+}
+
+const createSCEspionageOrder = `-- name: CreateSCEspionageOrder :one
+insert into sc_espionage_order (sc_id, effdt, kind, target_id, qty)
+values (?1, ?2, ?3, ?4, ?5)
+returning id
+`
+
+type CreateSCEspionageOrderParams struct {
+	ScID     int64
+	Effdt    int64
+	Kind     string
+	TargetID int64
+	Qty      int64
+}
+
+// CreateSCEspionageOrder creates a new espionage mission.
+func (q *Queries) CreateSCEspionageOrder(ctx context.Context, arg CreateSCEspionageOrderParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, createSCEspionageOrder,
+		arg.ScID,
+		arg.Effdt,
+		arg.Kind,
+		arg.TargetID,
+		arg.Qty,
+	)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
+const createSCEspionageResult = `-- name: CreateSCEspionageResult :exec
+insert into sc_espionage_result (espionage_id, effdt, empire_id, target_empire_id,
+                                 agents_lost, agents_through, counter_lost, effect_qty, status, reason)
+values (?1, ?2, ?3, ?4,
+        ?5, ?6, ?7, ?8, ?9, ?10)
+`
+
+type CreateSCEspionageResultParams struct {
+	EspionageID    int64
+	Effdt          int64
+	EmpireID       int64
+	TargetEmpireID int64
+	AgentsLost     int64
+	AgentsThrough  int64
+	CounterLost    int64
+	EffectQty      int64
+	Status         string
+	Reason         string
+}
+
+// CreateSCEspionageResult adds a new result.
+func (q *Queries) CreateSCEspionageResult(ctx context.Context, arg CreateSCEspionageResultParams) error {
+	_, err := q.db.ExecContext(ctx, createSCEspionageResult,
+		arg.EspionageID,
+		arg.Effdt,
+		arg.EmpireID,
+		arg.TargetEmpireID,
+		arg.AgentsLost,
+		arg.AgentsThrough,
+		arg.CounterLost,
+		arg.EffectQty,
+		arg.Status,
+		arg.Reason,
+	)
+	return err
+}
+
+const createSCGroup = `-- name: CreateSCGroup :one
 insert into sc_group (sc_id, kind, effdt, enddt)
 values (?1, ?2, ?3, ?4)
+returning id
 `
 
 type CreateSCGroupParams struct {
@@ -42,13 +200,39 @@ type CreateSCGroupParams struct {
 }
 
 // CreateSCGroup creates a new ship or colony production group.
-func (q *Queries) CreateSCGroup(ctx context.Context, arg CreateSCGroupParams) error {
-	_, err := q.db.ExecContext(ctx, createSCGroup,
+func (q *Queries) CreateSCGroup(ctx context.Context, arg CreateSCGroupParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, createSCGroup,
 		arg.ScID,
 		arg.Kind,
 		arg.Effdt,
 		arg.Enddt,
 	)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
+const createSCGroupDeposit = `-- name: CreateSCGroupDeposit :exec
+insert into sc_group_deposit (group_id, effdt, enddt, deposit_id)
+values (?1, ?2, ?3, ?4)
+`
+
+type CreateSCGroupDepositParams struct {
+	GroupID   int64
+	Effdt     int64
+	Enddt     int64
+	DepositID int64
+}
+
+// CreateSCGroupDeposit creates a record of the deposit that a mine group
+// works.
+func (q *Queries) CreateSCGroupDeposit(ctx context.Context, arg CreateSCGroupDepositParams) error {
+	_, err := q.db.ExecContext(ctx, createSCGroupDeposit,
+		arg.GroupID,
+		arg.Effdt,
+		arg.Enddt,
+		arg.DepositID,
+	)
 	return err
 }
 
@@ -80,26 +264,27 @@ func (q *Queries) CreateSCGroupNo(ctx context.Context, arg CreateSCGroupNoParams
 
 const createSCGroupProductionSummary = `-- name: CreateSCGroupProductionSummary :exec
 insert into sc_group_production_summary (group_id, production_dt,
-                                         fuel_consumed, gold_consumed, mets_consumed, nmts_consumed,
+                                         fuel_consumed, power_consumed, gold_consumed, mets_consumed, nmts_consumed,
                                          pro_consumed, usk_consumed, aut_consumed,
                                          qty_produced)
 values (?1, ?2,
-        ?3, ?4, ?5, ?6,
-        ?7, ?8, ?9,
-        ?10)
+        ?3, ?4, ?5, ?6, ?7,
+        ?8, ?9, ?10,
+        ?11)
 `
 
 type CreateSCGroupProductionSummaryParams struct {
-	GroupID      int64
-	ProductionDt int64
-	FuelConsumed int64
-	GoldConsumed int64
-	MetsConsumed int64
-	NmtsConsumed int64
-	ProConsumed  int64
-	UskConsumed  int64
-	AutConsumed  int64
-	QtyProduced  int64
+	GroupID       int64
+	ProductionDt  int64
+	FuelConsumed  int64
+	PowerConsumed int64
+	GoldConsumed  int64
+	MetsConsumed  int64
+	NmtsConsumed  int64
+	ProConsumed   int64
+	UskConsumed   int64
+	AutConsumed   int64
+	QtyProduced   int64
 }
 
 // CreateSCGroupProductionSummary stores the totals from the entire group for a single turn.
@@ -108,6 +293,7 @@ func (q *Queries) CreateSCGroupProductionSummary(ctx context.Context, arg Create
 		arg.GroupID,
 		arg.ProductionDt,
 		arg.FuelConsumed,
+		arg.PowerConsumed,
 		arg.GoldConsumed,
 		arg.MetsConsumed,
 		arg.NmtsConsumed,
@@ -204,27 +390,28 @@ func (q *Queries) CreateSCGroupUnit(ctx context.Context, arg CreateSCGroupUnitPa
 
 const createSCGroupUnitProduction = `-- name: CreateSCGroupUnitProduction :exec
 insert into sc_group_unit_production (group_id, tech_level, production_dt,
-                                      fuel_consumed, gold_consumed, mets_consumed, nmts_consumed,
+                                      fuel_consumed, power_consumed, gold_consumed, mets_consumed, nmts_consumed,
                                       pro_consumed, usk_consumed, aut_consumed,
                                       qty_produced)
 values (?1, ?2, ?3,
-        ?4, ?5, ?6, ?7,
-        ?8, ?9, ?10,
-        ?11)
+        ?4, ?5, ?6, ?7, ?8,
+        ?9, ?10, ?11,
+        ?12)
 `
 
 type CreateSCGroupUnitProductionParams struct {
-	GroupID      int64
-	TechLevel    int64
-	ProductionDt int64
-	FuelConsumed int64
-	GoldConsumed int64
-	MetsConsumed int64
-	NmtsConsumed int64
-	ProConsumed  int64
-	UskConsumed  int64
-	AutConsumed  int64
-	QtyProduced  int64
+	GroupID       int64
+	TechLevel     int64
+	ProductionDt  int64
+	FuelConsumed  int64
+	PowerConsumed int64
+	GoldConsumed  int64
+	MetsConsumed  int64
+	NmtsConsumed  int64
+	ProConsumed   int64
+	UskConsumed   int64
+	AutConsumed   int64
+	QtyProduced   int64
 }
 
 // CreateSCGroupUnitProduction creates a record of the resources consumed
@@ -235,6 +422,7 @@ func (q *Queries) CreateSCGroupUnitProduction(ctx context.Context, arg CreateSCG
 		arg.TechLevel,
 		arg.ProductionDt,
 		arg.FuelConsumed,
+		arg.PowerConsumed,
 		arg.GoldConsumed,
 		arg.MetsConsumed,
 		arg.NmtsConsumed,
@@ -311,6 +499,67 @@ func (q *Queries) CreateSCInventory(ctx context.Context, arg CreateSCInventoryPa
 	return err
 }
 
+const createSCJumpOrder = `-- name: CreateSCJumpOrder :one
+insert into sc_jump_order (sc_id, effdt, orbit_id)
+values (?1, ?2, ?3)
+returning id
+`
+
+type CreateSCJumpOrderParams struct {
+	ScID    int64
+	Effdt   int64
+	OrbitID int64
+}
+
+// CreateSCJumpOrder creates a new ship jump order.
+func (q *Queries) CreateSCJumpOrder(ctx context.Context, arg CreateSCJumpOrderParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, createSCJumpOrder, arg.ScID, arg.Effdt, arg.OrbitID)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
+const createSCJumpResult = `-- name: CreateSCJumpResult :exec
+insert into sc_jump_result (jump_id, effdt,
+                            from_orbit_id, to_orbit_id,
+                            distance, mass, engines_used, fuel_used,
+                            status, reason)
+values (?1, ?2,
+        ?3, ?4,
+        ?5, ?6, ?7, ?8,
+        ?9, ?10)
+`
+
+type CreateSCJumpResultParams struct {
+	JumpID      int64
+	Effdt       int64
+	FromOrbitID int64
+	ToOrbitID   int64
+	Distance    float64
+	Mass        float64
+	EnginesUsed int64
+	FuelUsed    int64
+	Status      string
+	Reason      string
+}
+
+// CreateSCJumpResult adds a new result.
+func (q *Queries) CreateSCJumpResult(ctx context.Context, arg CreateSCJumpResultParams) error {
+	_, err := q.db.ExecContext(ctx, createSCJumpResult,
+		arg.JumpID,
+		arg.Effdt,
+		arg.FromOrbitID,
+		arg.ToOrbitID,
+		arg.Distance,
+		arg.Mass,
+		arg.EnginesUsed,
+		arg.FuelUsed,
+		arg.Status,
+		arg.Reason,
+	)
+	return err
+}
+
 const createSCLocation = `-- name: CreateSCLocation :exec
 insert into sc_location (sc_id, effdt, enddt, orbit_id, is_on_surface)
 values (?1, ?2, ?3, ?4, ?5)
@@ -365,6 +614,77 @@ func (q *Queries) CreateSCMiningSummary(ctx context.Context, arg CreateSCMiningS
 	return err
 }
 
+const createSCMoveOrder = `-- name: CreateSCMoveOrder :one
+insert into sc_move_order (sc_id, effdt, orbit_id, is_on_surface)
+values (?1, ?2, ?3, ?4)
+returning id
+`
+
+type CreateSCMoveOrderParams struct {
+	ScID        int64
+	Effdt       int64
+	OrbitID     int64
+	IsOnSurface int64
+}
+
+// CreateSCMoveOrder creates a new ship move order.
+func (q *Queries) CreateSCMoveOrder(ctx context.Context, arg CreateSCMoveOrderParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, createSCMoveOrder,
+		arg.ScID,
+		arg.Effdt,
+		arg.OrbitID,
+		arg.IsOnSurface,
+	)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
+const createSCMoveResult = `-- name: CreateSCMoveResult :exec
+insert into sc_move_result (move_id, effdt,
+                            from_orbit_id, from_is_on_surface,
+                            to_orbit_id, to_is_on_surface,
+                            mass, thrust, fuel_used,
+                            status, reason)
+values (?1, ?2,
+        ?3, ?4,
+        ?5, ?6,
+        ?7, ?8, ?9,
+        ?10, ?11)
+`
+
+type CreateSCMoveResultParams struct {
+	MoveID          int64
+	Effdt           int64
+	FromOrbitID     int64
+	FromIsOnSurface int64
+	ToOrbitID       int64
+	ToIsOnSurface   int64
+	Mass            float64
+	Thrust          float64
+	FuelUsed        int64
+	Status          string
+	Reason          string
+}
+
+// CreateSCMoveResult adds a new result.
+func (q *Queries) CreateSCMoveResult(ctx context.Context, arg CreateSCMoveResultParams) error {
+	_, err := q.db.ExecContext(ctx, createSCMoveResult,
+		arg.MoveID,
+		arg.Effdt,
+		arg.FromOrbitID,
+		arg.FromIsOnSurface,
+		arg.ToOrbitID,
+		arg.ToIsOnSurface,
+		arg.Mass,
+		arg.Thrust,
+		arg.FuelUsed,
+		arg.Status,
+		arg.Reason,
+	)
+	return err
+}
+
 const createSCName = `-- name: CreateSCName :exec
 insert into sc_name (sc_id, name, effdt, enddt)
 values (?1, ?2, ?3, ?4)
@@ -388,6 +708,29 @@ func (q *Queries) CreateSCName(ctx context.Context, arg CreateSCNameParams) erro
 	return err
 }
 
+const createSCOwner = `-- name: CreateSCOwner :exec
+insert into sc_owner (sc_id, effdt, enddt, empire_id)
+values (?1, ?2, ?3, ?4)
+`
+
+type CreateSCOwnerParams struct {
+	ScID     int64
+	Effdt    int64
+	Enddt    int64
+	EmpireID int64
+}
+
+// CreateSCOwner creates a new owner entry for a ship or colony.
+func (q *Queries) CreateSCOwner(ctx context.Context, arg CreateSCOwnerParams) error {
+	_, err := q.db.ExecContext(ctx, createSCOwner,
+		arg.ScID,
+		arg.Effdt,
+		arg.Enddt,
+		arg.EmpireID,
+	)
+	return err
+}
+
 const createSCPopulation = `-- name: CreateSCPopulation :exec
 insert into sc_population (sc_id, population_cd, effdt, enddt, qty, pay_rate, rebel_qty)
 values (?1, ?2, ?3, ?4, ?5, ?6, ?7)
@@ -443,6 +786,41 @@ func (q *Queries) CreateSCProbeOrder(ctx context.Context, arg CreateSCProbeOrder
 	return id, err
 }
 
+const createSCProbeResult = `-- name: CreateSCProbeResult :exec
+insert into sc_probe_result (probe_id, effdt,
+                             unit_cd, unit_tech_level, units_used, fuel_used,
+                             status, reason)
+values (?1, ?2,
+        ?3, ?4, ?5, ?6,
+        ?7, ?8)
+`
+
+type CreateSCProbeResultParams struct {
+	ProbeID       int64
+	Effdt         int64
+	UnitCd        string
+	UnitTechLevel int64
+	UnitsUsed     int64
+	FuelUsed      int64
+	Status        string
+	Reason        string
+}
+
+// CreateSCProbeResult adds a new result.
+func (q *Queries) CreateSCProbeResult(ctx context.Context, arg CreateSCProbeResultParams) error {
+	_, err := q.db.ExecContext(ctx, createSCProbeResult,
+		arg.ProbeID,
+		arg.Effdt,
+		arg.UnitCd,
+		arg.UnitTechLevel,
+		arg.UnitsUsed,
+		arg.FuelUsed,
+		arg.Status,
+		arg.Reason,
+	)
+	return err
+}
+
 const createSCProbeStarOrbitResults = `-- name: CreateSCProbeStarOrbitResults :exec
 insert into sc_probe_star_orbit_result(probe_id, effdt,
                                        star_id,
@@ -536,6 +914,33 @@ func (q *Queries) CreateSCRates(ctx context.Context, arg CreateSCRatesParams) er
 	return err
 }
 
+const createSCSurveyDepositResult = `-- name: CreateSCSurveyDepositResult :exec
+insert into sc_survey_deposit_result (survey_id, effdt, deposit_no, deposit_kind, deposit_qty, yield_pct)
+values (?1, ?2, ?3, ?4, ?5, ?6)
+`
+
+type CreateSCSurveyDepositResultParams struct {
+	SurveyID    int64
+	Effdt       int64
+	DepositNo   int64
+	DepositKind string
+	DepositQty  int64
+	YieldPct    int64
+}
+
+// CreateSCSurveyDepositResult adds a new deposit to the result of a survey.
+func (q *Queries) CreateSCSurveyDepositResult(ctx context.Context, arg CreateSCSurveyDepositResultParams) error {
+	_, err := q.db.ExecContext(ctx, createSCSurveyDepositResult,
+		arg.SurveyID,
+		arg.Effdt,
+		arg.DepositNo,
+		arg.DepositKind,
+		arg.DepositQty,
+		arg.YieldPct,
+	)
+	return err
+}
+
 const createSCSurveyOrbitResult = `-- name: CreateSCSurveyOrbitResult :exec
 insert into sc_survey_orbit_result (survey_id, effdt, orbit_id, location, orbit_no, habitability_no, farmland_in_use,
                                     population)
@@ -594,107 +999,1258 @@ func (q *Queries) CreateSCSurveyOrder(ctx context.Context, arg CreateSCSurveyOrd
 	return id, err
 }
 
-const deleteSCProbeStarOrbitResult = `-- name: DeleteSCProbeStarOrbitResult :exec
+const createSCSurveyResult = `-- name: CreateSCSurveyResult :exec
+insert into sc_survey_result (survey_id, effdt, status, reason)
+values (?1, ?2, ?3, ?4)
+`
+
+type CreateSCSurveyResultParams struct {
+	SurveyID int64
+	Effdt    int64
+	Status   string
+	Reason   string
+}
+
+// CreateSCSurveyResult adds a new result.
+func (q *Queries) CreateSCSurveyResult(ctx context.Context, arg CreateSCSurveyResultParams) error {
+	_, err := q.db.ExecContext(ctx, createSCSurveyResult,
+		arg.SurveyID,
+		arg.Effdt,
+		arg.Status,
+		arg.Reason,
+	)
+	return err
+}
+
+const deleteNewSCGroupToolingByTurn = `-- name: DeleteNewSCGroupToolingByTurn :exec
 delete
-from sc_probe_star_orbit_result
-where probe_id = ?1
+from sc_group_tooling
+where group_id in (select id
+                   from sc_group
+                   where effdt = ?1)
 `
 
-// DeleteSCProbeStarOrbitResults deletes the results of a probe.
-func (q *Queries) DeleteSCProbeStarOrbitResult(ctx context.Context, probeID int64) error {
-	_, err := q.db.ExecContext(ctx, deleteSCProbeStarOrbitResult, probeID)
+// DeleteNewSCGroupToolingByTurn deletes the tooling of the factory groups
+// created on a given turn.
+func (q *Queries) DeleteNewSCGroupToolingByTurn(ctx context.Context, effdt int64) error {
+	_, err := q.db.ExecContext(ctx, deleteNewSCGroupToolingByTurn, effdt)
 	return err
 }
 
-const deleteSCProbeStarOrbitResultsByTurn = `-- name: DeleteSCProbeStarOrbitResultsByTurn :exec
+const deleteSCCombatLossesByTurn = `-- name: DeleteSCCombatLossesByTurn :exec
 delete
-from sc_probe_star_orbit_result
+from sc_combat_loss
 where effdt = ?1
 `
 
-// DeleteSCProbeStarOrbitResultsByTurn deletes the results of all probes for a given turn.
-func (q *Queries) DeleteSCProbeStarOrbitResultsByTurn(ctx context.Context, effdt int64) error {
-	_, err := q.db.ExecContext(ctx, deleteSCProbeStarOrbitResultsByTurn, effdt)
+// DeleteSCCombatLossesByTurn deletes the combat losses for a given turn.
+func (q *Queries) DeleteSCCombatLossesByTurn(ctx context.Context, effdt int64) error {
+	_, err := q.db.ExecContext(ctx, deleteSCCombatLossesByTurn, effdt)
 	return err
 }
 
-const deleteSCProbeStarResult = `-- name: DeleteSCProbeStarResult :exec
+const deleteSCCombatResultsByTurn = `-- name: DeleteSCCombatResultsByTurn :exec
 delete
-from sc_probe_star_result
-where probe_id = ?1
+from sc_combat_result
+where effdt = ?1
 `
 
-// DeleteSCProbeStarResult deletes the results of a probe.
-func (q *Queries) DeleteSCProbeStarResult(ctx context.Context, probeID int64) error {
-	_, err := q.db.ExecContext(ctx, deleteSCProbeStarResult, probeID)
+// DeleteSCCombatResultsByTurn deletes the results of all combat orders for a given turn.
+func (q *Queries) DeleteSCCombatResultsByTurn(ctx context.Context, effdt int64) error {
+	_, err := q.db.ExecContext(ctx, deleteSCCombatResultsByTurn, effdt)
 	return err
 }
 
-const deleteSCProbeStarResultsByTurn = `-- name: DeleteSCProbeStarResultsByTurn :exec
+const deleteSCEspionageResultsByTurn = `-- name: DeleteSCEspionageResultsByTurn :exec
 delete
-from sc_probe_star_result
+from sc_espionage_result
 where effdt = ?1
 `
 
-// DeleteSCProbeStarResultsByTurn deletes the results of all probes for a given turn.
-func (q *Queries) DeleteSCProbeStarResultsByTurn(ctx context.Context, effdt int64) error {
-	_, err := q.db.ExecContext(ctx, deleteSCProbeStarResultsByTurn, effdt)
+// DeleteSCEspionageResultsByTurn deletes the results of all espionage missions for a given turn.
+func (q *Queries) DeleteSCEspionageResultsByTurn(ctx context.Context, effdt int64) error {
+	_, err := q.db.ExecContext(ctx, deleteSCEspionageResultsByTurn, effdt)
 	return err
 }
 
-const deleteSCSurveyOrbitResult = `-- name: DeleteSCSurveyOrbitResult :exec
+const deleteSCGroupDepositsByTurn = `-- name: DeleteSCGroupDepositsByTurn :exec
 delete
-from sc_survey_orbit_result
-where survey_id = ?1
+from sc_group_deposit
+where group_id in (select id
+                   from sc_group
+                   where effdt = ?1)
 `
 
-// DeleteSCSurveyOrbitResult deletes the results of a survey.
-func (q *Queries) DeleteSCSurveyOrbitResult(ctx context.Context, surveyID int64) error {
-	_, err := q.db.ExecContext(ctx, deleteSCSurveyOrbitResult, surveyID)
+// DeleteSCGroupDepositsByTurn deletes the deposits of the mine groups
+// created on a given turn.
+func (q *Queries) DeleteSCGroupDepositsByTurn(ctx context.Context, effdt int64) error {
+	_, err := q.db.ExecContext(ctx, deleteSCGroupDepositsByTurn, effdt)
 	return err
 }
 
-const deleteSCSurveyOrbitResultsByTurn = `-- name: DeleteSCSurveyOrbitResultsByTurn :exec
+const deleteSCGroupNosByTurn = `-- name: DeleteSCGroupNosByTurn :exec
 delete
-from sc_survey_orbit_result
+from sc_group_no
+where group_id in (select id
+                   from sc_group
+                   where effdt = ?1)
+`
+
+// DeleteSCGroupNosByTurn deletes the numbers of the groups created on a
+// given turn.
+func (q *Queries) DeleteSCGroupNosByTurn(ctx context.Context, effdt int64) error {
+	_, err := q.db.ExecContext(ctx, deleteSCGroupNosByTurn, effdt)
+	return err
+}
+
+const deleteSCGroupProductionByTurn = `-- name: DeleteSCGroupProductionByTurn :exec
+delete
+from sc_group_production_summary
+where group_id in (select id
+                   from sc_group
+                   where effdt = ?1)
+`
+
+// DeleteSCGroupProductionByTurn deletes the production summaries of the
+// groups created on a given turn.
+func (q *Queries) DeleteSCGroupProductionByTurn(ctx context.Context, effdt int64) error {
+	_, err := q.db.ExecContext(ctx, deleteSCGroupProductionByTurn, effdt)
+	return err
+}
+
+const deleteSCGroupUnitsByTurn = `-- name: DeleteSCGroupUnitsByTurn :exec
+delete
+from sc_group_unit
 where effdt = ?1
 `
 
-// DeleteSCSurveyOrbitResultsByTurn deletes the results of all surveys for a given turn.
-func (q *Queries) DeleteSCSurveyOrbitResultsByTurn(ctx context.Context, effdt int64) error {
-	_, err := q.db.ExecContext(ctx, deleteSCSurveyOrbitResultsByTurn, effdt)
+// DeleteSCGroupUnitsByTurn deletes the group unit entries created on a
+// given turn.
+func (q *Queries) DeleteSCGroupUnitsByTurn(ctx context.Context, effdt int64) error {
+	_, err := q.db.ExecContext(ctx, deleteSCGroupUnitsByTurn, effdt)
 	return err
 }
 
-const readAllColoniesByEmpire = `-- name: ReadAllColoniesByEmpire :many
+const deleteSCGroupsByTurn = `-- name: DeleteSCGroupsByTurn :exec
+delete
+from sc_group
+where effdt = ?1
+`
+
+// DeleteSCGroupsByTurn deletes the groups created on a given turn. Delete
+// the deposits, numbers, production, tooling, and units first.
+func (q *Queries) DeleteSCGroupsByTurn(ctx context.Context, effdt int64) error {
+	_, err := q.db.ExecContext(ctx, deleteSCGroupsByTurn, effdt)
+	return err
+}
+
+const deleteSCInventoryByTurn = `-- name: DeleteSCInventoryByTurn :exec
+delete
+from sc_inventory
+where effdt = ?1
+`
+
+// DeleteSCInventoryByTurn deletes the inventory entries created on a given turn.
+func (q *Queries) DeleteSCInventoryByTurn(ctx context.Context, effdt int64) error {
+	_, err := q.db.ExecContext(ctx, deleteSCInventoryByTurn, effdt)
+	return err
+}
+
+const deleteSCJumpResultsByTurn = `-- name: DeleteSCJumpResultsByTurn :exec
+delete
+from sc_jump_result
+where effdt = ?1
+`
+
+// DeleteSCJumpResultsByTurn deletes the results of all jumps for a given turn.
+func (q *Queries) DeleteSCJumpResultsByTurn(ctx context.Context, effdt int64) error {
+	_, err := q.db.ExecContext(ctx, deleteSCJumpResultsByTurn, effdt)
+	return err
+}
+
+const deleteSCLocationsByTurn = `-- name: DeleteSCLocationsByTurn :exec
+delete
+from sc_location
+where effdt = ?1
+`
+
+// DeleteSCLocationsByTurn deletes the location entries created on a given turn.
+func (q *Queries) DeleteSCLocationsByTurn(ctx context.Context, effdt int64) error {
+	_, err := q.db.ExecContext(ctx, deleteSCLocationsByTurn, effdt)
+	return err
+}
+
+const deleteSCMoveResultsByTurn = `-- name: DeleteSCMoveResultsByTurn :exec
+delete
+from sc_move_result
+where effdt = ?1
+`
+
+// DeleteSCMoveResultsByTurn deletes the results of all moves for a given turn.
+func (q *Queries) DeleteSCMoveResultsByTurn(ctx context.Context, effdt int64) error {
+	_, err := q.db.ExecContext(ctx, deleteSCMoveResultsByTurn, effdt)
+	return err
+}
+
+const deleteSCOwnersByTurn = `-- name: DeleteSCOwnersByTurn :exec
+delete
+from sc_owner
+where effdt = ?1
+`
+
+// DeleteSCOwnersByTurn deletes the owner entries created on a given turn.
+func (q *Queries) DeleteSCOwnersByTurn(ctx context.Context, effdt int64) error {
+	_, err := q.db.ExecContext(ctx, deleteSCOwnersByTurn, effdt)
+	return err
+}
+
+const deleteSCPopulationByTurn = `-- name: DeleteSCPopulationByTurn :exec
+delete
+from sc_population
+where effdt = ?1
+`
+
+// DeleteSCPopulationByTurn deletes the population entries created on a given turn.
+func (q *Queries) DeleteSCPopulationByTurn(ctx context.Context, effdt int64) error {
+	_, err := q.db.ExecContext(ctx, deleteSCPopulationByTurn, effdt)
+	return err
+}
+
+const deleteSCProbeResultsByTurn = `-- name: DeleteSCProbeResultsByTurn :exec
+delete
+from sc_probe_result
+where effdt = ?1
+`
+
+// DeleteSCProbeResultsByTurn deletes the results of all probes for a given turn.
+func (q *Queries) DeleteSCProbeResultsByTurn(ctx context.Context, effdt int64) error {
+	_, err := q.db.ExecContext(ctx, deleteSCProbeResultsByTurn, effdt)
+	return err
+}
+
+const deleteSCProbeStarOrbitResult = `-- name: DeleteSCProbeStarOrbitResult :exec
+delete
+from sc_probe_star_orbit_result
+where probe_id = ?1
+`
+
+// DeleteSCProbeStarOrbitResults deletes the results of a probe.
+func (q *Queries) DeleteSCProbeStarOrbitResult(ctx context.Context, probeID int64) error {
+	_, err := q.db.ExecContext(ctx, deleteSCProbeStarOrbitResult, probeID)
+	return err
+}
+
+const deleteSCProbeStarOrbitResultsByTurn = `-- name: DeleteSCProbeStarOrbitResultsByTurn :exec
+delete
+from sc_probe_star_orbit_result
+where effdt = ?1
+`
+
+// DeleteSCProbeStarOrbitResultsByTurn deletes the results of all probes for a given turn.
+func (q *Queries) DeleteSCProbeStarOrbitResultsByTurn(ctx context.Context, effdt int64) error {
+	_, err := q.db.ExecContext(ctx, deleteSCProbeStarOrbitResultsByTurn, effdt)
+	return err
+}
+
+const deleteSCProbeStarResult = `-- name: DeleteSCProbeStarResult :exec
+delete
+from sc_probe_star_result
+where probe_id = ?1
+`
+
+// DeleteSCProbeStarResult deletes the results of a probe.
+func (q *Queries) DeleteSCProbeStarResult(ctx context.Context, probeID int64) error {
+	_, err := q.db.ExecContext(ctx, deleteSCProbeStarResult, probeID)
+	return err
+}
+
+const deleteSCProbeStarResultsByTurn = `-- name: DeleteSCProbeStarResultsByTurn :exec
+delete
+from sc_probe_star_result
+where effdt = ?1
+`
+
+// DeleteSCProbeStarResultsByTurn deletes the results of all probes for a given turn.
+func (q *Queries) DeleteSCProbeStarResultsByTurn(ctx context.Context, effdt int64) error {
+	_, err := q.db.ExecContext(ctx, deleteSCProbeStarResultsByTurn, effdt)
+	return err
+}
+
+const deleteSCSurveyDepositResultsByTurn = `-- name: DeleteSCSurveyDepositResultsByTurn :exec
+delete
+from sc_survey_deposit_result
+where effdt = ?1
+`
+
+// DeleteSCSurveyDepositResultsByTurn deletes the deposits found by all surveys for a given turn.
+func (q *Queries) DeleteSCSurveyDepositResultsByTurn(ctx context.Context, effdt int64) error {
+	_, err := q.db.ExecContext(ctx, deleteSCSurveyDepositResultsByTurn, effdt)
+	return err
+}
+
+const deleteSCSurveyOrbitResult = `-- name: DeleteSCSurveyOrbitResult :exec
+delete
+from sc_survey_orbit_result
+where survey_id = ?1
+`
+
+// DeleteSCSurveyOrbitResult deletes the results of a survey.
+func (q *Queries) DeleteSCSurveyOrbitResult(ctx context.Context, surveyID int64) error {
+	_, err := q.db.ExecContext(ctx, deleteSCSurveyOrbitResult, surveyID)
+	return err
+}
+
+const deleteSCSurveyOrbitResultsByTurn = `-- name: DeleteSCSurveyOrbitResultsByTurn :exec
+delete
+from sc_survey_orbit_result
+where effdt = ?1
+`
+
+// DeleteSCSurveyOrbitResultsByTurn deletes the results of all surveys for a given turn.
+func (q *Queries) DeleteSCSurveyOrbitResultsByTurn(ctx context.Context, effdt int64) error {
+	_, err := q.db.ExecContext(ctx, deleteSCSurveyOrbitResultsByTurn, effdt)
+	return err
+}
+
+const deleteSCSurveyResultsByTurn = `-- name: DeleteSCSurveyResultsByTurn :exec
+delete
+from sc_survey_result
+where effdt = ?1
+`
+
+// DeleteSCSurveyResultsByTurn deletes the results of all surveys for a given turn.
+func (q *Queries) DeleteSCSurveyResultsByTurn(ctx context.Context, effdt int64) error {
+	_, err := q.db.ExecContext(ctx, deleteSCSurveyResultsByTurn, effdt)
+	return err
+}
+
+const readAllColoniesByEmpire = `-- name: ReadAllColoniesByEmpire :many
 select scs.id        as sc_id,
        systems.id    as system_id,
        systems.system_name,
-       stars.id      as star_id,
+       stars.id      as star_id,
+       stars.star_name,
+       orbits.orbit_no,
+       sc_codes.name as sc_kind,
+       scs.sc_tech_level,
+       sc_name.name,
+       sc_rates.rations,
+       sc_rates.birth_rate,
+       sc_rates.death_rate,
+       sc_rates.sol
+from sc_owner,
+     scs,
+     sc_codes,
+     sc_name,
+     sc_rates,
+     sc_location,
+     orbits,
+     stars,
+     systems
+where sc_owner.empire_id = ?1
+  and (sc_owner.effdt <= ?2 and ?2 < sc_owner.enddt)
+  and scs.id = sc_owner.sc_id
+  and scs.sc_cd in ('COPN', 'CENC', 'CORB')
+  and sc_codes.code = scs.sc_cd
+  and sc_name.sc_id = scs.id
+  and (sc_name.effdt <= ?2 and ?2 < sc_name.enddt)
+  and sc_rates.sc_id = scs.id
+  and (sc_rates.effdt <= ?2 and ?2 < sc_rates.enddt)
+  and sc_location.sc_id = scs.id
+  and (sc_location.effdt <= ?2 and ?2 < sc_location.enddt)
+  and orbits.id = sc_location.orbit_id
+  and stars.id = orbits.star_id
+  and systems.id = orbits.system_id
+order by scs.id
+`
+
+type ReadAllColoniesByEmpireParams struct {
+	EmpireID int64
+	AsOfDt   int64
+}
+
+type ReadAllColoniesByEmpireRow struct {
+	ScID        int64
+	SystemID    int64
+	SystemName  string
+	StarID      int64
+	StarName    string
+	OrbitNo     int64
+	ScKind      string
+	ScTechLevel int64
+	Name        string
+	Rations     float64
+	BirthRate   float64
+	DeathRate   float64
+	Sol         float64
+}
+
+// ReadAllColoniesByEmpire returns a list of all colonies for an empire
+// that were active on a given turn.
+func (q *Queries) ReadAllColoniesByEmpire(ctx context.Context, arg ReadAllColoniesByEmpireParams) ([]ReadAllColoniesByEmpireRow, error) {
+	rows, err := q.db.QueryContext(ctx, readAllColoniesByEmpire, arg.EmpireID, arg.AsOfDt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ReadAllColoniesByEmpireRow
+	for rows.Next() {
+		var i ReadAllColoniesByEmpireRow
+		if err := rows.Scan(
+			&i.ScID,
+			&i.SystemID,
+			&i.SystemName,
+			&i.StarID,
+			&i.StarName,
+			&i.OrbitNo,
+			&i.ScKind,
+			&i.ScTechLevel,
+			&i.Name,
+			&i.Rations,
+			&i.BirthRate,
+			&i.DeathRate,
+			&i.Sol,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const readAllCombatLossesByEmpire = `-- name: ReadAllCombatLossesByEmpire :many
+select sc_combat_loss.sc_id,
+       sc_combat_loss.code,
+       sc_combat_loss.tech_level,
+       sc_combat_loss.qty
+from sc_combat_loss
+where sc_combat_loss.empire_id = ?1
+  and sc_combat_loss.effdt = ?2
+order by sc_combat_loss.sc_id, sc_combat_loss.code, sc_combat_loss.tech_level
+`
+
+type ReadAllCombatLossesByEmpireParams struct {
+	EmpireID int64
+	AsOfDt   int64
+}
+
+type ReadAllCombatLossesByEmpireRow struct {
+	ScID      int64
+	Code      string
+	TechLevel int64
+	Qty       int64
+}
+
+// ReadAllCombatLossesByEmpire returns a list of the units and population lost
+// in combat by all the ships and colonies in an empire for a given turn.
+// A colony that was captured is reported to the empire that lost it.
+func (q *Queries) ReadAllCombatLossesByEmpire(ctx context.Context, arg ReadAllCombatLossesByEmpireParams) ([]ReadAllCombatLossesByEmpireRow, error) {
+	rows, err := q.db.QueryContext(ctx, readAllCombatLossesByEmpire, arg.EmpireID, arg.AsOfDt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ReadAllCombatLossesByEmpireRow
+	for rows.Next() {
+		var i ReadAllCombatLossesByEmpireRow
+		if err := rows.Scan(
+			&i.ScID,
+			&i.Code,
+			&i.TechLevel,
+			&i.Qty,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const readAllCombatOrdersByTurn = `-- name: ReadAllCombatOrdersByTurn :many
+select sc_combat_order.id as combat_id,
+       sc_combat_order.sc_id,
+       sc_owner.empire_id,
+       sc_combat_order.kind,
+       sc_combat_order.target_id,
+       sc_combat_order.support_id,
+       sc_combat_order.pct_committed,
+       sc_combat_order.raid_unit_cd
+from sc_combat_order,
+     sc_owner
+where sc_combat_order.effdt = ?1
+  and sc_owner.sc_id = sc_combat_order.sc_id
+  and (sc_owner.effdt <= ?1 and ?1 < sc_owner.enddt)
+order by sc_combat_order.id
+`
+
+type ReadAllCombatOrdersByTurnRow struct {
+	CombatID     int64
+	ScID         int64
+	EmpireID     int64
+	Kind         string
+	TargetID     int64
+	SupportID    int64
+	PctCommitted int64
+	RaidUnitCd   string
+}
+
+// ReadAllCombatOrdersByTurn returns a list of attack and support orders issued in a given turn of a game.
+func (q *Queries) ReadAllCombatOrdersByTurn(ctx context.Context, asOfDt int64) ([]ReadAllCombatOrdersByTurnRow, error) {
+	rows, err := q.db.QueryContext(ctx, readAllCombatOrdersByTurn, asOfDt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ReadAllCombatOrdersByTurnRow
+	for rows.Next() {
+		var i ReadAllCombatOrdersByTurnRow
+		if err := rows.Scan(
+			&i.CombatID,
+			&i.ScID,
+			&i.EmpireID,
+			&i.Kind,
+			&i.TargetID,
+			&i.SupportID,
+			&i.PctCommitted,
+			&i.RaidUnitCd,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const readAllCombatResultsByEmpire = `-- name: ReadAllCombatResultsByEmpire :many
+select sc_combat_order.id                as combat_id,
+       sc_combat_order.sc_id,
+       sc_combat_result.empire_id        as sc_empire_id,
+       sc_combat_order.kind,
+       sc_combat_order.target_id,
+       sc_combat_result.target_empire_id,
+       sc_combat_order.pct_committed,
+       sc_combat_result.rounds,
+       sc_combat_result.mass_pct,
+       sc_combat_result.status,
+       sc_combat_result.reason
+from sc_combat_order,
+     sc_combat_result
+where sc_combat_result.combat_id = sc_combat_order.id
+  and sc_combat_result.effdt = ?1
+  and (sc_combat_result.empire_id = ?2 or sc_combat_result.target_empire_id = ?2)
+order by sc_combat_order.target_id, sc_combat_order.id
+`
+
+type ReadAllCombatResultsByEmpireParams struct {
+	AsOfDt   int64
+	EmpireID int64
+}
+
+type ReadAllCombatResultsByEmpireRow struct {
+	CombatID       int64
+	ScID           int64
+	ScEmpireID     int64
+	Kind           string
+	TargetID       int64
+	TargetEmpireID int64
+	PctCommitted   int64
+	Rounds         int64
+	MassPct        float64
+	Status         string
+	Reason         string
+}
+
+// ReadAllCombatResultsByEmpire returns a list of the results of the battles
+// that the ships and colonies in an empire fought in on a given turn. The
+// defender sees the results of the orders that targeted it. Owners are
+// as of the battle, so the empire that lost a colony sees the invasion.
+func (q *Queries) ReadAllCombatResultsByEmpire(ctx context.Context, arg ReadAllCombatResultsByEmpireParams) ([]ReadAllCombatResultsByEmpireRow, error) {
+	rows, err := q.db.QueryContext(ctx, readAllCombatResultsByEmpire, arg.AsOfDt, arg.EmpireID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ReadAllCombatResultsByEmpireRow
+	for rows.Next() {
+		var i ReadAllCombatResultsByEmpireRow
+		if err := rows.Scan(
+			&i.CombatID,
+			&i.ScID,
+			&i.ScEmpireID,
+			&i.Kind,
+			&i.TargetID,
+			&i.TargetEmpireID,
+			&i.PctCommitted,
+			&i.Rounds,
+			&i.MassPct,
+			&i.Status,
+			&i.Reason,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const readAllEspionageOrdersByTurn = `-- name: ReadAllEspionageOrdersByTurn :many
+select sc_espionage_order.id as espionage_id,
+       sc_espionage_order.sc_id,
+       sc_owner.empire_id,
+       sc_espionage_order.kind,
+       sc_espionage_order.target_id,
+       sc_espionage_order.qty
+from sc_espionage_order,
+     sc_owner
+where sc_espionage_order.effdt = ?1
+  and sc_owner.sc_id = sc_espionage_order.sc_id
+  and (sc_owner.effdt <= ?1 and ?1 < sc_owner.enddt)
+order by sc_espionage_order.id
+`
+
+type ReadAllEspionageOrdersByTurnRow struct {
+	EspionageID int64
+	ScID        int64
+	EmpireID    int64
+	Kind        string
+	TargetID    int64
+	Qty         int64
+}
+
+// ReadAllEspionageOrdersByTurn returns a list of espionage missions ordered in a given turn of a game.
+func (q *Queries) ReadAllEspionageOrdersByTurn(ctx context.Context, asOfDt int64) ([]ReadAllEspionageOrdersByTurnRow, error) {
+	rows, err := q.db.QueryContext(ctx, readAllEspionageOrdersByTurn, asOfDt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ReadAllEspionageOrdersByTurnRow
+	for rows.Next() {
+		var i ReadAllEspionageOrdersByTurnRow
+		if err := rows.Scan(
+			&i.EspionageID,
+			&i.ScID,
+			&i.EmpireID,
+			&i.Kind,
+			&i.TargetID,
+			&i.Qty,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const readAllEspionageResultsByEmpire = `-- name: ReadAllEspionageResultsByEmpire :many
+select sc_espionage_order.id                as espionage_id,
+       sc_espionage_order.sc_id,
+       sc_espionage_result.empire_id        as sc_empire_id,
+       sc_espionage_order.kind,
+       sc_espionage_order.target_id,
+       sc_espionage_result.target_empire_id,
+       sc_espionage_order.qty,
+       sc_espionage_result.agents_lost,
+       sc_espionage_result.agents_through,
+       sc_espionage_result.counter_lost,
+       sc_espionage_result.effect_qty,
+       sc_espionage_result.status,
+       sc_espionage_result.reason
+from sc_espionage_order,
+     sc_espionage_result
+where sc_espionage_result.espionage_id = sc_espionage_order.id
+  and sc_espionage_result.effdt = ?1
+  and (sc_espionage_result.empire_id = ?2 or sc_espionage_result.target_empire_id = ?2)
+order by sc_espionage_order.target_id, sc_espionage_order.id
+`
+
+type ReadAllEspionageResultsByEmpireParams struct {
+	AsOfDt   int64
+	EmpireID int64
+}
+
+type ReadAllEspionageResultsByEmpireRow struct {
+	EspionageID    int64
+	ScID           int64
+	ScEmpireID     int64
+	Kind           string
+	TargetID       int64
+	TargetEmpireID int64
+	Qty            int64
+	AgentsLost     int64
+	AgentsThrough  int64
+	CounterLost    int64
+	EffectQty      int64
+	Status         string
+	Reason         string
+}
+
+// ReadAllEspionageResultsByEmpire returns a list of the results of the
+// espionage missions run by an empire or against it on a given turn.
+// Owners are as of the mission. The caller is responsible for hiding the
+// details of missions against the empire.
+func (q *Queries) ReadAllEspionageResultsByEmpire(ctx context.Context, arg ReadAllEspionageResultsByEmpireParams) ([]ReadAllEspionageResultsByEmpireRow, error) {
+	rows, err := q.db.QueryContext(ctx, readAllEspionageResultsByEmpire, arg.AsOfDt, arg.EmpireID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ReadAllEspionageResultsByEmpireRow
+	for rows.Next() {
+		var i ReadAllEspionageResultsByEmpireRow
+		if err := rows.Scan(
+			&i.EspionageID,
+			&i.ScID,
+			&i.ScEmpireID,
+			&i.Kind,
+			&i.TargetID,
+			&i.TargetEmpireID,
+			&i.Qty,
+			&i.AgentsLost,
+			&i.AgentsThrough,
+			&i.CounterLost,
+			&i.EffectQty,
+			&i.Status,
+			&i.Reason,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const readAllFailedSurveysByEmpire = `-- name: ReadAllFailedSurveysByEmpire :many
+select sc_survey_result.survey_id,
+       sc_survey_order.sc_id,
+       sc_survey_result.reason
+from sc_owner,
+     scs,
+     sc_survey_order,
+     sc_survey_result
+where sc_owner.empire_id = ?1
+  and (sc_owner.effdt <= ?2 and ?2 < sc_owner.enddt)
+  and scs.id = sc_owner.sc_id
+  and sc_survey_order.sc_id = scs.id
+  and sc_survey_result.survey_id = sc_survey_order.id
+  and sc_survey_result.effdt = ?2
+  and sc_survey_result.status = 'failed'
+order by sc_survey_result.survey_id
+`
+
+type ReadAllFailedSurveysByEmpireParams struct {
+	EmpireID int64
+	AsOfDt   int64
+}
+
+type ReadAllFailedSurveysByEmpireRow struct {
+	SurveyID int64
+	ScID     int64
+	Reason   string
+}
+
+// ReadAllFailedSurveysByEmpire returns a list of the surveys of an empire
+// that failed in a given turn.
+func (q *Queries) ReadAllFailedSurveysByEmpire(ctx context.Context, arg ReadAllFailedSurveysByEmpireParams) ([]ReadAllFailedSurveysByEmpireRow, error) {
+	rows, err := q.db.QueryContext(ctx, readAllFailedSurveysByEmpire, arg.EmpireID, arg.AsOfDt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ReadAllFailedSurveysByEmpireRow
+	for rows.Next() {
+		var i ReadAllFailedSurveysByEmpireRow
+		if err := rows.Scan(&i.SurveyID, &i.ScID, &i.Reason); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const readAllJumpOrdersByTurn = `-- name: ReadAllJumpOrdersByTurn :many
+select sc_jump_order.id as jump_id,
+       sc_jump_order.sc_id,
+       scs.sc_cd,
+       sc_jump_order.orbit_id,
+       systems.id       as system_id,
+       systems.x,
+       systems.y,
+       systems.z
+from sc_jump_order,
+     scs,
+     orbits,
+     systems
+where sc_jump_order.effdt = ?1
+  and scs.id = sc_jump_order.sc_id
+  and orbits.id = sc_jump_order.orbit_id
+  and systems.id = orbits.system_id
+order by sc_jump_order.sc_id, sc_jump_order.id
+`
+
+type ReadAllJumpOrdersByTurnRow struct {
+	JumpID   int64
+	ScID     int64
+	ScCd     string
+	OrbitID  int64
+	SystemID int64
+	X        int64
+	Y        int64
+	Z        int64
+}
+
+// ReadAllJumpOrdersByTurn returns a list of jump orders issued in a given turn of a game.
+func (q *Queries) ReadAllJumpOrdersByTurn(ctx context.Context, asOfDt int64) ([]ReadAllJumpOrdersByTurnRow, error) {
+	rows, err := q.db.QueryContext(ctx, readAllJumpOrdersByTurn, asOfDt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ReadAllJumpOrdersByTurnRow
+	for rows.Next() {
+		var i ReadAllJumpOrdersByTurnRow
+		if err := rows.Scan(
+			&i.JumpID,
+			&i.ScID,
+			&i.ScCd,
+			&i.OrbitID,
+			&i.SystemID,
+			&i.X,
+			&i.Y,
+			&i.Z,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const readAllJumpResultsByEmpire = `-- name: ReadAllJumpResultsByEmpire :many
+select sc_jump_order.sc_id,
+       from_star.star_name as from_star_name,
+       from_orbit.orbit_no as from_orbit_no,
+       to_star.star_name   as to_star_name,
+       to_orbit.orbit_no   as to_orbit_no,
+       sc_jump_result.distance,
+       sc_jump_result.mass,
+       sc_jump_result.engines_used,
+       sc_jump_result.fuel_used,
+       sc_jump_result.status,
+       sc_jump_result.reason
+from sc_owner,
+     scs,
+     sc_jump_order,
+     sc_jump_result,
+     orbits as from_orbit,
+     stars as from_star,
+     orbits as to_orbit,
+     stars as to_star
+where sc_owner.empire_id = ?1
+  and (sc_owner.effdt <= ?2 and ?2 < sc_owner.enddt)
+  and scs.id = sc_owner.sc_id
+  and sc_jump_order.sc_id = scs.id
+  and sc_jump_result.jump_id = sc_jump_order.id
+  and sc_jump_result.effdt = ?2
+  and from_orbit.id = sc_jump_result.from_orbit_id
+  and from_star.id = from_orbit.star_id
+  and to_orbit.id = sc_jump_result.to_orbit_id
+  and to_star.id = to_orbit.star_id
+order by sc_jump_order.sc_id, sc_jump_order.id
+`
+
+type ReadAllJumpResultsByEmpireParams struct {
+	EmpireID int64
+	AsOfDt   int64
+}
+
+type ReadAllJumpResultsByEmpireRow struct {
+	ScID         int64
+	FromStarName string
+	FromOrbitNo  int64
+	ToStarName   string
+	ToOrbitNo    int64
+	Distance     float64
+	Mass         float64
+	EnginesUsed  int64
+	FuelUsed     int64
+	Status       string
+	Reason       string
+}
+
+// ReadAllJumpResultsByEmpire returns a list of the jump results for all the
+// ships in an empire for a given turn.
+func (q *Queries) ReadAllJumpResultsByEmpire(ctx context.Context, arg ReadAllJumpResultsByEmpireParams) ([]ReadAllJumpResultsByEmpireRow, error) {
+	rows, err := q.db.QueryContext(ctx, readAllJumpResultsByEmpire, arg.EmpireID, arg.AsOfDt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ReadAllJumpResultsByEmpireRow
+	for rows.Next() {
+		var i ReadAllJumpResultsByEmpireRow
+		if err := rows.Scan(
+			&i.ScID,
+			&i.FromStarName,
+			&i.FromOrbitNo,
+			&i.ToStarName,
+			&i.ToOrbitNo,
+			&i.Distance,
+			&i.Mass,
+			&i.EnginesUsed,
+			&i.FuelUsed,
+			&i.Status,
+			&i.Reason,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const readAllMoveOrdersByTurn = `-- name: ReadAllMoveOrdersByTurn :many
+select sc_move_order.id as move_id,
+       sc_move_order.sc_id,
+       scs.sc_cd,
+       sc_move_order.orbit_id,
+       orbits.system_id,
+       orbits.kind      as orbit_kind,
+       sc_move_order.is_on_surface
+from sc_move_order,
+     scs,
+     orbits
+where sc_move_order.effdt = ?1
+  and scs.id = sc_move_order.sc_id
+  and orbits.id = sc_move_order.orbit_id
+order by sc_move_order.sc_id, sc_move_order.id
+`
+
+type ReadAllMoveOrdersByTurnRow struct {
+	MoveID      int64
+	ScID        int64
+	ScCd        string
+	OrbitID     int64
+	SystemID    int64
+	OrbitKind   string
+	IsOnSurface int64
+}
+
+// ReadAllMoveOrdersByTurn returns a list of move orders issued in a given turn of a game.
+func (q *Queries) ReadAllMoveOrdersByTurn(ctx context.Context, asOfDt int64) ([]ReadAllMoveOrdersByTurnRow, error) {
+	rows, err := q.db.QueryContext(ctx, readAllMoveOrdersByTurn, asOfDt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ReadAllMoveOrdersByTurnRow
+	for rows.Next() {
+		var i ReadAllMoveOrdersByTurnRow
+		if err := rows.Scan(
+			&i.MoveID,
+			&i.ScID,
+			&i.ScCd,
+			&i.OrbitID,
+			&i.SystemID,
+			&i.OrbitKind,
+			&i.IsOnSurface,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const readAllMoveResultsByEmpire = `-- name: ReadAllMoveResultsByEmpire :many
+select sc_move_order.sc_id,
+       from_star.star_name as from_star_name,
+       from_orbit.orbit_no as from_orbit_no,
+       sc_move_result.from_is_on_surface,
+       to_star.star_name   as to_star_name,
+       to_orbit.orbit_no   as to_orbit_no,
+       sc_move_result.to_is_on_surface,
+       sc_move_result.mass,
+       sc_move_result.thrust,
+       sc_move_result.fuel_used,
+       sc_move_result.status,
+       sc_move_result.reason
+from sc_owner,
+     scs,
+     sc_move_order,
+     sc_move_result,
+     orbits as from_orbit,
+     stars as from_star,
+     orbits as to_orbit,
+     stars as to_star
+where sc_owner.empire_id = ?1
+  and (sc_owner.effdt <= ?2 and ?2 < sc_owner.enddt)
+  and scs.id = sc_owner.sc_id
+  and sc_move_order.sc_id = scs.id
+  and sc_move_result.move_id = sc_move_order.id
+  and sc_move_result.effdt = ?2
+  and from_orbit.id = sc_move_result.from_orbit_id
+  and from_star.id = from_orbit.star_id
+  and to_orbit.id = sc_move_result.to_orbit_id
+  and to_star.id = to_orbit.star_id
+order by sc_move_order.sc_id, sc_move_order.id
+`
+
+type ReadAllMoveResultsByEmpireParams struct {
+	EmpireID int64
+	AsOfDt   int64
+}
+
+type ReadAllMoveResultsByEmpireRow struct {
+	ScID            int64
+	FromStarName    string
+	FromOrbitNo     int64
+	FromIsOnSurface int64
+	ToStarName      string
+	ToOrbitNo       int64
+	ToIsOnSurface   int64
+	Mass            float64
+	Thrust          float64
+	FuelUsed        int64
+	Status          string
+	Reason          string
+}
+
+// ReadAllMoveResultsByEmpire returns a list of the move results for all the
+// ships in an empire for a given turn.
+func (q *Queries) ReadAllMoveResultsByEmpire(ctx context.Context, arg ReadAllMoveResultsByEmpireParams) ([]ReadAllMoveResultsByEmpireRow, error) {
+	rows, err := q.db.QueryContext(ctx, readAllMoveResultsByEmpire, arg.EmpireID, arg.AsOfDt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ReadAllMoveResultsByEmpireRow
+	for rows.Next() {
+		var i ReadAllMoveResultsByEmpireRow
+		if err := rows.Scan(
+			&i.ScID,
+			&i.FromStarName,
+			&i.FromOrbitNo,
+			&i.FromIsOnSurface,
+			&i.ToStarName,
+			&i.ToOrbitNo,
+			&i.ToIsOnSurface,
+			&i.Mass,
+			&i.Thrust,
+			&i.FuelUsed,
+			&i.Status,
+			&i.Reason,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const readAllProbeOrdersByTurn = `-- name: ReadAllProbeOrdersByTurn :many
+select sc_probe_order.id as probe_id,
+       sc_probe_order.sc_id,
+       sc_owner.empire_id,
+       scs.sc_cd,
+       sc_probe_order.target_id,
+       sc_probe_order.kind
+from sc_probe_order,
+     scs,
+     sc_owner
+where sc_probe_order.effdt = ?1
+  and scs.id = sc_probe_order.sc_id
+  and sc_owner.sc_id = scs.id
+  and (sc_owner.effdt <= ?1 and ?1 < sc_owner.enddt)
+order by sc_probe_order.sc_id, sc_probe_order.id
+`
+
+type ReadAllProbeOrdersByTurnRow struct {
+	ProbeID  int64
+	ScID     int64
+	EmpireID int64
+	ScCd     string
+	TargetID int64
+	Kind     string
+}
+
+// ReadAllProbeOrdersByTurn returns a list of probe orders issued in a given turn of a game.
+func (q *Queries) ReadAllProbeOrdersByTurn(ctx context.Context, asOfDt int64) ([]ReadAllProbeOrdersByTurnRow, error) {
+	rows, err := q.db.QueryContext(ctx, readAllProbeOrdersByTurn, asOfDt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ReadAllProbeOrdersByTurnRow
+	for rows.Next() {
+		var i ReadAllProbeOrdersByTurnRow
+		if err := rows.Scan(
+			&i.ProbeID,
+			&i.ScID,
+			&i.EmpireID,
+			&i.ScCd,
+			&i.TargetID,
+			&i.Kind,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const readAllSCsByOrbit = `-- name: ReadAllSCsByOrbit :many
+select scs.id as sc_id,
+       sc_owner.empire_id,
+       scs.sc_cd,
+       sc_location.is_on_surface
+from sc_location,
+     scs,
+     sc_owner
+where sc_location.orbit_id = ?1
+  and (sc_location.effdt <= ?2 and ?2 < sc_location.enddt)
+  and scs.id = sc_location.sc_id
+  and sc_owner.sc_id = scs.id
+  and (sc_owner.effdt <= ?2 and ?2 < sc_owner.enddt)
+order by scs.id
+`
+
+type ReadAllSCsByOrbitParams struct {
+	OrbitID int64
+	AsOfDt  int64
+}
+
+type ReadAllSCsByOrbitRow struct {
+	ScID        int64
+	EmpireID    int64
+	ScCd        string
+	IsOnSurface int64
+}
+
+// ReadAllSCsByOrbit returns a list of the ships and colonies in an orbit on a given turn.
+func (q *Queries) ReadAllSCsByOrbit(ctx context.Context, arg ReadAllSCsByOrbitParams) ([]ReadAllSCsByOrbitRow, error) {
+	rows, err := q.db.QueryContext(ctx, readAllSCsByOrbit, arg.OrbitID, arg.AsOfDt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ReadAllSCsByOrbitRow
+	for rows.Next() {
+		var i ReadAllSCsByOrbitRow
+		if err := rows.Scan(
+			&i.ScID,
+			&i.EmpireID,
+			&i.ScCd,
+			&i.IsOnSurface,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const readAllShipsByEmpire = `-- name: ReadAllShipsByEmpire :many
+select scs.id     as sc_id,
+       systems.id as system_id,
+       systems.system_name,
+       stars.id   as star_id,
        stars.star_name,
        orbits.orbit_no,
-       sc_codes.name as sc_kind,
+       sc_location.is_on_surface,
        scs.sc_tech_level,
-       sc_name.name,
-       sc_rates.rations,
-       sc_rates.birth_rate,
-       sc_rates.death_rate,
-       sc_rates.sol
-from scs,
-     sc_codes,
+       sc_name.name
+from sc_owner,
+     scs,
      sc_name,
-     sc_rates,
      sc_location,
      orbits,
      stars,
      systems
-where scs.empire_id = ?1
-  and scs.sc_cd in ('COPN', 'CENC', 'CORB')
-  and sc_codes.code = scs.sc_cd
+where sc_owner.empire_id = ?1
+  and (sc_owner.effdt <= ?2 and ?2 < sc_owner.enddt)
+  and scs.id = sc_owner.sc_id
+  and scs.sc_cd = 'SHIP'
   and sc_name.sc_id = scs.id
   and (sc_name.effdt <= ?2 and ?2 < sc_name.enddt)
-  and sc_rates.sc_id = scs.id
-  and (sc_rates.effdt <= ?2 and ?2 < sc_rates.enddt)
   and sc_location.sc_id = scs.id
   and (sc_location.effdt <= ?2 and ?2 < sc_location.enddt)
   and orbits.id = sc_location.orbit_id
@@ -703,38 +2259,34 @@ where scs.empire_id = ?1
 order by scs.id
 `
 
-type ReadAllColoniesByEmpireParams struct {
+type ReadAllShipsByEmpireParams struct {
 	EmpireID int64
 	AsOfDt   int64
 }
 
-type ReadAllColoniesByEmpireRow struct {
+type ReadAllShipsByEmpireRow struct {
 	ScID        int64
 	SystemID    int64
 	SystemName  string
 	StarID      int64
 	StarName    string
 	OrbitNo     int64
-	ScKind      string
+	IsOnSurface int64
 	ScTechLevel int64
 	Name        string
-	Rations     float64
-	BirthRate   float64
-	DeathRate   float64
-	Sol         float64
 }
 
-// ReadAllColoniesByEmpire returns a list of all colonies for an empire
+// ReadAllShipsByEmpire returns a list of all ships for an empire
 // that were active on a given turn.
-func (q *Queries) ReadAllColoniesByEmpire(ctx context.Context, arg ReadAllColoniesByEmpireParams) ([]ReadAllColoniesByEmpireRow, error) {
-	rows, err := q.db.QueryContext(ctx, readAllColoniesByEmpire, arg.EmpireID, arg.AsOfDt)
+func (q *Queries) ReadAllShipsByEmpire(ctx context.Context, arg ReadAllShipsByEmpireParams) ([]ReadAllShipsByEmpireRow, error) {
+	rows, err := q.db.QueryContext(ctx, readAllShipsByEmpire, arg.EmpireID, arg.AsOfDt)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []ReadAllColoniesByEmpireRow
+	var items []ReadAllShipsByEmpireRow
 	for rows.Next() {
-		var i ReadAllColoniesByEmpireRow
+		var i ReadAllShipsByEmpireRow
 		if err := rows.Scan(
 			&i.ScID,
 			&i.SystemID,
@@ -742,13 +2294,149 @@ func (q *Queries) ReadAllColoniesByEmpire(ctx context.Context, arg ReadAllColoni
 			&i.StarID,
 			&i.StarName,
 			&i.OrbitNo,
-			&i.ScKind,
+			&i.IsOnSurface,
 			&i.ScTechLevel,
 			&i.Name,
-			&i.Rations,
-			&i.BirthRate,
-			&i.DeathRate,
-			&i.Sol,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const readAllSurveyDepositResultsByEmpire = `-- name: ReadAllSurveyDepositResultsByEmpire :many
+select sc_survey_deposit_result.survey_id,
+       sc_survey_deposit_result.deposit_no,
+       sc_survey_deposit_result.deposit_kind,
+       sc_survey_deposit_result.deposit_qty,
+       sc_survey_deposit_result.yield_pct
+from sc_owner,
+     scs,
+     sc_survey_order,
+     sc_survey_deposit_result
+where sc_owner.empire_id = ?1
+  and (sc_owner.effdt <= ?2 and ?2 < sc_owner.enddt)
+  and scs.id = sc_owner.sc_id
+  and sc_survey_order.sc_id = scs.id
+  and sc_survey_deposit_result.survey_id = sc_survey_order.id
+  and sc_survey_deposit_result.effdt = ?2
+order by sc_survey_deposit_result.survey_id, sc_survey_deposit_result.deposit_no
+`
+
+type ReadAllSurveyDepositResultsByEmpireParams struct {
+	EmpireID int64
+	AsOfDt   int64
+}
+
+type ReadAllSurveyDepositResultsByEmpireRow struct {
+	SurveyID    int64
+	DepositNo   int64
+	DepositKind string
+	DepositQty  int64
+	YieldPct    int64
+}
+
+// ReadAllSurveyDepositResultsByEmpire returns a list of the deposits found
+// by all the surveys of an empire for a given turn.
+func (q *Queries) ReadAllSurveyDepositResultsByEmpire(ctx context.Context, arg ReadAllSurveyDepositResultsByEmpireParams) ([]ReadAllSurveyDepositResultsByEmpireRow, error) {
+	rows, err := q.db.QueryContext(ctx, readAllSurveyDepositResultsByEmpire, arg.EmpireID, arg.AsOfDt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ReadAllSurveyDepositResultsByEmpireRow
+	for rows.Next() {
+		var i ReadAllSurveyDepositResultsByEmpireRow
+		if err := rows.Scan(
+			&i.SurveyID,
+			&i.DepositNo,
+			&i.DepositKind,
+			&i.DepositQty,
+			&i.YieldPct,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const readAllSurveyOrbitResultsByEmpire = `-- name: ReadAllSurveyOrbitResultsByEmpire :many
+select sc_survey_orbit_result.survey_id,
+       sc_survey_order.sc_id,
+       orbits.star_id,
+       sc_survey_orbit_result.orbit_id,
+       sc_survey_orbit_result.location,
+       sc_survey_orbit_result.orbit_no,
+       sc_survey_orbit_result.habitability_no,
+       sc_survey_orbit_result.farmland_in_use,
+       sc_survey_orbit_result.population
+from sc_owner,
+     scs,
+     sc_survey_order,
+     sc_survey_orbit_result,
+     orbits
+where sc_owner.empire_id = ?1
+  and (sc_owner.effdt <= ?2 and ?2 < sc_owner.enddt)
+  and scs.id = sc_owner.sc_id
+  and sc_survey_order.sc_id = scs.id
+  and sc_survey_orbit_result.survey_id = sc_survey_order.id
+  and sc_survey_orbit_result.effdt = ?2
+  and orbits.id = sc_survey_orbit_result.orbit_id
+order by sc_survey_orbit_result.survey_id
+`
+
+type ReadAllSurveyOrbitResultsByEmpireParams struct {
+	EmpireID int64
+	AsOfDt   int64
+}
+
+type ReadAllSurveyOrbitResultsByEmpireRow struct {
+	SurveyID       int64
+	ScID           int64
+	StarID         int64
+	OrbitID        int64
+	Location       string
+	OrbitNo        int64
+	HabitabilityNo int64
+	FarmlandInUse  int64
+	Population     int64
+}
+
+// ReadAllSurveyOrbitResultsByEmpire returns a list of the results of all
+// the surveys of an empire for a given turn.
+func (q *Queries) ReadAllSurveyOrbitResultsByEmpire(ctx context.Context, arg ReadAllSurveyOrbitResultsByEmpireParams) ([]ReadAllSurveyOrbitResultsByEmpireRow, error) {
+	rows, err := q.db.QueryContext(ctx, readAllSurveyOrbitResultsByEmpire, arg.EmpireID, arg.AsOfDt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ReadAllSurveyOrbitResultsByEmpireRow
+	for rows.Next() {
+		var i ReadAllSurveyOrbitResultsByEmpireRow
+		if err := rows.Scan(
+			&i.SurveyID,
+			&i.ScID,
+			&i.StarID,
+			&i.OrbitID,
+			&i.Location,
+			&i.OrbitNo,
+			&i.HabitabilityNo,
+			&i.FarmlandInUse,
+			&i.Population,
 		); err != nil {
 			return nil, err
 		}
@@ -764,24 +2452,29 @@ func (q *Queries) ReadAllColoniesByEmpire(ctx context.Context, arg ReadAllColoni
 }
 
 const readAllSurveyOrdersByTurn = `-- name: ReadAllSurveyOrdersByTurn :many
-select empire.id as empire_id,
+select empire.id          as empire_id,
+       sc_survey_order.id as survey_id,
        sc_survey_order.sc_id,
        sc_survey_order.effdt,
-       sc_survey_order.target_id
+       sc_survey_order.target_id,
+       sc_survey_order.kind
 from sc_survey_order,
-     scs,
+     sc_owner,
      empire
 where sc_survey_order.effdt = ?1
-  and scs.id = sc_survey_order.sc_id
-  and empire.id = scs.empire_id
+  and sc_owner.sc_id = sc_survey_order.sc_id
+  and (sc_owner.effdt <= ?1 and ?1 < sc_owner.enddt)
+  and empire.id = sc_owner.empire_id
 order by empire.id, sc_survey_order.sc_id, sc_survey_order.effdt, sc_survey_order.target_id
 `
 
 type ReadAllSurveyOrdersByTurnRow struct {
 	EmpireID int64
+	SurveyID int64
 	ScID     int64
 	Effdt    int64
 	TargetID int64
+	Kind     string
 }
 
 // ReadAllSurveyOrdersByTurn returns a list of survey orders issued in a given turn of a game.
@@ -796,9 +2489,11 @@ func (q *Queries) ReadAllSurveyOrdersByTurn(ctx context.Context, asOfDt int64) (
 		var i ReadAllSurveyOrdersByTurnRow
 		if err := rows.Scan(
 			&i.EmpireID,
+			&i.SurveyID,
 			&i.ScID,
 			&i.Effdt,
 			&i.TargetID,
+			&i.Kind,
 		); err != nil {
 			return nil, err
 		}
@@ -813,9 +2508,46 @@ func (q *Queries) ReadAllSurveyOrdersByTurn(ctx context.Context, asOfDt int64) (
 	return items, nil
 }
 
+const readPopulationBasePayRate = `-- name: ReadPopulationBasePayRate :one
+select base_pay_rate
+from population_codes
+where code = ?1
+`
+
+// ReadPopulationBasePayRate returns the base pay rate for a population code.
+func (q *Queries) ReadPopulationBasePayRate(ctx context.Context, code string) (float64, error) {
+	row := q.db.QueryRowContext(ctx, readPopulationBasePayRate, code)
+	var base_pay_rate float64
+	err := row.Scan(&base_pay_rate)
+	return base_pay_rate, err
+}
+
+const readSC = `-- name: ReadSC :one
+select empire_id,
+       sc_cd,
+       sc_tech_level
+from scs
+where id = ?1
+`
+
+type ReadSCRow struct {
+	EmpireID    int64
+	ScCd        string
+	ScTechLevel int64
+}
+
+// ReadSC returns the code and tech level of a ship or colony.
+func (q *Queries) ReadSC(ctx context.Context, scID int64) (ReadSCRow, error) {
+	row := q.db.QueryRowContext(ctx, readSC, scID)
+	var i ReadSCRow
+	err := row.Scan(&i.EmpireID, &i.ScCd, &i.ScTechLevel)
+	return i, err
+}
+
 const readSCGroupTooling = `-- name: ReadSCGroupTooling :many
 select sc_group.id as group_id,
        sc_group_no.group_no,
+       sc_group_tooling.effdt,
        sc_group_tooling.item_cd,
        sc_group_tooling.item_tech_level,
        sc_group_tooling.retooled
@@ -842,6 +2574,7 @@ type ReadSCGroupToolingParams struct {
 type ReadSCGroupToolingRow struct {
 	GroupID       int64
 	GroupNo       int64
+	Effdt         int64
 	ItemCd        string
 	ItemTechLevel int64
 	Retooled      int64
@@ -860,6 +2593,7 @@ func (q *Queries) ReadSCGroupTooling(ctx context.Context, arg ReadSCGroupTooling
 		if err := rows.Scan(
 			&i.GroupID,
 			&i.GroupNo,
+			&i.Effdt,
 			&i.ItemCd,
 			&i.ItemTechLevel,
 			&i.Retooled,
@@ -877,6 +2611,89 @@ func (q *Queries) ReadSCGroupTooling(ctx context.Context, arg ReadSCGroupTooling
 	return items, nil
 }
 
+const readSCGroupUnit = `-- name: ReadSCGroupUnit :one
+select effdt,
+       enddt,
+       nbr_of_units
+from sc_group_unit
+where group_id = ?1
+  and tech_level = ?2
+  and (effdt <= ?3 and ?3 < enddt)
+`
+
+type ReadSCGroupUnitParams struct {
+	GroupID   int64
+	TechLevel int64
+	AsOfDt    int64
+}
+
+type ReadSCGroupUnitRow struct {
+	Effdt      int64
+	Enddt      int64
+	NbrOfUnits int64
+}
+
+// ReadSCGroupUnit returns the units in a group with a single tech level.
+func (q *Queries) ReadSCGroupUnit(ctx context.Context, arg ReadSCGroupUnitParams) (ReadSCGroupUnitRow, error) {
+	row := q.db.QueryRowContext(ctx, readSCGroupUnit, arg.GroupID, arg.TechLevel, arg.AsOfDt)
+	var i ReadSCGroupUnitRow
+	err := row.Scan(&i.Effdt, &i.Enddt, &i.NbrOfUnits)
+	return i, err
+}
+
+const readSCGroupUnitWIPByGroup = `-- name: ReadSCGroupUnitWIPByGroup :many
+select tech_level,
+       wip_25pct_qty,
+       wip_50pct_qty,
+       wip_75pct_qty
+from sc_group_unit_production_wip
+where group_id = ?1
+  and production_dt = ?2
+order by tech_level
+`
+
+type ReadSCGroupUnitWIPByGroupParams struct {
+	GroupID      int64
+	ProductionDt int64
+}
+
+type ReadSCGroupUnitWIPByGroupRow struct {
+	TechLevel   int64
+	Wip25pctQty int64
+	Wip50pctQty int64
+	Wip75pctQty int64
+}
+
+// ReadSCGroupUnitWIPByGroup returns the work in progress for the units in
+// a single group at the end of a given turn.
+func (q *Queries) ReadSCGroupUnitWIPByGroup(ctx context.Context, arg ReadSCGroupUnitWIPByGroupParams) ([]ReadSCGroupUnitWIPByGroupRow, error) {
+	rows, err := q.db.QueryContext(ctx, readSCGroupUnitWIPByGroup, arg.GroupID, arg.ProductionDt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ReadSCGroupUnitWIPByGroupRow
+	for rows.Next() {
+		var i ReadSCGroupUnitWIPByGroupRow
+		if err := rows.Scan(
+			&i.TechLevel,
+			&i.Wip25pctQty,
+			&i.Wip50pctQty,
+			&i.Wip75pctQty,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const readSCGroupUnits = `-- name: ReadSCGroupUnits :many
 select sc_group_unit.tech_level,
        nbr_of_units
@@ -925,6 +2742,49 @@ func (q *Queries) ReadSCGroupUnits(ctx context.Context, arg ReadSCGroupUnitsPara
 	return items, nil
 }
 
+const readSCGroupUnitsByGroup = `-- name: ReadSCGroupUnitsByGroup :many
+select tech_level,
+       nbr_of_units
+from sc_group_unit
+where group_id = ?1
+  and (effdt <= ?2 and ?2 < enddt)
+order by tech_level
+`
+
+type ReadSCGroupUnitsByGroupParams struct {
+	GroupID int64
+	AsOfDt  int64
+}
+
+type ReadSCGroupUnitsByGroupRow struct {
+	TechLevel  int64
+	NbrOfUnits int64
+}
+
+// ReadSCGroupUnitsByGroup returns a list of the units in a single group.
+func (q *Queries) ReadSCGroupUnitsByGroup(ctx context.Context, arg ReadSCGroupUnitsByGroupParams) ([]ReadSCGroupUnitsByGroupRow, error) {
+	rows, err := q.db.QueryContext(ctx, readSCGroupUnitsByGroup, arg.GroupID, arg.AsOfDt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ReadSCGroupUnitsByGroupRow
+	for rows.Next() {
+		var i ReadSCGroupUnitsByGroupRow
+		if err := rows.Scan(&i.TechLevel, &i.NbrOfUnits); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const readSCGroups = `-- name: ReadSCGroups :many
 select sc_group.id as group_id,
        sc_group_no.group_no
@@ -940,28 +2800,77 @@ where scs.id = ?1
 order by sc_group_no.group_no
 `
 
-type ReadSCGroupsParams struct {
+type ReadSCGroupsParams struct {
+	ScID   int64
+	Kind   string
+	AsOfDt int64
+}
+
+type ReadSCGroupsRow struct {
+	GroupID int64
+	GroupNo int64
+}
+
+// ReadSCGroups returns a list of the groups for a given ship or colony.
+func (q *Queries) ReadSCGroups(ctx context.Context, arg ReadSCGroupsParams) ([]ReadSCGroupsRow, error) {
+	rows, err := q.db.QueryContext(ctx, readSCGroups, arg.ScID, arg.Kind, arg.AsOfDt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ReadSCGroupsRow
+	for rows.Next() {
+		var i ReadSCGroupsRow
+		if err := rows.Scan(&i.GroupID, &i.GroupNo); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const readSCGroupsByGroupNo = `-- name: ReadSCGroupsByGroupNo :many
+select sc_group.id as group_id,
+       sc_group.kind,
+       sc_group_no.group_no
+from sc_group,
+     sc_group_no
+where sc_group.sc_id = ?1
+  and (sc_group.effdt <= ?2 and ?2 < sc_group.enddt)
+  and sc_group_no.group_id = sc_group.id
+  and (sc_group_no.effdt <= ?2 and ?2 < sc_group_no.enddt)
+order by sc_group_no.group_no, sc_group.kind
+`
+
+type ReadSCGroupsByGroupNoParams struct {
 	ScID   int64
-	Kind   string
 	AsOfDt int64
 }
 
-type ReadSCGroupsRow struct {
+type ReadSCGroupsByGroupNoRow struct {
 	GroupID int64
+	Kind    string
 	GroupNo int64
 }
 
-// ReadSCGroups returns a list of the groups for a given ship or colony.
-func (q *Queries) ReadSCGroups(ctx context.Context, arg ReadSCGroupsParams) ([]ReadSCGroupsRow, error) {
-	rows, err := q.db.QueryContext(ctx, readSCGroups, arg.ScID, arg.Kind, arg.AsOfDt)
+// ReadSCGroupsByGroupNo returns all the groups of a ship or colony in the
+// order that resources are assigned to them.
+func (q *Queries) ReadSCGroupsByGroupNo(ctx context.Context, arg ReadSCGroupsByGroupNoParams) ([]ReadSCGroupsByGroupNoRow, error) {
+	rows, err := q.db.QueryContext(ctx, readSCGroupsByGroupNo, arg.ScID, arg.AsOfDt)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []ReadSCGroupsRow
+	var items []ReadSCGroupsByGroupNoRow
 	for rows.Next() {
-		var i ReadSCGroupsRow
-		if err := rows.Scan(&i.GroupID, &i.GroupNo); err != nil {
+		var i ReadSCGroupsByGroupNoRow
+		if err := rows.Scan(&i.GroupID, &i.Kind, &i.GroupNo); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -1041,6 +2950,142 @@ func (q *Queries) ReadSCInventory(ctx context.Context, arg ReadSCInventoryParams
 	return items, nil
 }
 
+const readSCInventoryUnit = `-- name: ReadSCInventoryUnit :one
+select sc_inventory.effdt,
+       sc_inventory.qty,
+       sc_inventory.mass,
+       sc_inventory.volume,
+       sc_inventory.is_assembled,
+       sc_inventory.is_stored
+from sc_inventory
+where sc_inventory.sc_id = ?1
+  and sc_inventory.unit_cd = ?2
+  and sc_inventory.unit_tech_level = ?3
+  and sc_inventory.is_assembled = ?4
+  and (sc_inventory.effdt <= ?5 and ?5 < sc_inventory.enddt)
+`
+
+type ReadSCInventoryUnitParams struct {
+	ScID          int64
+	UnitCd        string
+	UnitTechLevel int64
+	IsAssembled   int64
+	AsOfDt        int64
+}
+
+type ReadSCInventoryUnitRow struct {
+	Effdt       int64
+	Qty         int64
+	Mass        float64
+	Volume      float64
+	IsAssembled int64
+	IsStored    int64
+}
+
+// ReadSCInventoryUnit returns the inventory entry for a single unit and tech level
+// that is either assembled or in storage.
+func (q *Queries) ReadSCInventoryUnit(ctx context.Context, arg ReadSCInventoryUnitParams) (ReadSCInventoryUnitRow, error) {
+	row := q.db.QueryRowContext(ctx, readSCInventoryUnit,
+		arg.ScID,
+		arg.UnitCd,
+		arg.UnitTechLevel,
+		arg.IsAssembled,
+		arg.AsOfDt,
+	)
+	var i ReadSCInventoryUnitRow
+	err := row.Scan(
+		&i.Effdt,
+		&i.Qty,
+		&i.Mass,
+		&i.Volume,
+		&i.IsAssembled,
+		&i.IsStored,
+	)
+	return i, err
+}
+
+const readSCLocation = `-- name: ReadSCLocation :one
+select sc_location.effdt,
+       sc_location.orbit_id,
+       sc_location.is_on_surface,
+       orbits.system_id,
+       orbits.star_id,
+       orbits.orbit_no,
+       orbits.kind as orbit_kind,
+       systems.x,
+       systems.y,
+       systems.z
+from sc_location,
+     orbits,
+     systems
+where sc_location.sc_id = ?1
+  and (sc_location.effdt <= ?2 and ?2 < sc_location.enddt)
+  and orbits.id = sc_location.orbit_id
+  and systems.id = orbits.system_id
+`
+
+type ReadSCLocationParams struct {
+	ScID   int64
+	AsOfDt int64
+}
+
+type ReadSCLocationRow struct {
+	Effdt       int64
+	OrbitID     int64
+	IsOnSurface int64
+	SystemID    int64
+	StarID      int64
+	OrbitNo     int64
+	OrbitKind   string
+	X           int64
+	Y           int64
+	Z           int64
+}
+
+// ReadSCLocation returns the location of a ship or colony on a given turn.
+func (q *Queries) ReadSCLocation(ctx context.Context, arg ReadSCLocationParams) (ReadSCLocationRow, error) {
+	row := q.db.QueryRowContext(ctx, readSCLocation, arg.ScID, arg.AsOfDt)
+	var i ReadSCLocationRow
+	err := row.Scan(
+		&i.Effdt,
+		&i.OrbitID,
+		&i.IsOnSurface,
+		&i.SystemID,
+		&i.StarID,
+		&i.OrbitNo,
+		&i.OrbitKind,
+		&i.X,
+		&i.Y,
+		&i.Z,
+	)
+	return i, err
+}
+
+const readSCOwner = `-- name: ReadSCOwner :one
+select effdt, empire_id
+from sc_owner
+where sc_id = ?1
+  and (effdt <= ?2 and ?2 < enddt)
+`
+
+type ReadSCOwnerParams struct {
+	ScID   int64
+	AsOfDt int64
+}
+
+type ReadSCOwnerRow struct {
+	Effdt    int64
+	EmpireID int64
+}
+
+// ReadSCOwner returns the empire that owns a ship or colony on a given turn.
+func (q *Queries) ReadSCOwner(ctx context.Context, arg ReadSCOwnerParams) (ReadSCOwnerRow, error) {
+	row := q.db.QueryRowContext(ctx, readSCOwner, arg.ScID, arg.AsOfDt)
+	var i ReadSCOwnerRow
+	err := row.Scan(&i.Effdt, &i.EmpireID)
+	return i, err
+}
+
 const readSCPopulation = `-- name: ReadSCPopulation :many
 select sc_population.population_cd,
        population_codes.name as population_kind,
@@ -1100,6 +3145,66 @@ func (q *Queries) ReadSCPopulation(ctx context.Context, arg ReadSCPopulationPara
 	return items, nil
 }
 
+const readSCPopulationCode = `-- name: ReadSCPopulationCode :one
+select sc_population.effdt,
+       sc_population.qty,
+       sc_population.pay_rate,
+       sc_population.rebel_qty
+from sc_population
+where sc_population.sc_id = ?1
+  and sc_population.population_cd = ?2
+  and (sc_population.effdt <= ?3 and ?3 < sc_population.enddt)
+`
+
+type ReadSCPopulationCodeParams struct {
+	ScID         int64
+	PopulationCd string
+	AsOfDt       int64
+}
+
+type ReadSCPopulationCodeRow struct {
+	Effdt    int64
+	Qty      int64
+	PayRate  float64
+	RebelQty int64
+}
+
+// ReadSCPopulationCode returns the population entry for a single population code.
+func (q *Queries) ReadSCPopulationCode(ctx context.Context, arg ReadSCPopulationCodeParams) (ReadSCPopulationCodeRow, error) {
+	row := q.db.QueryRowContext(ctx, readSCPopulationCode, arg.ScID, arg.PopulationCd, arg.AsOfDt)
+	var i ReadSCPopulationCodeRow
+	err := row.Scan(
+		&i.Effdt,
+		&i.Qty,
+		&i.PayRate,
+		&i.RebelQty,
+	)
+	return i, err
+}
+
+const readSCPowerConsumed = `-- name: ReadSCPowerConsumed :one
+select cast(coalesce(sum(sc_group_production_summary.power_consumed), 0) as integer) as power_consumed
+from sc_group,
+     sc_group_production_summary
+where sc_group.sc_id = ?1
+  and sc_group_production_summary.group_id = sc_group.id
+  and sc_group_production_summary.production_dt = ?2
+`
+
+type ReadSCPowerConsumedParams struct {
+	ScID         int64
+	ProductionDt int64
+}
+
+// ReadSCPowerConsumed returns the power used by all the groups of a ship
+// or colony on a turn.
+func (q *Queries) ReadSCPowerConsumed(ctx context.Context, arg ReadSCPowerConsumedParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, readSCPowerConsumed, arg.ScID, arg.ProductionDt)
+	var power_consumed int64
+	err := row.Scan(&power_consumed)
+	return power_consumed, err
+}
+
 const readSCProbeOrders = `-- name: ReadSCProbeOrders :exec
 select target_id, kind
 from sc_probe_order
@@ -1119,6 +3224,44 @@ func (q *Queries) ReadSCProbeOrders(ctx context.Context, arg ReadSCProbeOrdersPa
 	return err
 }
 
+const readSCRates = `-- name: ReadSCRates :one
+select effdt,
+       rations,
+       sol,
+       birth_rate,
+       death_rate
+from sc_rates
+where sc_id = ?1
+  and (effdt <= ?2 and ?2 < enddt)
+`
+
+type ReadSCRatesParams struct {
+	ScID   int64
+	AsOfDt int64
+}
+
+type ReadSCRatesRow struct {
+	Effdt     int64
+	Rations   float64
+	Sol       float64
+	BirthRate float64
+	DeathRate float64
+}
+
+// ReadSCRates returns the rates for a colony on a given turn.
+func (q *Queries) ReadSCRates(ctx context.Context, arg ReadSCRatesParams) (ReadSCRatesRow, error) {
+	row := q.db.QueryRowContext(ctx, readSCRates, arg.ScID, arg.AsOfDt)
+	var i ReadSCRatesRow
+	err := row.Scan(
+		&i.Effdt,
+		&i.Rations,
+		&i.Sol,
+		&i.BirthRate,
+		&i.DeathRate,
+	)
+	return i, err
+}
+
 const readSCSurveyOrders = `-- name: ReadSCSurveyOrders :exec
 select target_id
 from sc_survey_order
@@ -1138,13 +3281,100 @@ func (q *Queries) ReadSCSurveyOrders(ctx context.Context, arg ReadSCSurveyOrders
 	return err
 }
 
+const readSCTypeFactor = `-- name: ReadSCTypeFactor :one
+select type_factor
+from sc_codes
+where code = ?1
+`
+
+// ReadSCTypeFactor returns the type factor for a kind of ship or colony.
+func (q *Queries) ReadSCTypeFactor(ctx context.Context, scCd string) (int64, error) {
+	row := q.db.QueryRowContext(ctx, readSCTypeFactor, scCd)
+	var type_factor int64
+	err := row.Scan(&type_factor)
+	return type_factor, err
+}
+
+const updateSCGroupUnitEndDt = `-- name: UpdateSCGroupUnitEndDt :exec
+update sc_group_unit
+set enddt = ?1
+where group_id = ?2
+  and tech_level = ?3
+  and effdt = ?4
+`
+
+type UpdateSCGroupUnitEndDtParams struct {
+	Enddt     int64
+	GroupID   int64
+	TechLevel int64
+	Effdt     int64
+}
+
+// UpdateSCGroupUnitEndDt updates the end date for a set of units in a group.
+func (q *Queries) UpdateSCGroupUnitEndDt(ctx context.Context, arg UpdateSCGroupUnitEndDtParams) error {
+	_, err := q.db.ExecContext(ctx, updateSCGroupUnitEndDt,
+		arg.Enddt,
+		arg.GroupID,
+		arg.TechLevel,
+		arg.Effdt,
+	)
+	return err
+}
+
+const updateSCGroupUnitEndDtByTurn = `-- name: UpdateSCGroupUnitEndDtByTurn :exec
+update sc_group_unit
+set enddt = ?1
+where enddt = ?2
+`
+
+type UpdateSCGroupUnitEndDtByTurnParams struct {
+	MaxEnddt int64
+	Effdt    int64
+}
+
+// UpdateSCGroupUnitEndDtByTurn re-opens the group unit entries that were
+// end-dated on a given turn. It is used to undo the changes made on the turn.
+func (q *Queries) UpdateSCGroupUnitEndDtByTurn(ctx context.Context, arg UpdateSCGroupUnitEndDtByTurnParams) error {
+	_, err := q.db.ExecContext(ctx, updateSCGroupUnitEndDtByTurn, arg.MaxEnddt, arg.Effdt)
+	return err
+}
+
+const updateSCGroupUnitQty = `-- name: UpdateSCGroupUnitQty :exec
+update sc_group_unit
+set nbr_of_units = ?1
+where group_id = ?2
+  and tech_level = ?3
+  and effdt = ?4
+`
+
+type UpdateSCGroupUnitQtyParams struct {
+	NbrOfUnits int64
+	GroupID    int64
+	TechLevel  int64
+	Effdt      int64
+}
+
+// UpdateSCGroupUnitQty updates the number of units in a group. Use this only
+// when the entry was created in the current turn; otherwise, end-date the
+// entry and create a new one.
+func (q *Queries) UpdateSCGroupUnitQty(ctx context.Context, arg UpdateSCGroupUnitQtyParams) error {
+	_, err := q.db.ExecContext(ctx, updateSCGroupUnitQty,
+		arg.NbrOfUnits,
+		arg.GroupID,
+		arg.TechLevel,
+		arg.Effdt,
+	)
+	return err
+}
+
 const updateSCInventoryEndDt = `-- name: UpdateSCInventoryEndDt :exec
 update sc_inventory
 set enddt = ?1
 where sc_id = ?2
   and unit_cd = ?3
   and unit_tech_level = ?4
-  and effdt = ?5
+  and is_assembled = ?5
+  and effdt = ?6
 `
 
 type UpdateSCInventoryEndDtParams struct {
@@ -1152,6 +3382,7 @@ type UpdateSCInventoryEndDtParams struct {
 	ScID          int64
 	UnitCd        string
 	UnitTechLevel int64
+	IsAssembled   int64
 	Effdt         int64
 }
 
@@ -1162,6 +3393,65 @@ func (q *Queries) UpdateSCInventoryEndDt(ctx context.Context, arg UpdateSCInvent
 		arg.ScID,
 		arg.UnitCd,
 		arg.UnitTechLevel,
+		arg.IsAssembled,
+		arg.Effdt,
+	)
+	return err
+}
+
+const updateSCInventoryEndDtByTurn = `-- name: UpdateSCInventoryEndDtByTurn :exec
+update sc_inventory
+set enddt = ?1
+where enddt = ?2
+`
+
+type UpdateSCInventoryEndDtByTurnParams struct {
+	MaxEnddt int64
+	Effdt    int64
+}
+
+// UpdateSCInventoryEndDtByTurn re-opens the inventory entries that were
+// end-dated on a given turn. It is used to undo the changes made on the turn.
+func (q *Queries) UpdateSCInventoryEndDtByTurn(ctx context.Context, arg UpdateSCInventoryEndDtByTurnParams) error {
+	_, err := q.db.ExecContext(ctx, updateSCInventoryEndDtByTurn, arg.MaxEnddt, arg.Effdt)
+	return err
+}
+
+const updateSCInventoryQty = `-- name: UpdateSCInventoryQty :exec
+update sc_inventory
+set qty    = ?1,
+    mass   = ?2,
+    volume = ?3
+where sc_id = ?4
+  and unit_cd = ?5
+  and unit_tech_level = ?6
+  and is_assembled = ?7
+  and effdt = ?8
+`
+
+type UpdateSCInventoryQtyParams struct {
+	Qty           int64
+	Mass          float64
+	Volume        float64
+	ScID          int64
+	UnitCd        string
+	UnitTechLevel int64
+	IsAssembled   int64
+	Effdt         int64
+}
+
+// UpdateSCInventoryQty updates the quantity, mass, and volume for an inventory entry.
+// Use this only when the entry was created in the current turn; otherwise,
+// end-date the entry and create a new one.
+func (q *Queries) UpdateSCInventoryQty(ctx context.Context, arg UpdateSCInventoryQtyParams) error {
+	_, err := q.db.ExecContext(ctx, updateSCInventoryQty,
+		arg.Qty,
+		arg.Mass,
+		arg.Volume,
+		arg.ScID,
+		arg.UnitCd,
+		arg.UnitTechLevel,
+		arg.IsAssembled,
 		arg.Effdt,
 	)
 	return err
@@ -1186,6 +3476,25 @@ func (q *Queries) UpdateSCLocationEndDt(ctx context.Context, arg UpdateSCLocatio
 	return err
 }
 
+const updateSCLocationEndDtByTurn = `-- name: UpdateSCLocationEndDtByTurn :exec
+update sc_location
+set enddt = ?1
+where enddt = ?2
+`
+
+type UpdateSCLocationEndDtByTurnParams struct {
+	MaxEnddt int64
+	Effdt    int64
+}
+
+// UpdateSCLocationEndDtByTurn re-opens the location entries that were
+// end-dated on a given turn. It is used to undo the moves and jumps made
+// on the turn.
+func (q *Queries) UpdateSCLocationEndDtByTurn(ctx context.Context, arg UpdateSCLocationEndDtByTurnParams) error {
+	_, err := q.db.ExecContext(ctx, updateSCLocationEndDtByTurn, arg.MaxEnddt, arg.Effdt)
+	return err
+}
+
 const updateSCNameEndDt = `-- name: UpdateSCNameEndDt :exec
 update sc_name
 set enddt = ?1
@@ -1205,6 +3514,43 @@ func (q *Queries) UpdateSCNameEndDt(ctx context.Context, arg UpdateSCNameEndDtPa
 	return err
 }
 
+const updateSCOwnerEndDt = `-- name: UpdateSCOwnerEndDt :exec
+update sc_owner
+set enddt = ?1
+where sc_id = ?2
+  and effdt = ?3
+`
+
+type UpdateSCOwnerEndDtParams struct {
+	Enddt int64
+	ScID  int64
+	Effdt int64
+}
+
+// UpdateSCOwnerEndDt updates the end date for an owner entry.
+func (q *Queries) UpdateSCOwnerEndDt(ctx context.Context, arg UpdateSCOwnerEndDtParams) error {
+	_, err := q.db.ExecContext(ctx, updateSCOwnerEndDt, arg.Enddt, arg.ScID, arg.Effdt)
+	return err
+}
+
+const updateSCOwnerEndDtByTurn = `-- name: UpdateSCOwnerEndDtByTurn :exec
+update sc_owner
+set enddt = ?1
+where enddt = ?2
+`
+
+type UpdateSCOwnerEndDtByTurnParams struct {
+	MaxEnddt int64
+	Effdt    int64
+}
+
+// UpdateSCOwnerEndDtByTurn re-opens the owner entries that were end-dated
+// on a given turn. It is used to undo the captures made on the turn.
+func (q *Queries) UpdateSCOwnerEndDtByTurn(ctx context.Context, arg UpdateSCOwnerEndDtByTurnParams) error {
+	_, err := q.db.ExecContext(ctx, updateSCOwnerEndDtByTurn, arg.MaxEnddt, arg.Effdt)
+	return err
+}
+
 const updateSCPopulationEndDt = `-- name: UpdateSCPopulationEndDt :exec
 update sc_population
 set enddt = ?1
@@ -1230,3 +3576,51 @@ func (q *Queries) UpdateSCPopulationEndDt(ctx context.Context, arg UpdateSCPopul
 	)
 	return err
 }
+
+const updateSCPopulationEndDtByTurn = `-- name: UpdateSCPopulationEndDtByTurn :exec
+update sc_population
+set enddt = ?1
+where enddt = ?2
+`
+
+type UpdateSCPopulationEndDtByTurnParams struct {
+	MaxEnddt int64
+	Effdt    int64
+}
+
+// UpdateSCPopulationEndDtByTurn re-opens the population entries that were
+// end-dated on a given turn. It is used to undo the changes made on the turn.
+func (q *Queries) UpdateSCPopulationEndDtByTurn(ctx context.Context, arg UpdateSCPopulationEndDtByTurnParams) error {
+	_, err := q.db.ExecContext(ctx, updateSCPopulationEndDtByTurn, arg.MaxEnddt, arg.Effdt)
+	return err
+}
+
+const updateSCPopulationQty = `-- name: UpdateSCPopulationQty :exec
+update sc_population
+set qty       = ?1,
+    rebel_qty = ?2
+where sc_id = ?3
+  and population_cd = ?4
+  and effdt = ?5
+`
+
+type UpdateSCPopulationQtyParams struct {
+	Qty          int64
+	RebelQty     int64
+	ScID         int64
+	PopulationCd string
+	Effdt        int64
+}
+
+// UpdateSCPopulationQty updates the quantity and rebels of a population entry.
+// It is used for entries that were created on the current turn.
+func (q *Queries) UpdateSCPopulationQty(ctx context.Context, arg UpdateSCPopulationQtyParams) error {
+	_, err := q.db.ExecContext(ctx, updateSCPopulationQty,
+		arg.Qty,
+		arg.RebelQty,
+		arg.ScID,
+		arg.PopulationCd,
+		arg.Effdt,
+	)
+	return err
+}
@@ -0,0 +1,149 @@
+-- CreateSCSetupOrder creates a new setup order.
+--
+-- name: CreateSCSetupOrder :one
+insert into sc_setup_order (sc_id, effdt, kind, orbit_id)
+values (:sc_id, :effdt, :kind, :orbit_id)
+returning id;
+
+-- CreateSCSetupItem adds a unit or population to a setup order.
+--
+-- name: CreateSCSetupItem :exec
+insert into sc_setup_item (setup_id, line_no, unit_cd, tech_level, qty)
+values (:setup_id, :line_no, :unit_cd, :tech_level, :qty);
+
+-- CreateSCSetupResult creates the result of a setup order.
+--
+-- name: CreateSCSetupResult :exec
+insert into sc_setup_result (setup_id, effdt, empire_id, new_sc_id, sc_cd, status, reason)
+values (:setup_id, :effdt, :empire_id, :new_sc_id, :sc_cd, :status, :reason);
+
+-- DeleteSCSetupResultsByTurn deletes the setup results for a turn.
+--
+-- name: DeleteSCSetupResultsByTurn :exec
+delete
+from sc_setup_result
+where effdt = :effdt;
+
+-- ReadAllSetupOrdersByTurn returns the setup orders for a turn, in the order
+-- they were given.
+--
+-- name: ReadAllSetupOrdersByTurn :many
+select id as setup_id,
+       sc_id,
+       kind,
+       orbit_id
+from sc_setup_order
+where effdt = :turn_no
+order by id;
+
+-- ReadAllSetupItemsBySetup returns the units and population that a setup
+-- order transfers, in the order they were given.
+--
+-- name: ReadAllSetupItemsBySetup :many
+select unit_cd,
+       tech_level,
+       qty
+from sc_setup_item
+where setup_id = :setup_id
+order by line_no;
+
+-- ReadAllSetupResultsByEmpire returns the results of the setup orders given
+-- by an empire's ships and colonies on a turn.
+--
+-- name: ReadAllSetupResultsByEmpire :many
+select sc_setup_order.id as setup_id,
+       sc_setup_order.sc_id,
+       sc_setup_order.kind,
+       sc_setup_result.new_sc_id,
+       sc_setup_result.sc_cd,
+       stars.star_name,
+       orbits.orbit_no,
+       sc_setup_result.status,
+       sc_setup_result.reason
+from sc_setup_order,
+     sc_setup_result,
+     orbits,
+     stars
+where sc_setup_result.empire_id = :empire_id
+  and sc_setup_result.effdt = :effdt
+  and sc_setup_order.id = sc_setup_result.setup_id
+  and orbits.id = sc_setup_order.orbit_id
+  and stars.id = orbits.star_id
+order by sc_setup_order.id;
+
+-- DeleteFoundedSCInventoryByTurn deletes the inventory of the ships and
+-- colonies founded on a turn.
+--
+-- name: DeleteFoundedSCInventoryByTurn :exec
+delete
+from sc_inventory
+where sc_id in (select new_sc_id
+                from sc_setup_result
+                where effdt = :effdt
+                  and new_sc_id != 0);
+
+-- DeleteFoundedSCLocationsByTurn deletes the location of the ships and
+-- colonies founded on a turn.
+--
+-- name: DeleteFoundedSCLocationsByTurn :exec
+delete
+from sc_location
+where sc_id in (select new_sc_id
+                from sc_setup_result
+                where effdt = :effdt
+                  and new_sc_id != 0);
+
+-- DeleteFoundedSCNamesByTurn deletes the names of the ships and colonies
+-- founded on a turn.
+--
+-- name: DeleteFoundedSCNamesByTurn :exec
+delete
+from sc_name
+where sc_id in (select new_sc_id
+                from sc_setup_result
+                where effdt = :effdt
+                  and new_sc_id != 0);
+
+-- DeleteFoundedSCOwnersByTurn deletes the owners of the ships and colonies
+-- founded on a turn.
+--
+-- name: DeleteFoundedSCOwnersByTurn :exec
+delete
+from sc_owner
+where sc_id in (select new_sc_id
+                from sc_setup_result
+                where effdt = :effdt
+                  and new_sc_id != 0);
+
+-- DeleteFoundedSCPopulationByTurn deletes the population of the ships and
+-- colonies founded on a turn.
+--
+-- name: DeleteFoundedSCPopulationByTurn :exec
+delete
+from sc_population
+where sc_id in (select new_sc_id
+                from sc_setup_result
+                where effdt = :effdt
+                  and new_sc_id != 0);
+
+-- DeleteFoundedSCRatesByTurn deletes the rates of the colonies founded on
+-- a turn.
+--
+-- name: DeleteFoundedSCRatesByTurn :exec
+delete
+from sc_rates
+where sc_id in (select new_sc_id
+                from sc_setup_result
+                where effdt = :effdt
+                  and new_sc_id != 0);
+
+-- DeleteFoundedSCsByTurn deletes the ships and colonies founded on a turn.
+-- Delete the inventory, location, name, owner, population, and rates first.
+--
+-- name: DeleteFoundedSCsByTurn :exec
+delete
+from scs
+where id in (select new_sc_id
+             from sc_setup_result
+             where effdt = :effdt
+               and new_sc_id != 0);
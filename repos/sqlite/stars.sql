@@ -42,6 +42,20 @@ where systems.id = :system_id
   and stars.system_id = systems.id
 order by stars.sequence;
 
+-- ReadStarSystem returns a star and the location of its system.
+--
+-- name: ReadStarSystem :one
+select stars.system_id,
+       stars.star_name,
+       stars.nbr_of_orbits,
+       systems.x,
+       systems.y,
+       systems.z
+from stars,
+     systems
+where stars.id = :star_id
+  and systems.id = stars.system_id;
+
 -- ReadStarSurvey reads the star survey data for star in a game.
 --
 -- name: ReadStarSurvey :many
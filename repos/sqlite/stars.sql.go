@@ -230,3 +230,40 @@ func (q *Queries) ReadStarSurvey(ctx context.Context, starID int64) ([]ReadStarS
 	}
 	return items, nil
 }
+
+const readStarSystem = `-- name: ReadStarSystem :one
+select stars.system_id,
+       stars.star_name,
+       stars.nbr_of_orbits,
+       systems.x,
+       systems.y,
+       systems.z
+from stars,
+     systems
+where stars.id = ?1
+  and systems.id = stars.system_id
+`
+
+type ReadStarSystemRow struct {
+	SystemID    int64
+	StarName    string
+	NbrOfOrbits int64
+	X           int64
+	Y           int64
+	Z           int64
+}
+
+// ReadStarSystem returns a star and the location of its system.
+func (q *Queries) ReadStarSystem(ctx context.Context, starID int64) (ReadStarSystemRow, error) {
+	row := q.db.QueryRowContext(ctx, readStarSystem, starID)
+	var i ReadStarSystemRow
+	err := row.Scan(
+		&i.SystemID,
+		&i.StarName,
+		&i.NbrOfOrbits,
+		&i.X,
+		&i.Y,
+		&i.Z,
+	)
+	return i, err
+}
@@ -0,0 +1,80 @@
+-- CreateSCTransferOrder creates a new transfer order.
+--
+-- name: CreateSCTransferOrder :one
+insert into sc_transfer_order (sc_id, effdt, target_id, unit_cd, tech_level, qty)
+values (:sc_id, :effdt, :target_id, :unit_cd, :tech_level, :qty)
+returning id;
+
+-- CreateSCTransferResult creates the result of a transfer order.
+--
+-- name: CreateSCTransferResult :exec
+insert into sc_transfer_result (transfer_id, effdt, empire_id, qty, mass, fuel_used, status, reason)
+values (:transfer_id, :effdt, :empire_id, :qty, :mass, :fuel_used, :status, :reason);
+
+-- DeleteSCTransferResultsByTurn deletes the transfer results for a turn.
+--
+-- name: DeleteSCTransferResultsByTurn :exec
+delete
+from sc_transfer_result
+where effdt = :effdt;
+
+-- ReadAllTransferOrdersByTurn returns the transfer orders for a turn and the
+-- orders queued on earlier turns, in the order they were given. qty_moved is
+-- the quantity moved on earlier turns.
+--
+-- name: ReadAllTransferOrdersByTurn :many
+select sc_transfer_order.id as transfer_id,
+       sc_transfer_order.sc_id,
+       sc_transfer_order.target_id,
+       sc_transfer_order.unit_cd,
+       sc_transfer_order.tech_level,
+       sc_transfer_order.qty,
+       cast(coalesce((select sum(sc_transfer_result.qty)
+                      from sc_transfer_result
+                      where sc_transfer_result.transfer_id = sc_transfer_order.id
+                        and sc_transfer_result.effdt < :turn_no), 0) as integer) as qty_moved
+from sc_transfer_order
+where sc_transfer_order.effdt <= :turn_no
+  and not exists (select 1
+                  from sc_transfer_result
+                  where sc_transfer_result.transfer_id = sc_transfer_order.id
+                    and sc_transfer_result.effdt < :turn_no
+                    and sc_transfer_result.status != 'queued')
+  and (sc_transfer_order.effdt = :turn_no
+    or exists (select 1
+               from sc_transfer_result
+               where sc_transfer_result.transfer_id = sc_transfer_order.id
+                 and sc_transfer_result.effdt < :turn_no))
+order by sc_transfer_order.id;
+
+-- ReadAllTransferResultsByEmpire returns the results of the transfer orders
+-- given by an empire's ships and colonies on a turn.
+--
+-- name: ReadAllTransferResultsByEmpire :many
+select sc_transfer_order.id as transfer_id,
+       sc_transfer_order.sc_id,
+       sc_transfer_order.target_id,
+       sc_transfer_order.unit_cd,
+       sc_transfer_order.tech_level,
+       sc_transfer_order.qty as ordered_qty,
+       sc_transfer_result.qty,
+       sc_transfer_result.fuel_used,
+       sc_transfer_result.status,
+       sc_transfer_result.reason
+from sc_transfer_order,
+     sc_transfer_result
+where sc_transfer_result.empire_id = :empire_id
+  and sc_transfer_result.effdt = :effdt
+  and sc_transfer_order.id = sc_transfer_result.transfer_id
+order by sc_transfer_order.id;
+
+-- ReadSCTransportUsed returns the mass carried by the transports of a ship
+-- or colony on a turn.
+--
+-- name: ReadSCTransportUsed :one
+select cast(coalesce(sum(sc_transfer_result.mass), 0) as real) as mass
+from sc_transfer_order,
+     sc_transfer_result
+where sc_transfer_order.sc_id = :sc_id
+  and sc_transfer_result.transfer_id = sc_transfer_order.id
+  and sc_transfer_result.effdt = :effdt;